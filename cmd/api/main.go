@@ -1,18 +1,56 @@
 package main
 
 import (
+	"context"
+	cartcommands "e-commerce/internal/application/cart/commands"
+	cartqueries "e-commerce/internal/application/cart/queries"
+	categorycommands "e-commerce/internal/application/category/commands"
+	categoryqueries "e-commerce/internal/application/category/queries"
+	couponcommands "e-commerce/internal/application/coupon/commands"
+	couponqueries "e-commerce/internal/application/coupon/queries"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/notification"
+	"e-commerce/internal/application/notification/emails"
+	notificationqueries "e-commerce/internal/application/notification/queries"
+	ordercommands "e-commerce/internal/application/order/commands"
+	orderqueries "e-commerce/internal/application/order/queries"
+	"e-commerce/internal/application/pricing"
+	productcommands "e-commerce/internal/application/product/commands"
+	productqueries "e-commerce/internal/application/product/queries"
+	productsearch "e-commerce/internal/application/product/search"
+	promotioncommands "e-commerce/internal/application/promotion/commands"
+	promotionqueries "e-commerce/internal/application/promotion/queries"
+	reviewcommands "e-commerce/internal/application/review/commands"
+	reviewqueries "e-commerce/internal/application/review/queries"
+	shippingcommands "e-commerce/internal/application/shipping/commands"
+	shippingqueries "e-commerce/internal/application/shipping/queries"
+	"e-commerce/internal/application/storage"
+	taxcommands "e-commerce/internal/application/tax/commands"
+	taxqueries "e-commerce/internal/application/tax/queries"
 	"e-commerce/internal/application/user/commands"
 	"e-commerce/internal/application/user/queries"
+	"e-commerce/internal/application/user/verification"
+	warehousecommands "e-commerce/internal/application/warehouse/commands"
+	warehousequeries "e-commerce/internal/application/warehouse/queries"
+	wishlistalerts "e-commerce/internal/application/wishlist/alerts"
+	wishlistcommands "e-commerce/internal/application/wishlist/commands"
+	wishlistqueries "e-commerce/internal/application/wishlist/queries"
 	"e-commerce/internal/infrastructure/api/handlers"
+	"e-commerce/internal/infrastructure/blobstore"
 	"e-commerce/internal/infrastructure/cache"
 	"e-commerce/internal/infrastructure/database"
+	"e-commerce/internal/infrastructure/jobs"
 	"e-commerce/internal/infrastructure/messaging"
+	"e-commerce/internal/infrastructure/notifier"
 	"e-commerce/internal/infrastructure/persistence"
+	"e-commerce/internal/infrastructure/searchindex"
 	"e-commerce/pkg/config"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
@@ -25,6 +63,12 @@ import (
 func main() {
 	// Load configuration
 	cfg := config.Load()
+	if cfg.Users.UsesDefaultVerificationSecret() {
+		if !cfg.Server.IsDevelopment() {
+			log.Fatalf("VERIFICATION_SECRET must be set when APP_ENV is %q", cfg.Server.Environment)
+		}
+		log.Println("Warning: VERIFICATION_SECRET is not set, email verification links use the development default")
+	}
 
 	// Initialize database
 	db, err := database.NewPostgresConnection(&cfg.Database)
@@ -52,27 +96,385 @@ func main() {
 
 	// Initialize repositories
 	userRepo := persistence.NewUserRepository(db)
+	productRepo := persistence.NewProductRepository(db)
+	cartRepo := persistence.NewCartRepository(db)
+	orderRepo := persistence.NewOrderRepository(db)
+	shippingRepo := persistence.NewShippingRepository(db)
+	taxRepo := persistence.NewTaxRepository(db)
+	couponRepo := persistence.NewCouponRepository(db)
+	promotionRepo := persistence.NewPromotionRepository(db)
+	categoryRepo := persistence.NewCategoryRepository(db)
+	importJobRepo := persistence.NewImportJobRepository(db)
+	warehouseRepo := persistence.NewWarehouseRepository(db)
+	wishlistRepo := persistence.NewWishlistRepository(db)
+	reviewRepo := persistence.NewReviewRepository(db)
+	deliveryRepo := persistence.NewNotificationDeliveryRepository(db)
+
+	// Initialize the unit of work, for the commands that write to several repositories at once
+	unitOfWork := persistence.NewUnitOfWork(db)
+
+	// Initialize the backorder filler, which allocates stock coming in to the order items waiting for it
+	// in the unit of work saving the stock
+	backorderFiller := inventory.NewBackorderFiller(orderRepo)
+
+	// Initialize the product search index
+	var searchIndex productsearch.SearchIndex
+	var embeddedIndex *searchindex.EmbeddedIndex
+	switch cfg.Search.Backend {
+	case "embedded":
+		embeddedIndex, err = searchindex.NewEmbeddedIndex(cfg.Search.IndexPath)
+		if err != nil {
+			log.Fatalf("Failed to initialize search index: %v", err)
+		}
+		if embeddedIndex.Len() == 0 {
+			log.Println("Search index is empty, POST /api/products/reindex to build it")
+		}
+		searchIndex = embeddedIndex
+	default:
+		searchIndex = persistence.NewProductSearchIndex(db)
+	}
+
+	// Initialize the media blob store
+	var blobStore storage.BlobStore
+	switch cfg.Storage.Backend {
+	case "s3":
+		s3Store, err := blobstore.NewS3Store(
+			cfg.Storage.S3Endpoint,
+			cfg.Storage.S3Region,
+			cfg.Storage.S3Bucket,
+			cfg.Storage.S3AccessKey,
+			cfg.Storage.S3SecretKey,
+		)
+		if err != nil {
+			log.Fatalf("Failed to initialize media storage: %v", err)
+		}
+		if err := s3Store.EnsureBucket(context.Background()); err != nil {
+			log.Fatalf("Failed to initialize media storage: %v", err)
+		}
+		blobStore = s3Store
+	default:
+		localStore, err := blobstore.NewLocalStore(cfg.Storage.LocalDir)
+		if err != nil {
+			log.Fatalf("Failed to initialize media storage: %v", err)
+		}
+		blobStore = localStore
+	}
+
+	// Initialize the notification backend, and the service rendering notifications from templates,
+	// logging their deliveries and retrying transient failures
+	var notificationBackend notification.Notifier
+	switch cfg.Notification.Backend {
+	case "smtp":
+		smtpNotifier, err := notifier.NewSMTPNotifier(
+			cfg.Notification.SMTPHost,
+			cfg.Notification.SMTPPort,
+			cfg.Notification.SMTPUsername,
+			cfg.Notification.SMTPPassword,
+			cfg.Notification.From,
+		)
+		if err != nil {
+			log.Fatalf("Failed to initialize notifications: %v", err)
+		}
+		notificationBackend = smtpNotifier
+	default:
+		notificationBackend = notifier.NewLogNotifier()
+	}
+
+	notificationTemplates, err := notification.NewTemplates(cfg.Notification.DefaultLocale)
+	if err != nil {
+		log.Fatalf("Failed to initialize notification templates: %v", err)
+	}
+	notificationService := notification.NewService(
+		notificationBackend,
+		notificationTemplates,
+		deliveryRepo,
+		cfg.Notification.MaxAttempts,
+		cfg.Notification.RetryDelay,
+	)
+
+	// Initialize the signing of email verification links
+	verificationTokens := verification.NewTokens(cfg.Users.VerificationSecret, cfg.Users.VerificationTTL)
+
+	// Initialize the event bus, keep the search index in sync with the catalog, alert staff of low stock,
+	// alert users of price drops and restocks on their wishlists and email customers about their account and orders
+	eventBus := events.NewBus()
+	productsearch.NewSyncer(productRepo, searchIndex).Subscribe(eventBus)
+	inventory.NewLowStockAlerter(productRepo, notificationService, cfg.Inventory.LowStockRecipients).Subscribe(eventBus)
+	wishlistalerts.NewAlerter(wishlistRepo, productRepo, userRepo, notificationService).Subscribe(eventBus)
+	emails.NewMailer(
+		notificationService, orderRepo, userRepo, productRepo, verificationTokens,
+		cfg.Users.PasswordResetURL, cfg.Users.VerificationURL,
+	).Subscribe(eventBus)
+
+	// Initialize services
+	pricer := pricing.NewPricer(productRepo, taxRepo, shippingRepo, couponRepo, promotionRepo, categoryRepo)
+	allocator, err := inventory.NewAllocator(warehouseRepo, cfg.Inventory.AllocationStrategy)
+	if err != nil {
+		log.Fatalf("Failed to initialize stock allocation: %v", err)
+	}
 
 	// Initialize command handlers
-	createUserHandler := commands.NewCreateUserHandler(userRepo)
-	updateUserHandler := commands.NewUpdateUserHandler(userRepo)
+	createUserHandler := commands.NewCreateUserHandler(unitOfWork, userRepo, cartRepo, eventBus)
+	updateUserHandler := commands.NewUpdateUserHandler(userRepo, eventBus, cfg.Users.VerificationResendCooldown)
 	deleteUserHandler := commands.NewDeleteUserHandler(userRepo)
+	addAddressHandler := commands.NewAddAddressHandler(userRepo)
+	updateAddressHandler := commands.NewUpdateAddressHandler(userRepo)
+	removeAddressHandler := commands.NewRemoveAddressHandler(userRepo)
+	setDefaultAddressHandler := commands.NewSetDefaultAddressHandler(userRepo)
+	requestPasswordResetHandler := commands.NewRequestPasswordResetHandler(userRepo, eventBus, cfg.Users.PasswordResetTTL, cfg.Users.PasswordResetCooldown)
+	resetPasswordHandler := commands.NewResetPasswordHandler(userRepo)
+	verifyEmailHandler := commands.NewVerifyEmailHandler(userRepo, verificationTokens)
+	resendVerificationHandler := commands.NewResendVerificationHandler(userRepo, eventBus, cfg.Users.VerificationResendCooldown)
+	createProductHandler := productcommands.NewCreateProductHandler(productRepo, categoryRepo, warehouseRepo, eventBus)
+	updateProductHandler := productcommands.NewUpdateProductHandler(unitOfWork, productRepo, categoryRepo, warehouseRepo, backorderFiller, eventBus)
+	archiveProductHandler := productcommands.NewArchiveProductHandler(productRepo, eventBus)
+	publishProductHandler := productcommands.NewPublishProductHandler(productRepo, eventBus)
+	unpublishProductHandler := productcommands.NewUnpublishProductHandler(productRepo, eventBus)
+	addVariantHandler := productcommands.NewAddVariantHandler(productRepo, warehouseRepo, eventBus)
+	updateVariantHandler := productcommands.NewUpdateVariantHandler(unitOfWork, productRepo, warehouseRepo, backorderFiller, eventBus)
+	removeVariantHandler := productcommands.NewRemoveVariantHandler(productRepo, eventBus)
+	transferStockHandler := productcommands.NewTransferStockHandler(unitOfWork, productRepo, warehouseRepo, backorderFiller, eventBus)
+	uploadImageHandler := productcommands.NewUploadImageHandler(productRepo, blobStore, cfg.Storage.MediaURL, eventBus)
+	updateImageHandler := productcommands.NewUpdateImageHandler(productRepo, eventBus)
+	reorderImagesHandler := productcommands.NewReorderImagesHandler(productRepo, eventBus)
+	removeImageHandler := productcommands.NewRemoveImageHandler(productRepo, blobStore, eventBus)
+	schedulePriceHandler := productcommands.NewSchedulePriceHandler(productRepo, eventBus)
+	cancelPriceScheduleHandler := productcommands.NewCancelPriceScheduleHandler(productRepo, eventBus)
+	applyPriceSchedulesHandler := productcommands.NewApplyPriceSchedulesHandler(productRepo, eventBus)
+	reindexProductsHandler := productcommands.NewReindexProductsHandler(productRepo, searchIndex)
+	importProductsHandler := productcommands.NewImportProductsHandler(importJobRepo, blobStore)
+	runProductImportsHandler := productcommands.NewRunProductImportsHandler(unitOfWork, productRepo, warehouseRepo, importJobRepo, blobStore, backorderFiller, eventBus)
+	createCartHandler := cartcommands.NewCreateCartHandler(cartRepo, userRepo)
+	createGuestCartHandler := cartcommands.NewCreateGuestCartHandler(cartRepo)
+	mergeGuestCartHandler := cartcommands.NewMergeGuestCartHandler(unitOfWork, cartRepo, userRepo)
+	adjustCartHandler := cartcommands.NewAdjustCartHandler(cartRepo, productRepo)
+	restoreCartHandler := cartcommands.NewRestoreCartHandler(cartRepo)
+	remindAbandonedCartsHandler := cartcommands.NewRemindAbandonedCartsHandler(cartRepo, userRepo, notificationService, cfg.Cart.RecoveryURL)
+	expireCartsHandler := cartcommands.NewExpireCartsHandler(cartRepo)
+	addCartItemHandler := cartcommands.NewAddCartItemHandler(cartRepo, productRepo)
+	removeCartItemHandler := cartcommands.NewRemoveCartItemHandler(cartRepo)
+	applyCouponHandler := cartcommands.NewApplyCouponHandler(cartRepo, pricer)
+	removeCouponHandler := cartcommands.NewRemoveCouponHandler(cartRepo)
+	placeOrderHandler := ordercommands.NewPlaceOrderHandler(unitOfWork, orderRepo, cartRepo, productRepo, userRepo, couponRepo, pricer, allocator, eventBus, cfg.Users.RequireVerifiedEmail)
+	placeGuestOrderHandler := ordercommands.NewPlaceGuestOrderHandler(unitOfWork, orderRepo, cartRepo, productRepo, couponRepo, pricer, allocator, eventBus)
+	updateOrderStatusHandler := ordercommands.NewUpdateOrderStatusHandler(unitOfWork, orderRepo, productRepo, backorderFiller, eventBus)
+	createZoneHandler := shippingcommands.NewCreateZoneHandler(shippingRepo)
+	deleteZoneHandler := shippingcommands.NewDeleteZoneHandler(shippingRepo)
+	createRuleHandler := taxcommands.NewCreateRuleHandler(taxRepo)
+	deleteRuleHandler := taxcommands.NewDeleteRuleHandler(taxRepo)
+	createCouponHandler := couponcommands.NewCreateCouponHandler(couponRepo)
+	updateCouponHandler := couponcommands.NewUpdateCouponHandler(couponRepo)
+	deleteCouponHandler := couponcommands.NewDeleteCouponHandler(couponRepo)
+	createPromotionHandler := promotioncommands.NewCreatePromotionHandler(promotionRepo)
+	updatePromotionHandler := promotioncommands.NewUpdatePromotionHandler(promotionRepo)
+	deletePromotionHandler := promotioncommands.NewDeletePromotionHandler(promotionRepo)
+	createCategoryHandler := categorycommands.NewCreateCategoryHandler(categoryRepo)
+	updateCategoryHandler := categorycommands.NewUpdateCategoryHandler(categoryRepo)
+	deleteCategoryHandler := categorycommands.NewDeleteCategoryHandler(categoryRepo)
+	createWarehouseHandler := warehousecommands.NewCreateWarehouseHandler(warehouseRepo)
+	updateWarehouseHandler := warehousecommands.NewUpdateWarehouseHandler(warehouseRepo)
+	deleteWarehouseHandler := warehousecommands.NewDeleteWarehouseHandler(warehouseRepo)
+	createListHandler := wishlistcommands.NewCreateListHandler(wishlistRepo, userRepo)
+	renameListHandler := wishlistcommands.NewRenameListHandler(wishlistRepo)
+	removeListHandler := wishlistcommands.NewRemoveListHandler(wishlistRepo)
+	addWishlistItemHandler := wishlistcommands.NewAddWishlistItemHandler(wishlistRepo, productRepo, userRepo)
+	removeWishlistItemHandler := wishlistcommands.NewRemoveWishlistItemHandler(wishlistRepo)
+	moveToCartHandler := wishlistcommands.NewMoveToCartHandler(wishlistRepo, cartRepo, productRepo)
+	saveForLaterHandler := wishlistcommands.NewSaveForLaterHandler(wishlistRepo, cartRepo, productRepo, userRepo)
+	shareListHandler := wishlistcommands.NewShareListHandler(wishlistRepo)
+	unshareListHandler := wishlistcommands.NewUnshareListHandler(wishlistRepo)
+	createReviewHandler := reviewcommands.NewCreateReviewHandler(reviewRepo, productRepo, userRepo, orderRepo)
+	updateReviewHandler := reviewcommands.NewUpdateReviewHandler(reviewRepo, orderRepo)
+	deleteReviewHandler := reviewcommands.NewDeleteReviewHandler(reviewRepo)
+	moderateReviewHandler := reviewcommands.NewModerateReviewHandler(reviewRepo)
+	voteReviewHandler := reviewcommands.NewVoteReviewHandler(reviewRepo, userRepo)
 
 	// Initialize query handlers
 	getUserHandler := queries.NewGetUserHandler(userRepo)
 	listUsersHandler := queries.NewListUsersHandler(userRepo)
+	listAddressesHandler := queries.NewListAddressesHandler(userRepo)
+	getProductHandler := productqueries.NewGetProductHandler(productRepo, categoryRepo, reviewRepo)
+	listProductsHandler := productqueries.NewListProductsHandler(productRepo, categoryRepo, reviewRepo)
+	searchProductsHandler := productqueries.NewSearchProductsHandler(productRepo, categoryRepo, reviewRepo, searchIndex)
+	productFacetsHandler := productqueries.NewGetProductFacetsHandler(productRepo)
+	suggestProductsHandler := productqueries.NewSuggestProductsHandler(searchIndex)
+	getMediaHandler := productqueries.NewGetMediaHandler(blobStore)
+	getPriceHistoryHandler := productqueries.NewGetPriceHistoryHandler(productRepo)
+	getStockMovementsHandler := productqueries.NewGetStockMovementsHandler(productRepo)
+	reconcileStockHandler := productqueries.NewReconcileStockHandler(productRepo)
+	reorderReportHandler := productqueries.NewGetReorderReportHandler(productRepo)
+	getImportJobHandler := productqueries.NewGetImportJobHandler(importJobRepo)
+	exportProductsHandler := productqueries.NewExportProductsHandler(productRepo)
+	listCategoryProductsHandler := productqueries.NewListCategoryProductsHandler(productRepo, categoryRepo, reviewRepo)
+	getCartHandler := cartqueries.NewGetCartHandler(cartRepo)
+	getUserCartHandler := cartqueries.NewGetUserCartHandler(cartRepo)
+	getGuestCartHandler := cartqueries.NewGetGuestCartHandler(cartRepo)
+	quoteCartHandler := cartqueries.NewQuoteCartHandler(cartRepo, pricer)
+	validateCartHandler := cartqueries.NewValidateCartHandler(cartRepo, productRepo, pricer)
+	getRecoveryReportHandler := cartqueries.NewGetRecoveryReportHandler(cartRepo)
+	evaluatePromotionsHandler := cartqueries.NewEvaluatePromotionsHandler(cartRepo, pricer)
+	getOrderHandler := orderqueries.NewGetOrderHandler(orderRepo)
+	listUserOrdersHandler := orderqueries.NewListUserOrdersHandler(orderRepo)
+	listZonesHandler := shippingqueries.NewListZonesHandler(shippingRepo)
+	quoteShippingHandler := shippingqueries.NewQuoteShippingHandler(cartRepo, productRepo, shippingRepo)
+	listRulesHandler := taxqueries.NewListRulesHandler(taxRepo)
+	getCouponHandler := couponqueries.NewGetCouponHandler(couponRepo)
+	listCouponsHandler := couponqueries.NewListCouponsHandler(couponRepo)
+	getPromotionHandler := promotionqueries.NewGetPromotionHandler(promotionRepo)
+	listPromotionsHandler := promotionqueries.NewListPromotionsHandler(promotionRepo)
+	getCategoryHandler := categoryqueries.NewGetCategoryHandler(categoryRepo)
+	getCategoryTreeHandler := categoryqueries.NewGetCategoryTreeHandler(categoryRepo)
+	getWarehouseHandler := warehousequeries.NewGetWarehouseHandler(warehouseRepo)
+	listWarehousesHandler := warehousequeries.NewListWarehousesHandler(warehouseRepo)
+	getWishlistHandler := wishlistqueries.NewGetWishlistHandler(wishlistRepo, productRepo)
+	getSharedListHandler := wishlistqueries.NewGetSharedListHandler(wishlistRepo, productRepo)
+	getReviewHandler := reviewqueries.NewGetReviewHandler(reviewRepo)
+	listReviewsHandler := reviewqueries.NewListReviewsHandler(reviewRepo)
+	listProductReviewsHandler := reviewqueries.NewListProductReviewsHandler(reviewRepo, productRepo)
+	listDeliveriesHandler := notificationqueries.NewListDeliveriesHandler(deliveryRepo)
 
 	// Initialize API handlers
 	userHandler := handlers.NewUserHandler(
 		createUserHandler,
 		updateUserHandler,
 		deleteUserHandler,
+		addAddressHandler,
+		updateAddressHandler,
+		removeAddressHandler,
+		setDefaultAddressHandler,
+		requestPasswordResetHandler,
+		resetPasswordHandler,
+		verifyEmailHandler,
+		resendVerificationHandler,
 		getUserHandler,
 		listUsersHandler,
+		listAddressesHandler,
+	)
+	productHandler := handlers.NewProductHandler(
+		createProductHandler,
+		updateProductHandler,
+		archiveProductHandler,
+		publishProductHandler,
+		unpublishProductHandler,
+		addVariantHandler,
+		updateVariantHandler,
+		removeVariantHandler,
+		transferStockHandler,
+		uploadImageHandler,
+		updateImageHandler,
+		reorderImagesHandler,
+		removeImageHandler,
+		schedulePriceHandler,
+		cancelPriceScheduleHandler,
+		reindexProductsHandler,
+		importProductsHandler,
+		getProductHandler,
+		listProductsHandler,
+		searchProductsHandler,
+		productFacetsHandler,
+		suggestProductsHandler,
+		getPriceHistoryHandler,
+		getStockMovementsHandler,
+		reconcileStockHandler,
+		reorderReportHandler,
+		getImportJobHandler,
+		exportProductsHandler,
+	)
+	cartHandler := handlers.NewCartHandler(
+		createCartHandler,
+		createGuestCartHandler,
+		mergeGuestCartHandler,
+		addCartItemHandler,
+		removeCartItemHandler,
+		applyCouponHandler,
+		removeCouponHandler,
+		adjustCartHandler,
+		restoreCartHandler,
+		getCartHandler,
+		getUserCartHandler,
+		getGuestCartHandler,
+		quoteCartHandler,
+		evaluatePromotionsHandler,
+		validateCartHandler,
+		getRecoveryReportHandler,
+	)
+	orderHandler := handlers.NewOrderHandler(
+		placeOrderHandler,
+		placeGuestOrderHandler,
+		updateOrderStatusHandler,
+		getOrderHandler,
+		listUserOrdersHandler,
 	)
+	shippingHandler := handlers.NewShippingHandler(
+		createZoneHandler,
+		deleteZoneHandler,
+		listZonesHandler,
+		quoteShippingHandler,
+	)
+	taxHandler := handlers.NewTaxHandler(
+		createRuleHandler,
+		deleteRuleHandler,
+		listRulesHandler,
+	)
+	couponHandler := handlers.NewCouponHandler(
+		createCouponHandler,
+		updateCouponHandler,
+		deleteCouponHandler,
+		getCouponHandler,
+		listCouponsHandler,
+	)
+	promotionHandler := handlers.NewPromotionHandler(
+		createPromotionHandler,
+		updatePromotionHandler,
+		deletePromotionHandler,
+		getPromotionHandler,
+		listPromotionsHandler,
+	)
+	categoryHandler := handlers.NewCategoryHandler(
+		createCategoryHandler,
+		updateCategoryHandler,
+		deleteCategoryHandler,
+		getCategoryHandler,
+		getCategoryTreeHandler,
+		listCategoryProductsHandler,
+	)
+	warehouseHandler := handlers.NewWarehouseHandler(
+		createWarehouseHandler,
+		updateWarehouseHandler,
+		deleteWarehouseHandler,
+		getWarehouseHandler,
+		listWarehousesHandler,
+	)
+	wishlistHandler := handlers.NewWishlistHandler(
+		createListHandler,
+		renameListHandler,
+		removeListHandler,
+		addWishlistItemHandler,
+		removeWishlistItemHandler,
+		moveToCartHandler,
+		saveForLaterHandler,
+		shareListHandler,
+		unshareListHandler,
+		getWishlistHandler,
+		getSharedListHandler,
+	)
+	reviewHandler := handlers.NewReviewHandler(
+		createReviewHandler,
+		updateReviewHandler,
+		deleteReviewHandler,
+		moderateReviewHandler,
+		voteReviewHandler,
+		getReviewHandler,
+		listReviewsHandler,
+		listProductReviewsHandler,
+	)
+	mediaHandler := handlers.NewMediaHandler(getMediaHandler)
+	notificationHandler := handlers.NewNotificationHandler(listDeliveriesHandler)
 
 	// Initialize Fiber app
 	app := fiber.New(fiber.Config{
+		// Leave room for image uploads, catalog imports and their multipart encoding
+		BodyLimit: 32 * 1024 * 1024,
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			code := fiber.StatusInternalServerError
 			if e, ok := err.(*fiber.Error); ok {
@@ -91,6 +493,19 @@ func main() {
 
 	// Register routes
 	userHandler.RegisterRoutes(app)
+	productHandler.RegisterRoutes(app)
+	cartHandler.RegisterRoutes(app)
+	orderHandler.RegisterRoutes(app)
+	shippingHandler.RegisterRoutes(app)
+	taxHandler.RegisterRoutes(app)
+	couponHandler.RegisterRoutes(app)
+	promotionHandler.RegisterRoutes(app)
+	categoryHandler.RegisterRoutes(app)
+	warehouseHandler.RegisterRoutes(app)
+	wishlistHandler.RegisterRoutes(app)
+	reviewHandler.RegisterRoutes(app)
+	mediaHandler.RegisterRoutes(app)
+	notificationHandler.RegisterRoutes(app)
 
 	// Default route
 	app.Get("/", func(c *fiber.Ctx) error {
@@ -106,6 +521,58 @@ func main() {
 		})
 	})
 
+	// Start the background jobs
+	jobRunner := jobs.NewRunner()
+	jobRunner.Add("apply price schedules", cfg.Jobs.PriceScheduleInterval, func(ctx context.Context) error {
+		repriced, err := applyPriceSchedulesHandler.Handle(ctx, productcommands.ApplyPriceSchedulesCommand{Now: time.Now()})
+		if repriced > 0 {
+			log.Printf("Applied price schedules to %d products", repriced)
+		}
+		return err
+	})
+	jobRunner.Add("run product imports", cfg.Jobs.ImportPollInterval, func(ctx context.Context) error {
+		run, err := runProductImportsHandler.Handle(ctx, productcommands.RunProductImportsCommand{Now: time.Now()})
+		if run > 0 {
+			log.Printf("Ran %d product imports", run)
+		}
+		return err
+	})
+	jobRunner.Add("remind abandoned carts", cfg.Cart.ReminderInterval, func(ctx context.Context) error {
+		reminded, err := remindAbandonedCartsHandler.Handle(ctx, cartcommands.RemindAbandonedCartsCommand{
+			Now:          time.Now(),
+			InactiveFor:  cfg.Cart.AbandonedAfter,
+			MaxReminders: cfg.Cart.MaxReminders,
+		})
+		if reminded > 0 {
+			log.Printf("Sent %d abandoned cart reminders", reminded)
+		}
+		return err
+	})
+	jobRunner.Add("expire carts", cfg.Cart.ReminderInterval, func(ctx context.Context) error {
+		expired, err := expireCartsHandler.Handle(ctx, cartcommands.ExpireCartsCommand{
+			Now:    time.Now(),
+			MaxAge: cfg.Cart.ExpireAfter,
+		})
+		if expired > 0 {
+			log.Printf("Expired %d carts", expired)
+		}
+		return err
+	})
+	jobRunner.Add("send notifications", cfg.Notification.SendInterval, func(ctx context.Context) error {
+		sent, err := notificationService.SendDue(ctx, time.Now())
+		if sent > 0 {
+			log.Printf("Delivered %d notifications", sent)
+		}
+		return err
+	})
+	if embeddedIndex != nil {
+		jobRunner.Add("save search index", cfg.Search.SaveInterval, func(ctx context.Context) error {
+			_, err := embeddedIndex.Save()
+			return err
+		})
+	}
+	jobRunner.Start()
+
 	// Start server in a goroutine
 	go func() {
 		port := cfg.Server.Port
@@ -125,5 +592,15 @@ func main() {
 	if err := app.Shutdown(); err != nil {
 		log.Fatalf("Server shutdown failed: %v", err)
 	}
+
+	// Stop the background jobs
+	jobRunner.Stop()
+
+	// Save the last changes of the search index
+	if embeddedIndex != nil {
+		if _, err := embeddedIndex.Save(); err != nil {
+			log.Printf("Failed to save search index: %v", err)
+		}
+	}
 	log.Println("Server gracefully stopped")
 }
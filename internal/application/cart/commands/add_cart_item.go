@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/product"
+	"time"
+)
+
+// AddCartItemCommand represents the command to add a product to a cart.
+// Products with variants require the variant to add.
+type AddCartItemCommand struct {
+	CartID    string `json:"-"`
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+// AddCartItemHandler handles the AddCartItemCommand
+type AddCartItemHandler struct {
+	cartRepo    cart.Repository
+	productRepo product.Repository
+}
+
+// NewAddCartItemHandler creates a new AddCartItemHandler
+func NewAddCartItemHandler(cartRepo cart.Repository, productRepo product.Repository) *AddCartItemHandler {
+	return &AddCartItemHandler{
+		cartRepo:    cartRepo,
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the AddCartItemCommand
+func (h *AddCartItemHandler) Handle(ctx context.Context, cmd AddCartItemCommand) error {
+	// Convert ID strings to domain IDs
+	cartID, err := cart.NewID(cmd.CartID)
+	if err != nil {
+		return err
+	}
+
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// Find the cart
+	existingCart, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return err
+	}
+
+	// Check if the product is for sale and the variant exists
+	p, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	if !p.IsAvailable(time.Now()) {
+		return product.ErrProductUnavailable
+	}
+
+	price, err := p.UnitPrice(product.VariantID(cmd.VariantID))
+	if err != nil {
+		return err
+	}
+
+	// Add the item
+	if err := existingCart.AddItem(cmd.ProductID, cmd.VariantID, cmd.Quantity, price); err != nil {
+		return err
+	}
+
+	// Save the updated cart
+	return h.cartRepo.Update(ctx, existingCart)
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/product"
+	"errors"
+	"time"
+)
+
+// AdjustCartCommand represents the command to bring a cart in line with the live catalog
+type AdjustCartCommand struct {
+	CartID string
+}
+
+// AdjustCartHandler handles the AdjustCartCommand
+type AdjustCartHandler struct {
+	cartRepo    cart.Repository
+	productRepo product.Repository
+}
+
+// NewAdjustCartHandler creates a new AdjustCartHandler
+func NewAdjustCartHandler(cartRepo cart.Repository, productRepo product.Repository) *AdjustCartHandler {
+	return &AdjustCartHandler{
+		cartRepo:    cartRepo,
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the AdjustCartCommand: unavailable lines are removed, quantities are reduced to
+// the stock available and current prices are accepted. It returns whether the cart changed.
+func (h *AdjustCartHandler) Handle(ctx context.Context, cmd AdjustCartCommand) (bool, error) {
+	// Convert ID string to domain ID
+	cartID, err := cart.NewID(cmd.CartID)
+	if err != nil {
+		return false, err
+	}
+
+	// Find the cart
+	c, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return false, err
+	}
+
+	// Check each line against its product
+	now := time.Now()
+	checks := make([]*cart.ItemCheck, len(c.Items()))
+	for i, item := range c.Items() {
+		p, err := h.productRepo.FindByID(ctx, item.ProductID())
+		if err != nil && !errors.Is(err, product.ErrProductNotFound) {
+			return false, err
+		}
+		checks[i] = cart.CheckItem(item, p, now)
+	}
+
+	// Adjust the cart
+	if !c.Adjust(checks) {
+		return false, nil
+	}
+
+	// Save the updated cart
+	if err := h.cartRepo.Update(ctx, c); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
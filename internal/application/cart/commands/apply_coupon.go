@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/pricing"
+	"e-commerce/internal/domain/cart"
+)
+
+// ApplyCouponCommand represents the command to apply a coupon code to a cart
+type ApplyCouponCommand struct {
+	CartID string `json:"-"`
+	Code   string `json:"code"`
+}
+
+// ApplyCouponHandler handles the ApplyCouponCommand
+type ApplyCouponHandler struct {
+	cartRepo cart.Repository
+	pricer   *pricing.Pricer
+}
+
+// NewApplyCouponHandler creates a new ApplyCouponHandler
+func NewApplyCouponHandler(cartRepo cart.Repository, pricer *pricing.Pricer) *ApplyCouponHandler {
+	return &ApplyCouponHandler{
+		cartRepo: cartRepo,
+		pricer:   pricer,
+	}
+}
+
+// Handle processes the ApplyCouponCommand
+func (h *ApplyCouponHandler) Handle(ctx context.Context, cmd ApplyCouponCommand) error {
+	// Convert ID string to domain ID
+	cartID, err := cart.NewID(cmd.CartID)
+	if err != nil {
+		return err
+	}
+
+	// Find the cart
+	existingCart, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return err
+	}
+
+	if existingCart.IsEmpty() {
+		return cart.ErrEmptyCart
+	}
+
+	// Check the coupon applies to the cart contents
+	items := make([]pricing.Item, len(existingCart.Items()))
+	for i, item := range existingCart.Items() {
+		items[i] = pricing.Item{ProductID: item.ProductID(), Quantity: item.Quantity()}
+	}
+
+	quote, err := h.pricer.Price(ctx, pricing.Request{
+		Items:      items,
+		UserID:     existingCart.UserID(),
+		CouponCode: cmd.Code,
+	})
+	if err != nil {
+		return err
+	}
+
+	if quote.CouponError != nil {
+		return quote.CouponError
+	}
+
+	// Apply the coupon
+	if err := existingCart.ApplyCoupon(quote.Coupon.Code().String()); err != nil {
+		return err
+	}
+
+	// Save the updated cart
+	return h.cartRepo.Update(ctx, existingCart)
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/user"
+)
+
+// CreateCartCommand represents the command to create a cart for a user
+type CreateCartCommand struct {
+	UserID string `json:"user_id"`
+}
+
+// CreateCartHandler handles the CreateCartCommand
+type CreateCartHandler struct {
+	cartRepo cart.Repository
+	userRepo user.Repository
+}
+
+// NewCreateCartHandler creates a new CreateCartHandler
+func NewCreateCartHandler(cartRepo cart.Repository, userRepo user.Repository) *CreateCartHandler {
+	return &CreateCartHandler{
+		cartRepo: cartRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Handle processes the CreateCartCommand
+func (h *CreateCartHandler) Handle(ctx context.Context, cmd CreateCartCommand) (string, error) {
+	// Convert ID string to domain ID
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if user exists
+	if _, err := h.userRepo.FindByID(ctx, userID); err != nil {
+		return "", err
+	}
+
+	// Return the existing cart if the user already has one
+	existingCart, err := h.cartRepo.FindByUserID(ctx, userID)
+	if err == nil && existingCart != nil {
+		return existingCart.ID().String(), nil
+	}
+
+	// Create a new cart
+	newCart, err := cart.NewCart(cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Save the cart
+	if err := h.cartRepo.Save(ctx, newCart); err != nil {
+		return "", err
+	}
+
+	return newCart.ID().String(), nil
+}
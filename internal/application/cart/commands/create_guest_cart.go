@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+)
+
+// CreateGuestCartHandler handles the creation of a cart for an anonymous visitor
+type CreateGuestCartHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewCreateGuestCartHandler creates a new CreateGuestCartHandler
+func NewCreateGuestCartHandler(cartRepo cart.Repository) *CreateGuestCartHandler {
+	return &CreateGuestCartHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle creates a new guest cart, returning its ID and the session token identifying it
+func (h *CreateGuestCartHandler) Handle(ctx context.Context) (string, string, error) {
+	// Create a new guest cart
+	newCart, err := cart.NewGuestCart()
+	if err != nil {
+		return "", "", err
+	}
+
+	// Save the cart
+	if err := h.cartRepo.Save(ctx, newCart); err != nil {
+		return "", "", err
+	}
+
+	return newCart.ID().String(), newCart.SessionToken().String(), nil
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"time"
+)
+
+// ExpireCartsCommand represents the command to remove the carts left untouched for longer than MaxAge
+type ExpireCartsCommand struct {
+	Now    time.Time
+	MaxAge time.Duration
+}
+
+// ExpireCartsHandler handles the ExpireCartsCommand
+type ExpireCartsHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewExpireCartsHandler creates a new ExpireCartsHandler
+func NewExpireCartsHandler(cartRepo cart.Repository) *ExpireCartsHandler {
+	return &ExpireCartsHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle processes the ExpireCartsCommand and returns the number of carts removed
+func (h *ExpireCartsHandler) Handle(ctx context.Context, cmd ExpireCartsCommand) (int, error) {
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Remove the expired carts
+	return h.cartRepo.DeleteInactive(ctx, now.Add(-cmd.MaxAge))
+}
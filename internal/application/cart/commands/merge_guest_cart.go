@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/user"
+	"errors"
+)
+
+// MergeGuestCartCommand represents the command to combine the guest cart of a visitor
+// into their cart when they log in
+type MergeGuestCartCommand struct {
+	UserID       string `json:"user_id"`
+	SessionToken string `json:"session_token"`
+}
+
+// MergeGuestCartHandler handles the MergeGuestCartCommand
+type MergeGuestCartHandler struct {
+	uow      transaction.UnitOfWork
+	cartRepo cart.Repository
+	userRepo user.Repository
+}
+
+// NewMergeGuestCartHandler creates a new MergeGuestCartHandler
+func NewMergeGuestCartHandler(uow transaction.UnitOfWork, cartRepo cart.Repository, userRepo user.Repository) *MergeGuestCartHandler {
+	return &MergeGuestCartHandler{
+		uow:      uow,
+		cartRepo: cartRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Handle processes the MergeGuestCartCommand, returning the ID of the user's cart.
+// The guest cart is merged into the user's cart and deleted, or becomes the user's cart when they have none,
+// in one unit of work.
+func (h *MergeGuestCartHandler) Handle(ctx context.Context, cmd MergeGuestCartCommand) (string, error) {
+	var cartID string
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		cartID, err = h.merge(ctx, cmd)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return cartID, nil
+}
+
+// merge merges the guest cart into the user's cart, returning the ID of the user's cart
+func (h *MergeGuestCartHandler) merge(ctx context.Context, cmd MergeGuestCartCommand) (string, error) {
+	// Convert ID string to domain ID
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if user exists
+	if _, err := h.userRepo.FindByID(ctx, userID); err != nil {
+		return "", err
+	}
+
+	// Find the guest cart
+	guestCart, err := h.cartRepo.FindBySessionToken(ctx, cart.SessionToken(cmd.SessionToken))
+	if err != nil {
+		return "", err
+	}
+
+	// Hand the guest cart over when the user has no cart yet
+	userCart, err := h.cartRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, cart.ErrCartNotFound) {
+		if err := guestCart.Claim(cmd.UserID); err != nil {
+			return "", err
+		}
+
+		if err := h.cartRepo.Update(ctx, guestCart); err != nil {
+			return "", err
+		}
+
+		return guestCart.ID().String(), nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Merge the guest cart into the user's cart
+	if err := userCart.Merge(guestCart); err != nil {
+		return "", err
+	}
+
+	// Save the updated cart, then remove the guest cart
+	if err := h.cartRepo.Update(ctx, userCart); err != nil {
+		return "", err
+	}
+
+	if err := h.cartRepo.Delete(ctx, guestCart.ID()); err != nil {
+		return "", err
+	}
+
+	return userCart.ID().String(), nil
+}
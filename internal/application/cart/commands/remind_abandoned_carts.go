@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/notification"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/user"
+	"log"
+	"time"
+)
+
+// RemindAbandonedCartsCommand represents the command to remind users of the carts they left
+// untouched for InactiveFor, at most MaxReminders times each, one InactiveFor apart
+type RemindAbandonedCartsCommand struct {
+	Now          time.Time
+	InactiveFor  time.Duration
+	MaxReminders int
+}
+
+// CartReminderData is the data of the cart reminder template
+type CartReminderData struct {
+	Name        string
+	Items       int
+	RecoveryURL string
+}
+
+// RemindAbandonedCartsHandler handles the RemindAbandonedCartsCommand
+type RemindAbandonedCartsHandler struct {
+	cartRepo    cart.Repository
+	userRepo    user.Repository
+	service     *notification.Service
+	recoveryURL string
+}
+
+// NewRemindAbandonedCartsHandler creates a new RemindAbandonedCartsHandler; the recovery token of a cart
+// is appended to recoveryURL to build the link restoring it
+func NewRemindAbandonedCartsHandler(
+	cartRepo cart.Repository,
+	userRepo user.Repository,
+	service *notification.Service,
+	recoveryURL string,
+) *RemindAbandonedCartsHandler {
+	return &RemindAbandonedCartsHandler{
+		cartRepo:    cartRepo,
+		userRepo:    userRepo,
+		service:     service,
+		recoveryURL: recoveryURL,
+	}
+}
+
+// Handle processes the RemindAbandonedCartsCommand and returns the number of reminders sent.
+// A cart that cannot be reminded is logged and left for the next run.
+func (h *RemindAbandonedCartsHandler) Handle(ctx context.Context, cmd RemindAbandonedCartsCommand) (int, error) {
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Find the abandoned carts
+	carts, err := h.cartRepo.FindAbandoned(ctx, now.Add(-cmd.InactiveFor), cmd.MaxReminders)
+	if err != nil {
+		return 0, err
+	}
+
+	reminded := 0
+	for _, c := range carts {
+		if !c.ReminderDue(now, cmd.InactiveFor, cmd.MaxReminders) {
+			continue
+		}
+
+		if err := h.remind(ctx, c, now); err != nil {
+			log.Printf("Failed to remind cart %s: %v", c.ID().String(), err)
+			continue
+		}
+		reminded++
+	}
+
+	return reminded, nil
+}
+
+// remind sends the user of a cart a reminder with the link restoring it, in their locale, and records
+// the reminder once it is sent
+func (h *RemindAbandonedCartsHandler) remind(ctx context.Context, c *cart.Cart, now time.Time) error {
+	// Find the user to remind
+	u, err := h.userRepo.FindByID(ctx, c.UserID())
+	if err != nil {
+		return err
+	}
+
+	token, err := c.RecordReminder(now)
+	if err != nil {
+		return err
+	}
+
+	// Send the reminder, then record it
+	if err := h.service.SendTemplate(
+		ctx, []string{u.Email().String()}, notification.TemplateCartReminder, u.Locale().String(),
+		CartReminderData{
+			Name:        u.Name().String(),
+			Items:       c.TotalItems(),
+			RecoveryURL: h.recoveryURL + token.String(),
+		},
+	); err != nil {
+		return err
+	}
+
+	return h.cartRepo.RecordReminder(ctx, c, u.Email().String(), now)
+}
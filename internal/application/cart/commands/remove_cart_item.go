@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+)
+
+// RemoveCartItemCommand represents the command to remove a product, or one of its variants, from a cart
+type RemoveCartItemCommand struct {
+	CartID    string
+	ProductID string
+	VariantID string
+}
+
+// RemoveCartItemHandler handles the RemoveCartItemCommand
+type RemoveCartItemHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewRemoveCartItemHandler creates a new RemoveCartItemHandler
+func NewRemoveCartItemHandler(cartRepo cart.Repository) *RemoveCartItemHandler {
+	return &RemoveCartItemHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle processes the RemoveCartItemCommand
+func (h *RemoveCartItemHandler) Handle(ctx context.Context, cmd RemoveCartItemCommand) error {
+	// Convert ID string to domain ID
+	cartID, err := cart.NewID(cmd.CartID)
+	if err != nil {
+		return err
+	}
+
+	// Find the cart
+	existingCart, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the item
+	if err := existingCart.RemoveItem(cmd.ProductID, cmd.VariantID); err != nil {
+		return err
+	}
+
+	// Save the updated cart
+	return h.cartRepo.Update(ctx, existingCart)
+}
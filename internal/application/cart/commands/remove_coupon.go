@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+)
+
+// RemoveCouponCommand represents the command to remove the coupon from a cart
+type RemoveCouponCommand struct {
+	CartID string
+}
+
+// RemoveCouponHandler handles the RemoveCouponCommand
+type RemoveCouponHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewRemoveCouponHandler creates a new RemoveCouponHandler
+func NewRemoveCouponHandler(cartRepo cart.Repository) *RemoveCouponHandler {
+	return &RemoveCouponHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle processes the RemoveCouponCommand
+func (h *RemoveCouponHandler) Handle(ctx context.Context, cmd RemoveCouponCommand) error {
+	// Convert ID string to domain ID
+	cartID, err := cart.NewID(cmd.CartID)
+	if err != nil {
+		return err
+	}
+
+	// Find the cart
+	existingCart, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the coupon
+	existingCart.RemoveCoupon()
+
+	// Save the updated cart
+	return h.cartRepo.Update(ctx, existingCart)
+}
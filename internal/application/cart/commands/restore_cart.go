@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"time"
+)
+
+// RestoreCartCommand represents the command to restore an abandoned cart through the link of a reminder
+type RestoreCartCommand struct {
+	RecoveryToken string
+}
+
+// RestoreCartHandler handles the RestoreCartCommand
+type RestoreCartHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewRestoreCartHandler creates a new RestoreCartHandler
+func NewRestoreCartHandler(cartRepo cart.Repository) *RestoreCartHandler {
+	return &RestoreCartHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle processes the RestoreCartCommand and returns the ID of the restored cart
+func (h *RestoreCartHandler) Handle(ctx context.Context, cmd RestoreCartCommand) (string, error) {
+	// Find the cart
+	c, err := h.cartRepo.FindByRecoveryToken(ctx, cart.RecoveryToken(cmd.RecoveryToken))
+	if err != nil {
+		return "", err
+	}
+
+	// Record the restore for recovery reporting
+	if err := h.cartRepo.RecordRestore(ctx, c.ID(), time.Now()); err != nil {
+		return "", err
+	}
+
+	return c.ID().String(), nil
+}
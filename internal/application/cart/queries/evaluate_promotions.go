@@ -0,0 +1,122 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/application/pricing"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/promotion"
+)
+
+// LineAdjustmentDTO represents the discount a promotion makes on a cart line
+type LineAdjustmentDTO struct {
+	ProductID string  `json:"product_id"`
+	VariantID string  `json:"variant_id,omitempty"`
+	Amount    float64 `json:"amount"`
+}
+
+// PromotionOutcomeDTO explains whether a promotion applied to a cart and why
+type PromotionOutcomeDTO struct {
+	PromotionID string               `json:"promotion_id"`
+	Name        string               `json:"name"`
+	Priority    int                  `json:"priority"`
+	Stackable   bool                 `json:"stackable"`
+	Applied     bool                 `json:"applied"`
+	Amount      float64              `json:"amount"`
+	Adjustments []*LineAdjustmentDTO `json:"adjustments,omitempty"`
+	Reason      string               `json:"reason,omitempty"`
+}
+
+// CartPromotionsDTO represents the promotions evaluated for a cart
+type CartPromotionsDTO struct {
+	CartID     string                 `json:"cart_id"`
+	Subtotal   float64                `json:"subtotal"`
+	Discount   float64                `json:"discount"`
+	Promotions []*PromotionOutcomeDTO `json:"promotions"`
+}
+
+// EvaluatePromotionsQuery represents the query to evaluate automatic promotions for a cart
+type EvaluatePromotionsQuery struct {
+	CartID string
+}
+
+// EvaluatePromotionsHandler handles the EvaluatePromotionsQuery
+type EvaluatePromotionsHandler struct {
+	cartRepo cart.Repository
+	pricer   *pricing.Pricer
+}
+
+// NewEvaluatePromotionsHandler creates a new EvaluatePromotionsHandler
+func NewEvaluatePromotionsHandler(cartRepo cart.Repository, pricer *pricing.Pricer) *EvaluatePromotionsHandler {
+	return &EvaluatePromotionsHandler{
+		cartRepo: cartRepo,
+		pricer:   pricer,
+	}
+}
+
+// Handle processes the EvaluatePromotionsQuery
+func (h *EvaluatePromotionsHandler) Handle(ctx context.Context, query EvaluatePromotionsQuery) (*CartPromotionsDTO, error) {
+	// Convert ID string to domain ID
+	cartID, err := cart.NewID(query.CartID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the cart
+	c, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Price the cart without destination or coupon
+	quote, err := h.pricer.Price(ctx, pricing.Request{
+		Items:  pricingItems(c),
+		UserID: c.UserID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CartPromotionsDTO{
+		CartID:     c.ID().String(),
+		Subtotal:   quote.Subtotal,
+		Discount:   quote.PromotionDiscount,
+		Promotions: toPromotionOutcomeDTOs(quote),
+	}, nil
+}
+
+// toPromotionOutcomeDTOs maps the promotion outcomes of a quote to DTOs
+func toPromotionOutcomeDTOs(quote *pricing.Quote) []*PromotionOutcomeDTO {
+	result := make([]*PromotionOutcomeDTO, len(quote.Promotions))
+	for i, outcome := range quote.Promotions {
+		result[i] = toPromotionOutcomeDTO(outcome, quote.Lines)
+	}
+	return result
+}
+
+// toPromotionOutcomeDTO maps a promotion outcome to a DTO
+func toPromotionOutcomeDTO(outcome *promotion.Outcome, lines []*pricing.Line) *PromotionOutcomeDTO {
+	dto := &PromotionOutcomeDTO{
+		PromotionID: outcome.Promotion.ID().String(),
+		Name:        outcome.Promotion.Name(),
+		Priority:    outcome.Promotion.Priority(),
+		Stackable:   outcome.Promotion.IsStackable(),
+		Applied:     outcome.Applied,
+		Amount:      outcome.Amount,
+	}
+
+	for i, adjustment := range outcome.LineAdjustments {
+		if adjustment > 0 {
+			dto.Adjustments = append(dto.Adjustments, &LineAdjustmentDTO{
+				ProductID: lines[i].Product.ID().String(),
+				VariantID: lines[i].VariantID(),
+				Amount:    adjustment,
+			})
+		}
+	}
+
+	if outcome.Reason != nil {
+		dto.Reason = outcome.Reason.Error()
+	}
+
+	return dto
+}
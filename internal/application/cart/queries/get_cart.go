@@ -0,0 +1,84 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"time"
+)
+
+// CartItemDTO represents the data transfer object for a cart item
+type CartItemDTO struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
+	Quantity  int    `json:"quantity"`
+}
+
+// CartDTO represents the data transfer object for cart information
+type CartDTO struct {
+	ID         string         `json:"id"`
+	UserID     string         `json:"user_id,omitempty"`
+	Guest      bool           `json:"guest"`
+	Items      []*CartItemDTO `json:"items"`
+	TotalItems int            `json:"total_items"`
+	CouponCode string         `json:"coupon_code,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+// GetCartQuery represents the query to get a cart by ID
+type GetCartQuery struct {
+	ID string
+}
+
+// GetCartHandler handles the GetCartQuery
+type GetCartHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewGetCartHandler creates a new GetCartHandler
+func NewGetCartHandler(cartRepo cart.Repository) *GetCartHandler {
+	return &GetCartHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle processes the GetCartQuery
+func (h *GetCartHandler) Handle(ctx context.Context, query GetCartQuery) (*CartDTO, error) {
+	// Convert ID string to domain ID
+	id, err := cart.NewID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the cart
+	c, err := h.cartRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain cart to DTO
+	return toCartDTO(c), nil
+}
+
+// toCartDTO maps a domain cart to a DTO
+func toCartDTO(c *cart.Cart) *CartDTO {
+	items := make([]*CartItemDTO, len(c.Items()))
+	for i, item := range c.Items() {
+		items[i] = &CartItemDTO{
+			ProductID: item.ProductID().String(),
+			VariantID: item.VariantID().String(),
+			Quantity:  item.Quantity(),
+		}
+	}
+
+	return &CartDTO{
+		ID:         c.ID().String(),
+		UserID:     c.UserID().String(),
+		Guest:      c.IsGuest(),
+		Items:      items,
+		TotalItems: c.TotalItems(),
+		CouponCode: c.CouponCode(),
+		CreatedAt:  c.CreatedAt(),
+		UpdatedAt:  c.UpdatedAt(),
+	}
+}
@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+)
+
+// GetGuestCartQuery represents the query to get the cart of an anonymous visitor by its session token
+type GetGuestCartQuery struct {
+	SessionToken string
+}
+
+// GetGuestCartHandler handles the GetGuestCartQuery
+type GetGuestCartHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewGetGuestCartHandler creates a new GetGuestCartHandler
+func NewGetGuestCartHandler(cartRepo cart.Repository) *GetGuestCartHandler {
+	return &GetGuestCartHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle processes the GetGuestCartQuery
+func (h *GetGuestCartHandler) Handle(ctx context.Context, query GetGuestCartQuery) (*CartDTO, error) {
+	// Find the cart
+	c, err := h.cartRepo.FindBySessionToken(ctx, cart.SessionToken(query.SessionToken))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain cart to DTO
+	return toCartDTO(c), nil
+}
@@ -0,0 +1,63 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"time"
+)
+
+// DefaultRecoveryWindowDays is the default number of days the recovery report covers
+const DefaultRecoveryWindowDays = 30
+
+// RecoveryReportDTO represents how well reminders about abandoned carts brought customers back
+type RecoveryReportDTO struct {
+	Days           int     `json:"days"`
+	RemindersSent  int     `json:"reminders_sent"`
+	CartsReminded  int     `json:"carts_reminded"`
+	CartsRestored  int     `json:"carts_restored"`
+	CartsRecovered int     `json:"carts_recovered"`
+	RestoreRate    float64 `json:"restore_rate"`
+	RecoveryRate   float64 `json:"recovery_rate"`
+}
+
+// GetRecoveryReportQuery represents the query to report on the carts reminded in the last Days days
+type GetRecoveryReportQuery struct {
+	Days int
+}
+
+// GetRecoveryReportHandler handles the GetRecoveryReportQuery
+type GetRecoveryReportHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewGetRecoveryReportHandler creates a new GetRecoveryReportHandler
+func NewGetRecoveryReportHandler(cartRepo cart.Repository) *GetRecoveryReportHandler {
+	return &GetRecoveryReportHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle processes the GetRecoveryReportQuery
+func (h *GetRecoveryReportHandler) Handle(ctx context.Context, query GetRecoveryReportQuery) (*RecoveryReportDTO, error) {
+	// Apply the default window
+	if query.Days <= 0 {
+		query.Days = DefaultRecoveryWindowDays
+	}
+
+	// Count the reminded, restored and recovered carts
+	stats, err := h.cartRepo.RecoveryStats(ctx, time.Now().AddDate(0, 0, -query.Days))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the stats to a DTO
+	return &RecoveryReportDTO{
+		Days:           query.Days,
+		RemindersSent:  stats.RemindersSent(),
+		CartsReminded:  stats.Reminded(),
+		CartsRestored:  stats.Restored(),
+		CartsRecovered: stats.Recovered(),
+		RestoreRate:    stats.RestoreRate(),
+		RecoveryRate:   stats.RecoveryRate(),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/user"
+)
+
+// GetUserCartQuery represents the query to get the cart of a user
+type GetUserCartQuery struct {
+	UserID string
+}
+
+// GetUserCartHandler handles the GetUserCartQuery
+type GetUserCartHandler struct {
+	cartRepo cart.Repository
+}
+
+// NewGetUserCartHandler creates a new GetUserCartHandler
+func NewGetUserCartHandler(cartRepo cart.Repository) *GetUserCartHandler {
+	return &GetUserCartHandler{
+		cartRepo: cartRepo,
+	}
+}
+
+// Handle processes the GetUserCartQuery
+func (h *GetUserCartHandler) Handle(ctx context.Context, query GetUserCartQuery) (*CartDTO, error) {
+	// Convert ID string to domain ID
+	userID, err := user.NewID(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the cart
+	c, err := h.cartRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain cart to DTO
+	return toCartDTO(c), nil
+}
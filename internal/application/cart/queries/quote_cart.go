@@ -0,0 +1,164 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/application/pricing"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/shipping"
+)
+
+// TaxLineDTO represents the data transfer object for a tax line
+type TaxLineDTO struct {
+	Name      string  `json:"name"`
+	Rate      float64 `json:"rate"`
+	Amount    float64 `json:"amount"`
+	Inclusive bool    `json:"inclusive"`
+}
+
+// QuoteLineDTO represents a priced cart line
+type QuoteLineDTO struct {
+	ProductID string        `json:"product_id"`
+	VariantID string        `json:"variant_id,omitempty"`
+	SKU       string        `json:"sku,omitempty"`
+	Name      string        `json:"name"`
+	Quantity  int           `json:"quantity"`
+	UnitPrice float64       `json:"unit_price"`
+	LineTotal float64       `json:"line_total"`
+	Discount  float64       `json:"discount"`
+	Taxes     []*TaxLineDTO `json:"taxes"`
+}
+
+// CartQuoteDTO represents the priced contents of a cart for a destination
+type CartQuoteDTO struct {
+	CartID           string                 `json:"cart_id"`
+	Country          string                 `json:"country"`
+	Region           string                 `json:"region"`
+	Lines            []*QuoteLineDTO        `json:"lines"`
+	Subtotal         float64                `json:"subtotal"`
+	Promotions       []*PromotionOutcomeDTO `json:"promotions"`
+	CouponCode       string                 `json:"coupon_code,omitempty"`
+	CouponError      string                 `json:"coupon_error,omitempty"`
+	DiscountTotal    float64                `json:"discount_total"`
+	TaxTotal         float64                `json:"tax_total"`
+	ShippingMethod   string                 `json:"shipping_method,omitempty"`
+	ShippingCost     float64                `json:"shipping_cost"`
+	ShippingDiscount float64                `json:"shipping_discount"`
+	Total            float64                `json:"total"`
+}
+
+// QuoteCartQuery represents the query to price a cart for a destination.
+// The shipping method is optional; without it no shipping is charged.
+type QuoteCartQuery struct {
+	CartID         string
+	Country        string
+	Region         string
+	ShippingMethod string
+}
+
+// QuoteCartHandler handles the QuoteCartQuery
+type QuoteCartHandler struct {
+	cartRepo cart.Repository
+	pricer   *pricing.Pricer
+}
+
+// NewQuoteCartHandler creates a new QuoteCartHandler
+func NewQuoteCartHandler(cartRepo cart.Repository, pricer *pricing.Pricer) *QuoteCartHandler {
+	return &QuoteCartHandler{
+		cartRepo: cartRepo,
+		pricer:   pricer,
+	}
+}
+
+// Handle processes the QuoteCartQuery
+func (h *QuoteCartHandler) Handle(ctx context.Context, query QuoteCartQuery) (*CartQuoteDTO, error) {
+	// Convert strings to domain values
+	cartID, err := cart.NewID(query.CartID)
+	if err != nil {
+		return nil, err
+	}
+
+	country, err := shipping.NewCountryCode(query.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the cart
+	c, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Price the cart
+	quote, err := h.pricer.Price(ctx, pricing.Request{
+		Items:          pricingItems(c),
+		UserID:         c.UserID(),
+		Country:        country,
+		Region:         query.Region,
+		ShippingMethod: query.ShippingMethod,
+		CouponCode:     c.CouponCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the quote to a DTO
+	lines := make([]*QuoteLineDTO, len(quote.Lines))
+	for i, line := range quote.Lines {
+		taxes := make([]*TaxLineDTO, len(line.Taxes))
+		for j, taxLine := range line.Taxes {
+			taxes[j] = &TaxLineDTO{
+				Name:      taxLine.Name,
+				Rate:      taxLine.Rate,
+				Amount:    taxLine.Amount,
+				Inclusive: taxLine.Inclusive,
+			}
+		}
+
+		lines[i] = &QuoteLineDTO{
+			ProductID: line.Product.ID().String(),
+			VariantID: line.VariantID(),
+			SKU:       line.SKU(),
+			Name:      line.Product.Name().String(),
+			Quantity:  line.Quantity,
+			UnitPrice: line.UnitPrice,
+			LineTotal: line.LineTotal,
+			Discount:  line.Discount,
+			Taxes:     taxes,
+		}
+	}
+
+	dto := &CartQuoteDTO{
+		CartID:           c.ID().String(),
+		Country:          country.String(),
+		Region:           query.Region,
+		Lines:            lines,
+		Subtotal:         quote.Subtotal,
+		Promotions:       toPromotionOutcomeDTOs(quote),
+		CouponCode:       c.CouponCode(),
+		DiscountTotal:    quote.DiscountTotal,
+		TaxTotal:         quote.TaxTotal,
+		ShippingMethod:   quote.ShippingMethod,
+		ShippingCost:     quote.ShippingCost,
+		ShippingDiscount: quote.ShippingDiscount,
+		Total:            quote.Total,
+	}
+
+	if quote.CouponError != nil {
+		dto.CouponError = quote.CouponError.Error()
+	}
+
+	return dto, nil
+}
+
+// pricingItems converts the items of a cart to pricing items
+func pricingItems(c *cart.Cart) []pricing.Item {
+	items := make([]pricing.Item, len(c.Items()))
+	for i, item := range c.Items() {
+		items[i] = pricing.Item{
+			ProductID: item.ProductID(),
+			VariantID: item.VariantID(),
+			Quantity:  item.Quantity(),
+		}
+	}
+	return items
+}
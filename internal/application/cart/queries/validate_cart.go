@@ -0,0 +1,201 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/application/pricing"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/shipping"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CartWarningDTO represents a warning about a cart line that no longer matches the live catalog
+type CartWarningDTO struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// ValidatedLineDTO represents a cart line checked against the live catalog
+type ValidatedLineDTO struct {
+	ProductID         string            `json:"product_id"`
+	VariantID         string            `json:"variant_id,omitempty"`
+	Name              string            `json:"name,omitempty"`
+	Quantity          int               `json:"quantity"`
+	AvailableQuantity int               `json:"available_quantity"`
+	ShownPrice        float64           `json:"shown_price"`
+	UnitPrice         float64           `json:"unit_price"`
+	LineTotal         float64           `json:"line_total"`
+	Discount          float64           `json:"discount"`
+	Warnings          []*CartWarningDTO `json:"warnings"`
+}
+
+// CartValidationDTO represents a cart checked against the live catalog, priced as it can be ordered
+type CartValidationDTO struct {
+	CartID         string              `json:"cart_id"`
+	Valid          bool                `json:"valid"`
+	Adjusted       bool                `json:"adjusted"`
+	Lines          []*ValidatedLineDTO `json:"lines"`
+	Subtotal       float64             `json:"subtotal"`
+	CouponCode     string              `json:"coupon_code,omitempty"`
+	CouponError    string              `json:"coupon_error,omitempty"`
+	DiscountTotal  float64             `json:"discount_total"`
+	TaxTotal       float64             `json:"tax_total"`
+	ShippingMethod string              `json:"shipping_method,omitempty"`
+	ShippingCost   float64             `json:"shipping_cost"`
+	Total          float64             `json:"total"`
+}
+
+// ValidateCartQuery represents the query to check a cart against the live catalog.
+// The destination is optional; without a country no taxes or shipping are calculated.
+type ValidateCartQuery struct {
+	CartID         string
+	Country        string
+	Region         string
+	ShippingMethod string
+}
+
+// ValidateCartHandler handles the ValidateCartQuery
+type ValidateCartHandler struct {
+	cartRepo    cart.Repository
+	productRepo product.Repository
+	pricer      *pricing.Pricer
+}
+
+// NewValidateCartHandler creates a new ValidateCartHandler
+func NewValidateCartHandler(cartRepo cart.Repository, productRepo product.Repository, pricer *pricing.Pricer) *ValidateCartHandler {
+	return &ValidateCartHandler{
+		cartRepo:    cartRepo,
+		productRepo: productRepo,
+		pricer:      pricer,
+	}
+}
+
+// Handle processes the ValidateCartQuery. Each line is checked for changed prices, reduced stock and
+// unavailable products; the totals cover the lines that can be ordered, at the quantities available.
+func (h *ValidateCartHandler) Handle(ctx context.Context, query ValidateCartQuery) (*CartValidationDTO, error) {
+	// Convert strings to domain values
+	cartID, err := cart.NewID(query.CartID)
+	if err != nil {
+		return nil, err
+	}
+
+	var country shipping.CountryCode
+	if query.Country != "" {
+		if country, err = shipping.NewCountryCode(query.Country); err != nil {
+			return nil, err
+		}
+	}
+
+	// Find the cart
+	c, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check each line against its product
+	now := time.Now()
+	dto := &CartValidationDTO{
+		CartID:     c.ID().String(),
+		Valid:      true,
+		Lines:      make([]*ValidatedLineDTO, len(c.Items())),
+		CouponCode: c.CouponCode(),
+	}
+
+	items := []pricing.Item{}
+	orderable := []*ValidatedLineDTO{}
+	for i, item := range c.Items() {
+		p, err := h.productRepo.FindByID(ctx, item.ProductID())
+		if err != nil && !errors.Is(err, product.ErrProductNotFound) {
+			return nil, err
+		}
+
+		check := cart.CheckItem(item, p, now)
+		dto.Lines[i] = toValidatedLineDTO(check)
+		if len(check.Warnings()) > 0 {
+			dto.Valid = false
+		}
+
+		if check.IsOrderable() {
+			items = append(items, pricing.Item{
+				ProductID: item.ProductID(),
+				VariantID: item.VariantID(),
+				Quantity:  check.Available(),
+			})
+			orderable = append(orderable, dto.Lines[i])
+		}
+	}
+
+	if len(items) == 0 {
+		return dto, nil
+	}
+
+	// Price the lines that can be ordered
+	quote, err := h.pricer.Price(ctx, pricing.Request{
+		Items:          items,
+		UserID:         c.UserID(),
+		Country:        country,
+		Region:         query.Region,
+		ShippingMethod: query.ShippingMethod,
+		CouponCode:     c.CouponCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, line := range quote.Lines {
+		orderable[i].LineTotal = line.LineTotal
+		orderable[i].Discount = line.Discount
+	}
+
+	dto.Subtotal = quote.Subtotal
+	dto.DiscountTotal = quote.DiscountTotal
+	dto.TaxTotal = quote.TaxTotal
+	dto.ShippingMethod = quote.ShippingMethod
+	dto.ShippingCost = quote.ShippingCost
+	dto.Total = quote.Total
+
+	if quote.CouponError != nil {
+		dto.CouponError = quote.CouponError.Error()
+	}
+
+	return dto, nil
+}
+
+// toValidatedLineDTO maps the check of a cart line to a DTO
+func toValidatedLineDTO(check *cart.ItemCheck) *ValidatedLineDTO {
+	item := check.Item()
+	line := &ValidatedLineDTO{
+		ProductID:         item.ProductID().String(),
+		VariantID:         item.VariantID().String(),
+		Quantity:          item.Quantity(),
+		AvailableQuantity: check.Available(),
+		ShownPrice:        item.Price().Value(),
+		UnitPrice:         check.UnitPrice().Value(),
+		Warnings:          make([]*CartWarningDTO, len(check.Warnings())),
+	}
+
+	if check.Product() != nil {
+		line.Name = check.Product().Name().String()
+	}
+
+	for i, warning := range check.Warnings() {
+		var message string
+		switch warning {
+		case cart.WarningPriceChanged:
+			message = fmt.Sprintf("Price changed from %.2f to %.2f", item.Price().Value(), check.UnitPrice().Value())
+		case cart.WarningStockReduced:
+			message = fmt.Sprintf("Only %d left in stock", check.Available())
+		case cart.WarningUnavailable:
+			message = "No longer available"
+		}
+
+		line.Warnings[i] = &CartWarningDTO{
+			Type:    string(warning),
+			Message: message,
+		}
+	}
+
+	return line
+}
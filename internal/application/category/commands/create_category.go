@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+)
+
+// CreateCategoryCommand represents the command to create a new category.
+// The slug is derived from the name when empty; without a parent the category is a root.
+type CreateCategoryCommand struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	ParentID    string `json:"parent_id"`
+}
+
+// CreateCategoryHandler handles the CreateCategoryCommand
+type CreateCategoryHandler struct {
+	categoryRepo category.Repository
+}
+
+// NewCreateCategoryHandler creates a new CreateCategoryHandler
+func NewCreateCategoryHandler(categoryRepo category.Repository) *CreateCategoryHandler {
+	return &CreateCategoryHandler{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Handle processes the CreateCategoryCommand
+func (h *CreateCategoryHandler) Handle(ctx context.Context, cmd CreateCategoryCommand) (string, error) {
+	// Check if the parent exists
+	parentID := category.ID(cmd.ParentID)
+	if parentID != "" {
+		if _, err := h.categoryRepo.FindByID(ctx, parentID); err != nil {
+			return "", err
+		}
+	}
+
+	// Create a new category
+	newCategory, err := category.NewCategory(cmd.Name, cmd.Slug, cmd.Description, parentID)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if the slug is already in use
+	if _, err := h.categoryRepo.FindBySlug(ctx, newCategory.Slug()); err == nil {
+		return "", category.ErrSlugTaken
+	}
+
+	// Save the category
+	if err := h.categoryRepo.Save(ctx, newCategory); err != nil {
+		return "", err
+	}
+
+	return newCategory.ID().String(), nil
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+)
+
+// DeleteCategoryCommand represents the command to delete a category
+type DeleteCategoryCommand struct {
+	ID string
+}
+
+// DeleteCategoryHandler handles the DeleteCategoryCommand
+type DeleteCategoryHandler struct {
+	categoryRepo category.Repository
+}
+
+// NewDeleteCategoryHandler creates a new DeleteCategoryHandler
+func NewDeleteCategoryHandler(categoryRepo category.Repository) *DeleteCategoryHandler {
+	return &DeleteCategoryHandler{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Handle processes the DeleteCategoryCommand.
+// Categories with subcategories cannot be deleted; product assignments are removed.
+func (h *DeleteCategoryHandler) Handle(ctx context.Context, cmd DeleteCategoryCommand) error {
+	// Convert ID string to domain ID
+	id, err := category.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check if category exists and has no subcategories
+	categories, err := h.categoryRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	tree := category.NewTree(categories)
+	if _, ok := tree.Find(id); !ok {
+		return category.ErrCategoryNotFound
+	}
+
+	if len(tree.Children(id)) > 0 {
+		return category.ErrHasChildren
+	}
+
+	// Delete the category
+	return h.categoryRepo.Delete(ctx, id)
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+)
+
+// UpdateCategoryCommand represents the command to update or move a category.
+// Pointer fields are only applied when provided; an empty parent ID moves the category to the root.
+type UpdateCategoryCommand struct {
+	ID          string  `json:"-"`
+	Name        string  `json:"name"`
+	Slug        string  `json:"slug"`
+	Description *string `json:"description"`
+	ParentID    *string `json:"parent_id"`
+}
+
+// UpdateCategoryHandler handles the UpdateCategoryCommand
+type UpdateCategoryHandler struct {
+	categoryRepo category.Repository
+}
+
+// NewUpdateCategoryHandler creates a new UpdateCategoryHandler
+func NewUpdateCategoryHandler(categoryRepo category.Repository) *UpdateCategoryHandler {
+	return &UpdateCategoryHandler{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Handle processes the UpdateCategoryCommand
+func (h *UpdateCategoryHandler) Handle(ctx context.Context, cmd UpdateCategoryCommand) error {
+	// Convert ID string to domain ID
+	id, err := category.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the category
+	existingCategory, err := h.categoryRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Update category fields if provided
+	if cmd.Name != "" {
+		if err := existingCategory.ChangeName(cmd.Name); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Slug != "" && cmd.Slug != existingCategory.Slug().String() {
+		if err := existingCategory.ChangeSlug(cmd.Slug); err != nil {
+			return err
+		}
+
+		if _, err := h.categoryRepo.FindBySlug(ctx, existingCategory.Slug()); err == nil {
+			return category.ErrSlugTaken
+		}
+	}
+
+	if cmd.Description != nil {
+		existingCategory.ChangeDescription(*cmd.Description)
+	}
+
+	// Move the category, rejecting cycles
+	if cmd.ParentID != nil && category.ID(*cmd.ParentID) != existingCategory.ParentID() {
+		categories, err := h.categoryRepo.List(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := existingCategory.MoveTo(category.ID(*cmd.ParentID), category.NewTree(categories)); err != nil {
+			return err
+		}
+	}
+
+	// Save the updated category
+	return h.categoryRepo.Update(ctx, existingCategory)
+}
@@ -0,0 +1,93 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+	"time"
+)
+
+// CategoryRefDTO represents a reference to a category in breadcrumbs and child lists
+type CategoryRefDTO struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// CategoryDTO represents the data transfer object for category information
+type CategoryDTO struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Slug        string            `json:"slug"`
+	Description string            `json:"description"`
+	ParentID    string            `json:"parent_id,omitempty"`
+	Breadcrumb  []*CategoryRefDTO `json:"breadcrumb"`
+	Children    []*CategoryRefDTO `json:"children"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// GetCategoryQuery represents the query to get a category by ID or, when the ID is empty, by slug
+type GetCategoryQuery struct {
+	ID   string
+	Slug string
+}
+
+// GetCategoryHandler handles the GetCategoryQuery
+type GetCategoryHandler struct {
+	categoryRepo category.Repository
+}
+
+// NewGetCategoryHandler creates a new GetCategoryHandler
+func NewGetCategoryHandler(categoryRepo category.Repository) *GetCategoryHandler {
+	return &GetCategoryHandler{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Handle processes the GetCategoryQuery
+func (h *GetCategoryHandler) Handle(ctx context.Context, query GetCategoryQuery) (*CategoryDTO, error) {
+	// Find the category
+	var c *category.Category
+	var err error
+	if query.ID != "" {
+		c, err = h.categoryRepo.FindByID(ctx, category.ID(query.ID))
+	} else {
+		c, err = h.categoryRepo.FindBySlug(ctx, category.Slug(query.Slug))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the taxonomy for the breadcrumb and children
+	categories, err := h.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree := category.NewTree(categories)
+
+	// Map domain category to DTO
+	return &CategoryDTO{
+		ID:          c.ID().String(),
+		Name:        c.Name(),
+		Slug:        c.Slug().String(),
+		Description: c.Description(),
+		ParentID:    c.ParentID().String(),
+		Breadcrumb:  toCategoryRefDTOs(tree.Path(c.ID())),
+		Children:    toCategoryRefDTOs(tree.Children(c.ID())),
+		CreatedAt:   c.CreatedAt(),
+		UpdatedAt:   c.UpdatedAt(),
+	}, nil
+}
+
+// toCategoryRefDTOs maps domain categories to reference DTOs
+func toCategoryRefDTOs(categories []*category.Category) []*CategoryRefDTO {
+	result := make([]*CategoryRefDTO, len(categories))
+	for i, c := range categories {
+		result[i] = &CategoryRefDTO{
+			ID:   c.ID().String(),
+			Name: c.Name(),
+			Slug: c.Slug().String(),
+		}
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+)
+
+// CategoryNodeDTO represents a category with its nested subcategories
+type CategoryNodeDTO struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Slug        string             `json:"slug"`
+	Description string             `json:"description"`
+	Children    []*CategoryNodeDTO `json:"children"`
+}
+
+// GetCategoryTreeQuery represents the query to get the whole category taxonomy
+type GetCategoryTreeQuery struct{}
+
+// GetCategoryTreeHandler handles the GetCategoryTreeQuery
+type GetCategoryTreeHandler struct {
+	categoryRepo category.Repository
+}
+
+// NewGetCategoryTreeHandler creates a new GetCategoryTreeHandler
+func NewGetCategoryTreeHandler(categoryRepo category.Repository) *GetCategoryTreeHandler {
+	return &GetCategoryTreeHandler{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Handle processes the GetCategoryTreeQuery
+func (h *GetCategoryTreeHandler) Handle(ctx context.Context, query GetCategoryTreeQuery) ([]*CategoryNodeDTO, error) {
+	// Get categories from repository
+	categories, err := h.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the tree to nested DTOs, starting from the roots
+	tree := category.NewTree(categories)
+	return toCategoryNodeDTOs(tree, tree.Roots()), nil
+}
+
+// toCategoryNodeDTOs maps categories and their descendants to nested DTOs
+func toCategoryNodeDTOs(tree *category.Tree, categories []*category.Category) []*CategoryNodeDTO {
+	result := make([]*CategoryNodeDTO, len(categories))
+	for i, c := range categories {
+		result[i] = &CategoryNodeDTO{
+			ID:          c.ID().String(),
+			Name:        c.Name(),
+			Slug:        c.Slug().String(),
+			Description: c.Description(),
+			Children:    toCategoryNodeDTOs(tree, tree.Children(c.ID())),
+		}
+	}
+	return result
+}
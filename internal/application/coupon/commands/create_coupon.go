@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/coupon"
+	"time"
+)
+
+// CreateCouponCommand represents the command to create a coupon
+type CreateCouponCommand struct {
+	Code          string     `json:"code"`
+	Type          string     `json:"type"`
+	Value         float64    `json:"value"`
+	BuyQuantity   int        `json:"buy_quantity"`
+	GetQuantity   int        `json:"get_quantity"`
+	MinOrderValue float64    `json:"min_order_value"`
+	ValidFrom     *time.Time `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	UsageLimit    int        `json:"usage_limit"`
+	PerUserLimit  int        `json:"per_user_limit"`
+	ProductIDs    []string   `json:"product_ids"`
+	CategoryIDs   []string   `json:"category_ids"`
+}
+
+// CreateCouponHandler handles the CreateCouponCommand
+type CreateCouponHandler struct {
+	couponRepo coupon.Repository
+}
+
+// NewCreateCouponHandler creates a new CreateCouponHandler
+func NewCreateCouponHandler(couponRepo coupon.Repository) *CreateCouponHandler {
+	return &CreateCouponHandler{
+		couponRepo: couponRepo,
+	}
+}
+
+// Handle processes the CreateCouponCommand
+func (h *CreateCouponHandler) Handle(ctx context.Context, cmd CreateCouponCommand) (string, error) {
+	// Create a new coupon
+	newCoupon, err := coupon.NewCoupon(cmd.Code, cmd.Type, cmd.Value, cmd.BuyQuantity, cmd.GetQuantity)
+	if err != nil {
+		return "", err
+	}
+
+	// Apply the coupon terms
+	if err := newCoupon.ChangeMinOrderValue(cmd.MinOrderValue); err != nil {
+		return "", err
+	}
+
+	if err := newCoupon.ChangeValidity(timeOrZero(cmd.ValidFrom), timeOrZero(cmd.ValidUntil)); err != nil {
+		return "", err
+	}
+
+	if err := newCoupon.ChangeUsageLimits(cmd.UsageLimit, cmd.PerUserLimit); err != nil {
+		return "", err
+	}
+
+	if err := newCoupon.ChangeEligibility(cmd.ProductIDs, cmd.CategoryIDs); err != nil {
+		return "", err
+	}
+
+	// Save the coupon
+	if err := h.couponRepo.Save(ctx, newCoupon); err != nil {
+		return "", err
+	}
+
+	return newCoupon.ID().String(), nil
+}
+
+// timeOrZero returns the pointed-to time or the zero time when nil
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
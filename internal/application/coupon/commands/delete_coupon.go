@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/coupon"
+)
+
+// DeleteCouponCommand represents the command to delete a coupon
+type DeleteCouponCommand struct {
+	ID string
+}
+
+// DeleteCouponHandler handles the DeleteCouponCommand
+type DeleteCouponHandler struct {
+	couponRepo coupon.Repository
+}
+
+// NewDeleteCouponHandler creates a new DeleteCouponHandler
+func NewDeleteCouponHandler(couponRepo coupon.Repository) *DeleteCouponHandler {
+	return &DeleteCouponHandler{
+		couponRepo: couponRepo,
+	}
+}
+
+// Handle processes the DeleteCouponCommand
+func (h *DeleteCouponHandler) Handle(ctx context.Context, cmd DeleteCouponCommand) error {
+	// Convert ID string to domain ID
+	id, err := coupon.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check if coupon exists
+	if _, err := h.couponRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	// Delete the coupon
+	return h.couponRepo.Delete(ctx, id)
+}
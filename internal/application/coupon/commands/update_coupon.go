@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/coupon"
+	"time"
+)
+
+// UpdateCouponCommand represents the command to update the terms of a coupon.
+// Pointer and slice fields are only applied when provided.
+type UpdateCouponCommand struct {
+	ID            string     `json:"-"`
+	MinOrderValue *float64   `json:"min_order_value"`
+	ValidFrom     *time.Time `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	UsageLimit    *int       `json:"usage_limit"`
+	PerUserLimit  *int       `json:"per_user_limit"`
+	ProductIDs    []string   `json:"product_ids"`
+	CategoryIDs   []string   `json:"category_ids"`
+	Active        *bool      `json:"active"`
+}
+
+// UpdateCouponHandler handles the UpdateCouponCommand
+type UpdateCouponHandler struct {
+	couponRepo coupon.Repository
+}
+
+// NewUpdateCouponHandler creates a new UpdateCouponHandler
+func NewUpdateCouponHandler(couponRepo coupon.Repository) *UpdateCouponHandler {
+	return &UpdateCouponHandler{
+		couponRepo: couponRepo,
+	}
+}
+
+// Handle processes the UpdateCouponCommand
+func (h *UpdateCouponHandler) Handle(ctx context.Context, cmd UpdateCouponCommand) error {
+	// Convert ID string to domain ID
+	id, err := coupon.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the coupon
+	existingCoupon, err := h.couponRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Update coupon terms if provided
+	if cmd.MinOrderValue != nil {
+		if err := existingCoupon.ChangeMinOrderValue(*cmd.MinOrderValue); err != nil {
+			return err
+		}
+	}
+
+	if cmd.ValidFrom != nil || cmd.ValidUntil != nil {
+		validFrom := existingCoupon.ValidFrom()
+		if cmd.ValidFrom != nil {
+			validFrom = *cmd.ValidFrom
+		}
+
+		validUntil := existingCoupon.ValidUntil()
+		if cmd.ValidUntil != nil {
+			validUntil = *cmd.ValidUntil
+		}
+
+		if err := existingCoupon.ChangeValidity(validFrom, validUntil); err != nil {
+			return err
+		}
+	}
+
+	if cmd.UsageLimit != nil || cmd.PerUserLimit != nil {
+		usageLimit := existingCoupon.UsageLimit()
+		if cmd.UsageLimit != nil {
+			usageLimit = *cmd.UsageLimit
+		}
+
+		perUserLimit := existingCoupon.PerUserLimit()
+		if cmd.PerUserLimit != nil {
+			perUserLimit = *cmd.PerUserLimit
+		}
+
+		if err := existingCoupon.ChangeUsageLimits(usageLimit, perUserLimit); err != nil {
+			return err
+		}
+	}
+
+	if cmd.ProductIDs != nil || cmd.CategoryIDs != nil {
+		productIDs := cmd.ProductIDs
+		if productIDs == nil {
+			productIDs = make([]string, len(existingCoupon.ProductIDs()))
+			for i, productID := range existingCoupon.ProductIDs() {
+				productIDs[i] = productID.String()
+			}
+		}
+
+		categoryIDs := cmd.CategoryIDs
+		if categoryIDs == nil {
+			categoryIDs = existingCoupon.CategoryIDs()
+		}
+
+		if err := existingCoupon.ChangeEligibility(productIDs, categoryIDs); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Active != nil {
+		if *cmd.Active {
+			existingCoupon.Activate()
+		} else {
+			existingCoupon.Deactivate()
+		}
+	}
+
+	// Save the updated coupon
+	return h.couponRepo.Update(ctx, existingCoupon)
+}
@@ -0,0 +1,99 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/coupon"
+	"time"
+)
+
+// CouponDTO represents the data transfer object for a coupon
+type CouponDTO struct {
+	ID            string     `json:"id"`
+	Code          string     `json:"code"`
+	Type          string     `json:"type"`
+	Value         float64    `json:"value"`
+	BuyQuantity   int        `json:"buy_quantity"`
+	GetQuantity   int        `json:"get_quantity"`
+	MinOrderValue float64    `json:"min_order_value"`
+	ValidFrom     *time.Time `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	UsageLimit    int        `json:"usage_limit"`
+	PerUserLimit  int        `json:"per_user_limit"`
+	TimesUsed     int        `json:"times_used"`
+	ProductIDs    []string   `json:"product_ids"`
+	CategoryIDs   []string   `json:"category_ids"`
+	Active        bool       `json:"active"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// GetCouponQuery represents the query to get a coupon by ID
+type GetCouponQuery struct {
+	ID string
+}
+
+// GetCouponHandler handles the GetCouponQuery
+type GetCouponHandler struct {
+	couponRepo coupon.Repository
+}
+
+// NewGetCouponHandler creates a new GetCouponHandler
+func NewGetCouponHandler(couponRepo coupon.Repository) *GetCouponHandler {
+	return &GetCouponHandler{
+		couponRepo: couponRepo,
+	}
+}
+
+// Handle processes the GetCouponQuery
+func (h *GetCouponHandler) Handle(ctx context.Context, query GetCouponQuery) (*CouponDTO, error) {
+	// Convert ID string to domain ID
+	id, err := coupon.NewID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the coupon
+	c, err := h.couponRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain coupon to DTO
+	return toCouponDTO(c), nil
+}
+
+// toCouponDTO maps a domain coupon to a DTO
+func toCouponDTO(c *coupon.Coupon) *CouponDTO {
+	productIDs := make([]string, len(c.ProductIDs()))
+	for i, productID := range c.ProductIDs() {
+		productIDs[i] = productID.String()
+	}
+
+	return &CouponDTO{
+		ID:            c.ID().String(),
+		Code:          c.Code().String(),
+		Type:          string(c.Type()),
+		Value:         c.Value(),
+		BuyQuantity:   c.BuyQuantity(),
+		GetQuantity:   c.GetQuantity(),
+		MinOrderValue: c.MinOrderValue(),
+		ValidFrom:     timePtr(c.ValidFrom()),
+		ValidUntil:    timePtr(c.ValidUntil()),
+		UsageLimit:    c.UsageLimit(),
+		PerUserLimit:  c.PerUserLimit(),
+		TimesUsed:     c.TimesUsed(),
+		ProductIDs:    productIDs,
+		CategoryIDs:   c.CategoryIDs(),
+		Active:        c.IsActive(),
+		CreatedAt:     c.CreatedAt(),
+		UpdatedAt:     c.UpdatedAt(),
+	}
+}
+
+// timePtr returns nil for the zero time so open validity bounds serialize as null
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
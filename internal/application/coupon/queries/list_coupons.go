@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/coupon"
+)
+
+// ListCouponsQuery represents the query to list coupons with pagination
+type ListCouponsQuery struct {
+	Limit  int
+	Offset int
+}
+
+// ListCouponsHandler handles the ListCouponsQuery
+type ListCouponsHandler struct {
+	couponRepo coupon.Repository
+}
+
+// NewListCouponsHandler creates a new ListCouponsHandler
+func NewListCouponsHandler(couponRepo coupon.Repository) *ListCouponsHandler {
+	return &ListCouponsHandler{
+		couponRepo: couponRepo,
+	}
+}
+
+// Handle processes the ListCouponsQuery
+func (h *ListCouponsHandler) Handle(ctx context.Context, query ListCouponsQuery) ([]*CouponDTO, error) {
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Get coupons from repository
+	coupons, err := h.couponRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain coupons to DTOs
+	result := make([]*CouponDTO, len(coupons))
+	for i, c := range coupons {
+		result[i] = toCouponDTO(c)
+	}
+
+	return result, nil
+}
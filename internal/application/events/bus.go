@@ -0,0 +1,58 @@
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// Event represents something that happened in the domain
+type Event interface {
+	Name() string
+}
+
+// Handler reacts to an event
+type Handler func(ctx context.Context, event Event) error
+
+// Publisher publishes events to their subscribers
+type Publisher interface {
+	Publish(ctx context.Context, events ...Event)
+}
+
+// Bus is an in-process Publisher that runs the handlers subscribed to an event
+// synchronously, in subscription order
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewBus creates a new Bus
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+// Subscribe registers a handler for the events with the given name
+func (b *Bus) Subscribe(name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// Publish runs the handlers of each event. The change an event describes has already
+// happened, so handler errors are logged rather than returned to the publisher.
+func (b *Bus) Publish(ctx context.Context, events ...Event) {
+	for _, event := range events {
+		b.mu.RLock()
+		handlers := b.handlers[event.Name()]
+		b.mu.RUnlock()
+
+		for _, handler := range handlers {
+			if err := handler(ctx, event); err != nil {
+				log.Printf("Error handling %s event: %v", event.Name(), err)
+			}
+		}
+	}
+}
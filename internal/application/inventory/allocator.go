@@ -0,0 +1,154 @@
+package inventory
+
+import (
+	"context"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/warehouse"
+	"errors"
+	"sort"
+)
+
+// Allocation strategies
+const (
+	// StrategyNearest ships each line from the warehouses nearest to the shipping address
+	StrategyNearest = "nearest"
+
+	// StrategyFillFirst ships the whole order from the first warehouse by priority that can fill it,
+	// falling back to filling each line by priority
+	StrategyFillFirst = "fill_first"
+)
+
+// ErrInvalidStrategy is returned for an unknown allocation strategy
+var ErrInvalidStrategy = errors.New("invalid allocation strategy")
+
+// Line represents a quantity of a product, or of one of its variants, to ship; lines without
+// a quantity, such as backordered ones, get no allocations
+type Line struct {
+	Product   *product.Product
+	VariantID product.VariantID
+	Quantity  int
+}
+
+// Allocation represents the quantity of a line shipped from a warehouse
+type Allocation struct {
+	WarehouseID warehouse.ID
+	Quantity    int
+}
+
+// Allocator decides which warehouses the lines of an order are shipped from
+type Allocator struct {
+	warehouseRepo warehouse.Repository
+	strategy      string
+}
+
+// NewAllocator creates a new Allocator using the given strategy
+func NewAllocator(warehouseRepo warehouse.Repository, strategy string) (*Allocator, error) {
+	if strategy != StrategyNearest && strategy != StrategyFillFirst {
+		return nil, ErrInvalidStrategy
+	}
+
+	return &Allocator{
+		warehouseRepo: warehouseRepo,
+		strategy:      strategy,
+	}, nil
+}
+
+// Allocate returns the allocations of each line, in line order. A line is shipped from a single
+// warehouse when one holds enough stock, and is split across warehouses otherwise; it returns
+// product.ErrInsufficientStock when the warehouses together cannot fill a line.
+func (a *Allocator) Allocate(ctx context.Context, destination address.Address, lines []Line) ([][]Allocation, error) {
+	warehouses, err := a.warehouseRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.strategy == StrategyNearest {
+		// Warehouses are listed by priority, which breaks ties between equally near warehouses
+		sort.SliceStable(warehouses, func(i, j int) bool {
+			return warehouses[i].DistanceTo(destination) < warehouses[j].DistanceTo(destination)
+		})
+	}
+
+	if a.strategy == StrategyFillFirst {
+		for _, w := range warehouses {
+			if canFillAll(w.ID(), lines) {
+				allocations := make([][]Allocation, len(lines))
+				for i, line := range lines {
+					allocations[i] = []Allocation{}
+					if line.Quantity > 0 {
+						allocations[i] = []Allocation{{WarehouseID: w.ID(), Quantity: line.Quantity}}
+					}
+				}
+				return allocations, nil
+			}
+		}
+	}
+
+	allocations := make([][]Allocation, len(lines))
+	for i, line := range lines {
+		if line.Quantity == 0 {
+			allocations[i] = []Allocation{}
+			continue
+		}
+
+		lineAllocations, err := allocateLine(warehouses, line)
+		if err != nil {
+			return nil, err
+		}
+		allocations[i] = lineAllocations
+	}
+
+	return allocations, nil
+}
+
+// allocateLine ships a line from the first warehouse holding enough stock, or splits it
+// across the warehouses in order
+func allocateLine(warehouses []*warehouse.Warehouse, line Line) ([]Allocation, error) {
+	for _, w := range warehouses {
+		if line.Product.StockIn(w.ID(), line.VariantID).Value() >= line.Quantity {
+			return []Allocation{{WarehouseID: w.ID(), Quantity: line.Quantity}}, nil
+		}
+	}
+
+	allocations := []Allocation{}
+	remaining := line.Quantity
+	for _, w := range warehouses {
+		held := line.Product.StockIn(w.ID(), line.VariantID).Value()
+		if held <= 0 {
+			continue
+		}
+
+		quantity := min(held, remaining)
+		allocations = append(allocations, Allocation{WarehouseID: w.ID(), Quantity: quantity})
+
+		remaining -= quantity
+		if remaining == 0 {
+			return allocations, nil
+		}
+	}
+
+	return nil, product.ErrInsufficientStock
+}
+
+// canFillAll checks if a warehouse holds enough stock for every line, counting lines of the same
+// product or variant together
+func canFillAll(warehouseID warehouse.ID, lines []Line) bool {
+	needed := make(map[*product.Product]map[product.VariantID]int)
+	for _, line := range lines {
+		if needed[line.Product] == nil {
+			needed[line.Product] = make(map[product.VariantID]int)
+		}
+		needed[line.Product][line.VariantID] += line.Quantity
+	}
+
+	for p, variants := range needed {
+		for variantID, quantity := range variants {
+			if p.StockIn(warehouseID, variantID).Value() < quantity {
+				return false
+			}
+		}
+	}
+
+	return true
+}
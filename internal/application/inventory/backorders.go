@@ -0,0 +1,63 @@
+package inventory
+
+import (
+	"context"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+)
+
+// BackorderFiller records the stock allocated to backordered order items on their orders. It runs in the
+// unit of work saving the products the stock was taken from, so that the stock taken and its allocation
+// on the orders are written together.
+type BackorderFiller struct {
+	orderRepo order.Repository
+}
+
+// NewBackorderFiller creates a new BackorderFiller
+func NewBackorderFiller(orderRepo order.Repository) *BackorderFiller {
+	return &BackorderFiller{
+		orderRepo: orderRepo,
+	}
+}
+
+// Fill allocates the stock of the backorder allocated events among the events of saved products
+// to the backordered items of the orders waiting for it, saving each order once
+func (f *BackorderFiller) Fill(ctx context.Context, productEvents []product.Event) error {
+	orders := []*order.Order{}
+	byID := make(map[order.ID]*order.Order)
+	for _, event := range productEvents {
+		if event.Name() != product.EventBackorderAllocated {
+			continue
+		}
+
+		// Find the order
+		o, ok := byID[order.ID(event.OrderID())]
+		if !ok {
+			var err error
+			o, err = f.orderRepo.FindByID(ctx, order.ID(event.OrderID()))
+			if err != nil {
+				return err
+			}
+			byID[o.ID()] = o
+			orders = append(orders, o)
+		}
+
+		allocation, err := order.NewAllocation(event.WarehouseID().String(), event.Quantity())
+		if err != nil {
+			return err
+		}
+
+		if err := o.FillBackorder(order.ID(event.ItemID()), allocation); err != nil {
+			return err
+		}
+	}
+
+	// Save the orders
+	for _, o := range orders {
+		if err := f.orderRepo.Update(ctx, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package inventory
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/notification"
+	"e-commerce/internal/domain/product"
+	"fmt"
+)
+
+// LowStockAlerter notifies staff when the stock of a product, or of one of its variants,
+// falls to its reorder threshold
+type LowStockAlerter struct {
+	productRepo product.Repository
+	notifier    notification.Notifier
+	recipients  []string
+}
+
+// NewLowStockAlerter creates a new LowStockAlerter notifying the given recipients
+func NewLowStockAlerter(productRepo product.Repository, notifier notification.Notifier, recipients []string) *LowStockAlerter {
+	return &LowStockAlerter{
+		productRepo: productRepo,
+		notifier:    notifier,
+		recipients:  recipients,
+	}
+}
+
+// Subscribe subscribes the alerter to the low-stock events of a bus
+func (a *LowStockAlerter) Subscribe(bus *events.Bus) {
+	bus.Subscribe(product.EventLowStock, a.alert)
+}
+
+// alert notifies the recipients of the product or variant running low, doing nothing without recipients
+func (a *LowStockAlerter) alert(ctx context.Context, event events.Event) error {
+	productEvent, ok := event.(product.Event)
+	if !ok || len(a.recipients) == 0 {
+		return nil
+	}
+
+	p, err := a.productRepo.FindByID(ctx, productEvent.ProductID())
+	if err != nil {
+		return err
+	}
+
+	item := p.Name().String()
+	sku := p.SKU()
+	if productEvent.VariantID() != "" {
+		v, err := p.FindVariant(productEvent.VariantID())
+		if err != nil {
+			return err
+		}
+		sku = v.SKU()
+	}
+	if sku != "" {
+		item = fmt.Sprintf("%s (%s)", item, sku)
+	}
+
+	return a.notifier.Send(ctx, notification.Message{
+		To:      a.recipients,
+		Subject: "Low stock: " + item,
+		Body: fmt.Sprintf(
+			"%s is low on stock: %d left, at or below the reorder threshold of %d.",
+			item, productEvent.Stock().Value(), productEvent.Threshold().Value(),
+		),
+	})
+}
@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"context"
+	"time"
+)
+
+// DeliveryStatus represents how far the delivery of a message got
+type DeliveryStatus string
+
+const (
+	// DeliveryPending is a delivery waiting for its first attempt, or its next one after a transient failure
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliverySent is a delivery the notifier accepted
+	DeliverySent DeliveryStatus = "sent"
+	// DeliveryFailed is a delivery that failed for good or ran out of attempts
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// Delivery records a message sent through the notification service and the attempts at delivering it
+type Delivery struct {
+	ID string
+
+	// Template and Locale are the template the message was rendered from and its locale,
+	// empty for messages sent as they are
+	Template string
+	Locale   string
+
+	Message Message
+
+	// Redacted is set when secrets, such as the token of a password reset link, were removed from
+	// the logged message; the message holding them is only kept in memory until it is delivered
+	Redacted bool
+
+	Status        DeliveryStatus
+	Attempts      int
+	LastError     string
+	NextAttemptAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	SentAt        *time.Time
+}
+
+// DeliveryRepository defines the interface for the delivery log
+type DeliveryRepository interface {
+	// Save adds a delivery to the log
+	Save(ctx context.Context, delivery *Delivery) error
+
+	// Update updates a delivery after an attempt
+	Update(ctx context.Context, delivery *Delivery) error
+
+	// FindDue retrieves the pending deliveries whose next attempt is due, oldest first
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*Delivery, error)
+
+	// List retrieves the deliveries with a status, or all of them with an empty status, newest first
+	List(ctx context.Context, status DeliveryStatus, limit, offset int) ([]*Delivery, error)
+}
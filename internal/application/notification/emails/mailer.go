@@ -0,0 +1,271 @@
+package emails
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/notification"
+	"e-commerce/internal/application/user/verification"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"errors"
+	"strings"
+	"time"
+)
+
+// WelcomeData is the data of the welcome template
+type WelcomeData struct {
+	Name string
+}
+
+// PasswordResetData is the data of the password reset template
+type PasswordResetData struct {
+	Name      string
+	ResetURL  string
+	ExpiresAt time.Time
+}
+
+// EmailVerificationData is the data of the email verification template
+type EmailVerificationData struct {
+	Name      string
+	VerifyURL string
+	ExpiresAt time.Time
+}
+
+// OrderItemData is the data of an order line in the order templates
+type OrderItemData struct {
+	Name        string
+	SKU         string
+	Quantity    int
+	LineTotal   float64
+	Backordered int
+}
+
+// OrderData is the data of the order confirmation, shipped and cancelled templates
+type OrderData struct {
+	Name            string
+	OrderID         string
+	Items           []OrderItemData
+	Subtotal        float64
+	Discount        float64
+	Tax             float64
+	Shipping        float64
+	Total           float64
+	ShippingMethod  string
+	ShippingAddress []string
+}
+
+// Mailer emails customers as their account and orders change: a welcome on registration, the link to
+// verify their email address, the link to reset their password, the confirmation of their orders and the news of orders shipped or cancelled
+type Mailer struct {
+	service          *notification.Service
+	orderRepo        order.Repository
+	userRepo         user.Repository
+	productRepo      product.Repository
+	tokens           *verification.Tokens
+	passwordResetURL string
+	verificationURL  string
+}
+
+// NewMailer creates a new Mailer; the token of a password reset is appended to passwordResetURL
+// to build the link choosing a new password, and a signed verification token to verificationURL
+// to build the link verifying an email address
+func NewMailer(
+	service *notification.Service,
+	orderRepo order.Repository,
+	userRepo user.Repository,
+	productRepo product.Repository,
+	tokens *verification.Tokens,
+	passwordResetURL string,
+	verificationURL string,
+) *Mailer {
+	return &Mailer{
+		service:          service,
+		orderRepo:        orderRepo,
+		userRepo:         userRepo,
+		productRepo:      productRepo,
+		tokens:           tokens,
+		passwordResetURL: passwordResetURL,
+		verificationURL:  verificationURL,
+	}
+}
+
+// Subscribe subscribes the mailer to the user and order events of a bus
+func (m *Mailer) Subscribe(bus *events.Bus) {
+	bus.Subscribe(user.EventRegistered, m.sendWelcome)
+	bus.Subscribe(user.EventVerificationRequested, m.sendEmailVerification)
+	bus.Subscribe(user.EventPasswordResetRequested, m.sendPasswordReset)
+	bus.Subscribe(order.EventPlaced, m.sendOrderConfirmation)
+	bus.Subscribe(order.EventStatusChanged, m.sendOrderUpdate)
+}
+
+// sendWelcome welcomes a newly registered user
+func (m *Mailer) sendWelcome(ctx context.Context, event events.Event) error {
+	userEvent, ok := event.(user.Event)
+	if !ok {
+		return nil
+	}
+
+	u, err := m.userRepo.FindByID(ctx, userEvent.UserID())
+	if err != nil {
+		return err
+	}
+
+	return m.service.SendTemplate(
+		ctx, []string{u.Email().String()}, notification.TemplateWelcome, u.Locale().String(),
+		WelcomeData{Name: u.Name().String()},
+	)
+}
+
+// sendEmailVerification sends a user the link to verify their email address
+func (m *Mailer) sendEmailVerification(ctx context.Context, event events.Event) error {
+	userEvent, ok := event.(user.Event)
+	if !ok {
+		return nil
+	}
+
+	u, err := m.userRepo.FindByID(ctx, userEvent.UserID())
+	if err != nil {
+		return err
+	}
+
+	if u.IsEmailVerified() || u.VerificationNonce() == "" || u.VerificationSentAt() == nil {
+		return nil
+	}
+
+	// The email waits for the resend cooldown when the address was changed shortly after the last one
+	requestedAt := *u.VerificationSentAt()
+	token := m.tokens.Issue(u.ID(), u.VerificationNonce(), requestedAt)
+	return m.service.SendTemplateAt(
+		ctx, []string{u.Email().String()}, notification.TemplateEmailVerification, u.Locale().String(),
+		EmailVerificationData{
+			Name:      u.Name().String(),
+			VerifyURL: m.verificationURL + token,
+			ExpiresAt: m.tokens.ExpiresAt(requestedAt),
+		},
+		requestedAt,
+		token,
+	)
+}
+
+// sendPasswordReset sends a user the link to choose a new password
+func (m *Mailer) sendPasswordReset(ctx context.Context, event events.Event) error {
+	userEvent, ok := event.(user.Event)
+	if !ok {
+		return nil
+	}
+
+	u, err := m.userRepo.FindByID(ctx, userEvent.UserID())
+	if err != nil {
+		return err
+	}
+
+	token := userEvent.PasswordResetToken()
+	if token == "" || u.PasswordResetTokenHash() != token.Hash() || u.PasswordResetExpiresAt() == nil {
+		return nil
+	}
+
+	return m.service.SendTemplate(
+		ctx, []string{u.Email().String()}, notification.TemplatePasswordReset, u.Locale().String(),
+		PasswordResetData{
+			Name:      u.Name().String(),
+			ResetURL:  m.passwordResetURL + token.String(),
+			ExpiresAt: *u.PasswordResetExpiresAt(),
+		},
+		token.String(),
+	)
+}
+
+// sendOrderConfirmation confirms a placed order to its customer
+func (m *Mailer) sendOrderConfirmation(ctx context.Context, event events.Event) error {
+	orderEvent, ok := event.(order.Event)
+	if !ok {
+		return nil
+	}
+
+	return m.sendOrder(ctx, orderEvent.OrderID(), notification.TemplateOrderConfirmation)
+}
+
+// sendOrderUpdate tells the customer of an order that it shipped or was cancelled
+func (m *Mailer) sendOrderUpdate(ctx context.Context, event events.Event) error {
+	orderEvent, ok := event.(order.Event)
+	if !ok {
+		return nil
+	}
+
+	switch orderEvent.Status() {
+	case order.StatusShipped:
+		return m.sendOrder(ctx, orderEvent.OrderID(), notification.TemplateOrderShipped)
+	case order.StatusCancelled:
+		return m.sendOrder(ctx, orderEvent.OrderID(), notification.TemplateOrderCancelled)
+	default:
+		return nil
+	}
+}
+
+// sendOrder emails an order to its customer, the user who placed it or the guest's email address
+func (m *Mailer) sendOrder(ctx context.Context, orderID order.ID, template string) error {
+	o, err := m.orderRepo.FindByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	data := OrderData{
+		Name:            o.ShippingAddress().Name(),
+		OrderID:         o.ID().String(),
+		Items:           make([]OrderItemData, len(o.Items())),
+		Subtotal:        o.Subtotal(),
+		Discount:        o.DiscountAmount(),
+		Tax:             o.TaxAmount(),
+		Shipping:        o.ShippingCost(),
+		Total:           o.TotalAmount(),
+		ShippingMethod:  o.ShippingMethod(),
+		ShippingAddress: addressLines(o.ShippingAddress()),
+	}
+
+	for i, item := range o.Items() {
+		name := item.SKU()
+		p, err := m.productRepo.FindByID(ctx, item.ProductID())
+		switch {
+		case err == nil:
+			name = p.Name().String()
+		case !errors.Is(err, product.ErrProductNotFound):
+			return err
+		}
+
+		data.Items[i] = OrderItemData{
+			Name:        name,
+			SKU:         item.SKU(),
+			Quantity:    item.Quantity(),
+			LineTotal:   item.Subtotal(),
+			Backordered: item.Backordered(),
+		}
+	}
+
+	// Guests are written to at the address they checked out with, in the default locale
+	if o.IsGuest() {
+		return m.service.SendTemplate(ctx, []string{o.GuestEmail().String()}, template, "", data)
+	}
+
+	u, err := m.userRepo.FindByID(ctx, o.UserID())
+	if err != nil {
+		return err
+	}
+	data.Name = u.Name().String()
+
+	return m.service.SendTemplate(ctx, []string{u.Email().String()}, template, u.Locale().String(), data)
+}
+
+// addressLines formats an address on the lines of an envelope
+func addressLines(a address.Address) []string {
+	lines := []string{}
+	for _, line := range []string{
+		a.Name(), a.Line1(), a.Line2(), strings.TrimSpace(a.PostalCode() + " " + a.City()), a.Region(), a.Country(),
+	} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
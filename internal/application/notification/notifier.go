@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"context"
+	"errors"
+)
+
+// Notification errors
+var (
+	ErrNoRecipients = errors.New("message has no recipients")
+	ErrTransient    = errors.New("transient delivery failure")
+)
+
+// Message represents a notification sent to one or more recipients
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+
+	// HTML is the HTML alternative of the plain text body, empty for plain text messages
+	HTML string
+}
+
+// Notifier defines the interface for notification delivery backends
+type Notifier interface {
+	// Send delivers a message to its recipients. Failures that may succeed when retried,
+	// such as a mail server that cannot be reached, wrap ErrTransient.
+	Send(ctx context.Context, msg Message) error
+}
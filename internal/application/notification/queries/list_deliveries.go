@@ -0,0 +1,83 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/application/notification"
+	"errors"
+	"time"
+)
+
+// ErrInvalidDeliveryStatus is returned when deliveries are listed by an unknown status
+var ErrInvalidDeliveryStatus = errors.New("invalid delivery status")
+
+// DeliveryDTO represents a message sent through the notification service and how its delivery went
+type DeliveryDTO struct {
+	ID            string     `json:"id"`
+	Template      string     `json:"template,omitempty"`
+	Locale        string     `json:"locale,omitempty"`
+	To            []string   `json:"to"`
+	Subject       string     `json:"subject"`
+	Redacted      bool       `json:"redacted,omitempty"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+}
+
+// ListDeliveriesQuery represents the query to list the delivery log, optionally by status
+type ListDeliveriesQuery struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// ListDeliveriesHandler handles the ListDeliveriesQuery
+type ListDeliveriesHandler struct {
+	deliveryRepo notification.DeliveryRepository
+}
+
+// NewListDeliveriesHandler creates a new ListDeliveriesHandler
+func NewListDeliveriesHandler(deliveryRepo notification.DeliveryRepository) *ListDeliveriesHandler {
+	return &ListDeliveriesHandler{
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// Handle processes the ListDeliveriesQuery
+func (h *ListDeliveriesHandler) Handle(ctx context.Context, query ListDeliveriesQuery) ([]*DeliveryDTO, error) {
+	status := notification.DeliveryStatus(query.Status)
+	switch status {
+	case "", notification.DeliveryPending, notification.DeliverySent, notification.DeliveryFailed:
+	default:
+		return nil, ErrInvalidDeliveryStatus
+	}
+
+	// Get the deliveries
+	deliveries, err := h.deliveryRepo.List(ctx, status, query.Limit, query.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the deliveries to DTOs
+	result := make([]*DeliveryDTO, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = &DeliveryDTO{
+			ID:            d.ID,
+			Template:      d.Template,
+			Locale:        d.Locale,
+			To:            d.Message.To,
+			Subject:       d.Message.Subject,
+			Redacted:      d.Redacted,
+			Status:        string(d.Status),
+			Attempts:      d.Attempts,
+			LastError:     d.LastError,
+			NextAttemptAt: d.NextAttemptAt,
+			CreatedAt:     d.CreatedAt,
+			SentAt:        d.SentAt,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,220 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delivery errors
+var (
+	// ErrDeliveryFailed is returned when a message could not be delivered and will not be retried
+	ErrDeliveryFailed = errors.New("notification delivery failed")
+	// ErrRedactedMessageLost fails the delivery of a message holding secrets that is no longer in memory,
+	// such as after a restart
+	ErrRedactedMessageLost = errors.New("the message holding secrets is no longer kept, it cannot be sent")
+)
+
+// retryBatchSize caps the deliveries retried in one go
+const retryBatchSize = 100
+
+// redactedSecret replaces the secrets of a message in the delivery log
+const redactedSecret = "[redacted]"
+
+// Service sends messages through a notifier, rendering them from templates, recording every
+// delivery and retrying transient failures. It implements Notifier itself, so that messages
+// sent as they are get the same delivery log and retries. Messages are only queued in the delivery
+// log when sent, and delivered by SendDue, so that no request waits on the notifier. Messages holding
+// secrets are logged with the secrets redacted and kept whole in memory until delivered.
+type Service struct {
+	notifier    Notifier
+	templates   *Templates
+	deliveries  DeliveryRepository
+	maxAttempts int
+	retryDelay  time.Duration
+
+	mu       sync.Mutex
+	unlogged map[string]Message
+}
+
+// NewService creates a new Service. Deliveries failing transiently are attempted up to maxAttempts
+// times, waiting retryDelay before the first retry and twice as long before each next one.
+func NewService(
+	notifier Notifier,
+	templates *Templates,
+	deliveries DeliveryRepository,
+	maxAttempts int,
+	retryDelay time.Duration,
+) *Service {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return &Service{
+		notifier:    notifier,
+		templates:   templates,
+		deliveries:  deliveries,
+		maxAttempts: maxAttempts,
+		retryDelay:  retryDelay,
+		unlogged:    make(map[string]Message),
+	}
+}
+
+// Send queues a message as it is
+func (s *Service) Send(ctx context.Context, msg Message) error {
+	return s.deliver(ctx, &Delivery{Message: msg}, time.Now())
+}
+
+// SendTemplate renders a message from a template in the locale of its recipients and queues it.
+// The secrets given are redacted from the delivery log.
+func (s *Service) SendTemplate(ctx context.Context, to []string, name, locale string, data interface{}, secrets ...string) error {
+	return s.SendTemplateAt(ctx, to, name, locale, data, time.Now(), secrets...)
+}
+
+// SendTemplateAt renders a message from a template in the locale of its recipients and queues it
+// to be sent at the given time. The secrets given are redacted from the delivery log.
+func (s *Service) SendTemplateAt(
+	ctx context.Context,
+	to []string,
+	name, locale string,
+	data interface{},
+	at time.Time,
+	secrets ...string,
+) error {
+	msg, locale, err := s.templates.Render(name, locale, data)
+	if err != nil {
+		return err
+	}
+	msg.To = to
+
+	if len(secrets) == 0 {
+		return s.deliver(ctx, &Delivery{Template: name, Locale: locale, Message: msg}, at)
+	}
+
+	// Keep the message before queueing it, so that it is there once the delivery is due
+	delivery := &Delivery{ID: uuid.New().String(), Template: name, Locale: locale, Message: redact(msg, secrets), Redacted: true}
+	s.mu.Lock()
+	s.unlogged[delivery.ID] = msg
+	s.mu.Unlock()
+
+	if err := s.deliver(ctx, delivery, at); err != nil {
+		s.mu.Lock()
+		delete(s.unlogged, delivery.ID)
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// SendDue attempts the queued deliveries whose attempt is due, first attempts and retries, and returns
+// how many were sent
+func (s *Service) SendDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.deliveries.FindDue(ctx, now, retryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, delivery := range due {
+		if err := s.attempt(ctx, delivery, now); err != nil && !errors.Is(err, ErrDeliveryFailed) {
+			return sent, err
+		}
+		if delivery.Status == DeliverySent {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// deliver queues a new delivery in the delivery log, due for its first attempt at the given time
+func (s *Service) deliver(ctx context.Context, delivery *Delivery, at time.Time) error {
+	if len(delivery.Message.To) == 0 {
+		return ErrNoRecipients
+	}
+
+	now := time.Now()
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+	delivery.Status = DeliveryPending
+	delivery.NextAttemptAt = &at
+	delivery.CreatedAt = now
+	delivery.UpdatedAt = now
+
+	return s.deliveries.Save(ctx, delivery)
+}
+
+// attempt sends the message of a delivery and records the outcome: sent, scheduled for a retry
+// after a transient failure, or failed, in which case the returned error wraps ErrDeliveryFailed
+func (s *Service) attempt(ctx context.Context, delivery *Delivery, now time.Time) error {
+	delivery.Attempts++
+	delivery.UpdatedAt = now
+	delivery.NextAttemptAt = nil
+
+	msg := delivery.Message
+	var sendErr error
+	if delivery.Redacted {
+		s.mu.Lock()
+		unlogged, ok := s.unlogged[delivery.ID]
+		s.mu.Unlock()
+
+		msg = unlogged
+		if !ok {
+			sendErr = ErrRedactedMessageLost
+		}
+	}
+
+	if sendErr == nil {
+		sendErr = s.notifier.Send(ctx, msg)
+	}
+	switch {
+	case sendErr == nil:
+		delivery.Status = DeliverySent
+		delivery.SentAt = &now
+		delivery.LastError = ""
+	case errors.Is(sendErr, ErrTransient) && delivery.Attempts < s.maxAttempts:
+		next := now.Add(s.retryDelay << (delivery.Attempts - 1))
+		delivery.NextAttemptAt = &next
+		delivery.LastError = sendErr.Error()
+	default:
+		delivery.Status = DeliveryFailed
+		delivery.LastError = sendErr.Error()
+	}
+
+	if err := s.deliveries.Update(ctx, delivery); err != nil {
+		return err
+	}
+
+	if delivery.Redacted && delivery.Status != DeliveryPending {
+		s.mu.Lock()
+		delete(s.unlogged, delivery.ID)
+		s.mu.Unlock()
+	}
+
+	if delivery.Status == DeliveryFailed {
+		return fmt.Errorf("%w: %v", ErrDeliveryFailed, sendErr)
+	}
+	return nil
+}
+
+// redact replaces the secrets in the subject and bodies of a message
+func redact(msg Message, secrets []string) Message {
+	pairs := make([]string, 0, 2*len(secrets))
+	for _, secret := range secrets {
+		if secret != "" {
+			pairs = append(pairs, secret, redactedSecret)
+		}
+	}
+
+	replacer := strings.NewReplacer(pairs...)
+	msg.Subject = replacer.Replace(msg.Subject)
+	msg.Body = replacer.Replace(msg.Body)
+	msg.HTML = replacer.Replace(msg.HTML)
+	return msg
+}
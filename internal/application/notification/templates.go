@@ -0,0 +1,130 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Template names
+const (
+	TemplateWelcome           = "welcome"
+	TemplateOrderConfirmation = "order_confirmation"
+	TemplateOrderShipped      = "order_shipped"
+	TemplateOrderCancelled    = "order_cancelled"
+	TemplatePasswordReset     = "password_reset"
+	TemplateEmailVerification = "email_verification"
+	TemplateCartReminder      = "cart_reminder"
+)
+
+// ErrUnknownTemplate is returned when a template is rendered that does not exist in the default locale
+var ErrUnknownTemplate = errors.New("unknown notification template")
+
+//go:embed templates
+var templateFiles embed.FS
+
+// localized holds the text and HTML templates of a message in a locale. The text template
+// defines the subject in a "subject" block and the plain text body outside it.
+type localized struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// Templates renders messages from the templates shipped in the templates directory,
+// one directory per locale holding a <name>.txt and a <name>.html file per message
+type Templates struct {
+	defaultLocale string
+	templates     map[string]map[string]*localized
+}
+
+// NewTemplates parses the shipped templates; messages are rendered in the default locale
+// when they have no template in the locale asked for
+func NewTemplates(defaultLocale string) (*Templates, error) {
+	t := &Templates{
+		defaultLocale: strings.ToLower(defaultLocale),
+		templates:     make(map[string]map[string]*localized),
+	}
+
+	textFiles, err := fs.Glob(templateFiles, "templates/*/*.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, textFile := range textFiles {
+		locale := path.Base(path.Dir(textFile))
+		name := strings.TrimSuffix(path.Base(textFile), ".txt")
+
+		text, err := texttemplate.ParseFS(templateFiles, textFile)
+		if err != nil {
+			return nil, err
+		}
+		if text.Lookup("subject") == nil {
+			return nil, errors.New("notification template " + textFile + " has no subject")
+		}
+
+		html, err := htmltemplate.ParseFS(templateFiles, strings.TrimSuffix(textFile, ".txt")+".html")
+		if err != nil {
+			return nil, err
+		}
+
+		if t.templates[locale] == nil {
+			t.templates[locale] = make(map[string]*localized)
+		}
+		t.templates[locale][name] = &localized{text: text, html: html}
+	}
+
+	if len(t.templates[t.defaultLocale]) == 0 {
+		return nil, errors.New("no notification templates for the default locale " + defaultLocale)
+	}
+
+	return t, nil
+}
+
+// Render renders the subject and bodies of a message from a template in a locale, falling back
+// to the language of a regional locale, such as "fr" for "fr-ca", and then to the default locale.
+// It returns the locale the message was rendered in.
+func (t *Templates) Render(name, locale string, data interface{}) (Message, string, error) {
+	locale, tmpl := t.lookup(name, locale)
+	if tmpl == nil {
+		return Message{}, "", ErrUnknownTemplate
+	}
+
+	var subject, body, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, "", err
+	}
+	if err := tmpl.text.Execute(&body, data); err != nil {
+		return Message{}, "", err
+	}
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return Message{}, "", err
+	}
+
+	return Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    strings.TrimSpace(body.String()) + "\n",
+		HTML:    html.String(),
+	}, locale, nil
+}
+
+// lookup finds the template of a message in a locale, its language or the default locale
+func (t *Templates) lookup(name, locale string) (string, *localized) {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	candidates := []string{locale}
+	if i := strings.Index(locale, "-"); i > 0 {
+		candidates = append(candidates, locale[:i])
+	}
+	candidates = append(candidates, t.defaultLocale)
+
+	for _, candidate := range candidates {
+		if tmpl := t.templates[candidate][name]; tmpl != nil {
+			return candidate, tmpl
+		}
+	}
+	return "", nil
+}
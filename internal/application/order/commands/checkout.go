@@ -0,0 +1,300 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/pricing"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/coupon"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/shipping"
+	"e-commerce/internal/domain/user"
+	"time"
+)
+
+// checkout turns a cart into a new order, shared by user and guest checkout: it prices the cart
+// for the destination, adds the lines and discounts to the order, reserves the stock, saves the
+// order and empties the cart, recording its recovery when it was abandoned. All of it is written
+// in one unit of work, so a failure leaves no order behind and the stock, coupon and cart unchanged.
+type checkout struct {
+	uow         transaction.UnitOfWork
+	orderRepo   order.Repository
+	cartRepo    cart.Repository
+	productRepo product.Repository
+	couponRepo  coupon.Repository
+	pricer      *pricing.Pricer
+	allocator   *inventory.Allocator
+	publisher   events.Publisher
+}
+
+// newCheckout creates a new checkout
+func newCheckout(
+	uow transaction.UnitOfWork,
+	orderRepo order.Repository,
+	cartRepo cart.Repository,
+	productRepo product.Repository,
+	couponRepo coupon.Repository,
+	pricer *pricing.Pricer,
+	allocator *inventory.Allocator,
+	publisher events.Publisher,
+) *checkout {
+	return &checkout{
+		uow:         uow,
+		orderRepo:   orderRepo,
+		cartRepo:    cartRepo,
+		productRepo: productRepo,
+		couponRepo:  couponRepo,
+		pricer:      pricer,
+		allocator:   allocator,
+		publisher:   publisher,
+	}
+}
+
+// place runs placeOrder, which finds the cart, creates the order and fills it, in one unit of work,
+// and publishes the placed order and the stock changes of the products sold once it is committed.
+// When a product sold was changed in the meantime, the order is placed again from a fresh cart and catalog.
+func (co *checkout) place(
+	ctx context.Context,
+	placeOrder func(ctx context.Context) (string, []events.Event, error),
+) (string, error) {
+	var orderID string
+	err := runAndPublish(ctx, co.uow, co.publisher, func(ctx context.Context) ([]events.Event, error) {
+		var placed []events.Event
+		var err error
+		orderID, placed, err = placeOrder(ctx)
+		return placed, err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return orderID, nil
+}
+
+// fill fills a new order from a non-empty cart, shipped to the order's shipping address, and returns
+// the events to publish once the order is placed
+func (co *checkout) fill(ctx context.Context, c *cart.Cart, newOrder *order.Order, shippingMethod string) ([]events.Event, error) {
+	shippingAddress := newOrder.ShippingAddress()
+	country, err := shipping.NewCountryCode(shippingAddress.Country())
+	if err != nil {
+		return nil, err
+	}
+
+	// Price the cart for the destination
+	items := make([]pricing.Item, len(c.Items()))
+	for i, item := range c.Items() {
+		items[i] = pricing.Item{ProductID: item.ProductID(), VariantID: item.VariantID(), Quantity: item.Quantity()}
+	}
+
+	quote, err := co.pricer.Price(ctx, pricing.Request{
+		Items:          items,
+		UserID:         newOrder.UserID(),
+		Country:        country,
+		Region:         shippingAddress.Region(),
+		ShippingMethod: shippingMethod,
+		CouponCode:     c.CouponCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if quote.CouponError != nil {
+		return nil, quote.CouponError
+	}
+
+	// Add the priced lines, checking each product is still for sale and the stock of each variant;
+	// the quantity out of stock is backordered when the product allows it
+	now := time.Now()
+	backordered := make([]int, len(quote.Lines))
+	for i, line := range quote.Lines {
+		if !line.Product.IsAvailable(now) {
+			return nil, product.ErrProductUnavailable
+		}
+
+		variantID := product.VariantID(line.VariantID())
+		backordered[i] = line.Product.Shortfall(variantID, line.Quantity)
+		if backordered[i] > 0 && !line.Product.CanBackorder(variantID, backordered[i]) {
+			if line.Product.BackorderPolicy() == product.BackorderNone {
+				return nil, product.ErrInsufficientStock
+			}
+			return nil, product.ErrBackorderLimitReached
+		}
+
+		taxLines, err := toOrderTaxLines(line.Taxes)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := newOrder.AddItem(
+			line.Product.ID().String(), line.VariantID(), line.SKU(),
+			line.Quantity, line.UnitPrice, line.Discount, taxLines...,
+		); err != nil {
+			return nil, err
+		}
+
+		if backordered[i] > 0 {
+			item := newOrder.Items()[i]
+			if err := newOrder.BackorderItem(item.ID(), backordered[i]); err != nil {
+				return nil, err
+			}
+
+			if err := line.Product.Backorder(variantID, backordered[i], newOrder.ID().String(), item.ID().String()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Allocate the lines, less their backordered quantity, to the warehouses they are shipped from
+	lines := make([]inventory.Line, len(quote.Lines))
+	for i, line := range quote.Lines {
+		lines[i] = inventory.Line{
+			Product:   line.Product,
+			VariantID: product.VariantID(line.VariantID()),
+			Quantity:  line.Quantity - backordered[i],
+		}
+	}
+
+	allocations, err := co.allocator.Allocate(ctx, shippingAddress, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range newOrder.Items() {
+		itemAllocations := make([]order.Allocation, len(allocations[i]))
+		for j, allocation := range allocations[i] {
+			itemAllocations[j], err = order.NewAllocation(allocation.WarehouseID.String(), allocation.Quantity)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := newOrder.AllocateItem(item.ID(), itemAllocations...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := newOrder.ChangeShipping(quote.ShippingMethod, quote.ShippingCost); err != nil {
+		return nil, err
+	}
+
+	// Record the discount breakdown
+	for _, outcome := range quote.Promotions {
+		if !outcome.Applied {
+			continue
+		}
+
+		discount, err := order.NewDiscount(outcome.Promotion.ID().String(), outcome.Promotion.Name(), outcome.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := newOrder.AddDiscount(discount); err != nil {
+			return nil, err
+		}
+	}
+
+	if quote.Coupon != nil {
+		discount, err := order.NewDiscount(quote.Coupon.Code().String(), describeCoupon(quote.Coupon), quote.CouponDiscount)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := newOrder.AddDiscount(discount); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reserve the stock in the allocated warehouses
+	for i, line := range quote.Lines {
+		for _, allocation := range allocations[i] {
+			if err := line.Product.DecreaseStock(
+				allocation.WarehouseID, product.VariantID(line.VariantID()), allocation.Quantity,
+				product.ReasonSale, product.OrderReference(newOrder.ID().String()),
+			); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Save the order, then the products whose backorders reference it
+	if err := co.orderRepo.Save(ctx, newOrder); err != nil {
+		return nil, err
+	}
+
+	for _, p := range distinctProducts(quote.Lines) {
+		if err := co.productRepo.Update(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+
+	// Redeem the coupon
+	if quote.Coupon != nil {
+		if err := co.redeemCoupon(ctx, quote.Coupon, newOrder.UserID(), newOrder.ID()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Empty the cart, counting it as recovered when it was checked out after a reminder
+	recovered := c.RemindersSent() > 0
+	c.Clear()
+	if err := co.cartRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+
+	if recovered {
+		if err := co.cartRepo.RecordRecovery(ctx, c.ID(), newOrder.ID().String(), now); err != nil {
+			return nil, err
+		}
+	}
+
+	placed := []events.Event{}
+	for _, event := range newOrder.PullEvents() {
+		placed = append(placed, event)
+	}
+
+	for _, p := range distinctProducts(quote.Lines) {
+		for _, event := range p.PullEvents() {
+			placed = append(placed, event)
+		}
+	}
+
+	return placed, nil
+}
+
+// redeemCoupon records the use of a coupon by a user, or a guest when userID is empty, on an order
+func (co *checkout) redeemCoupon(ctx context.Context, c *coupon.Coupon, userID user.ID, orderID order.ID) error {
+	userRedemptions := 0
+	if userID != "" {
+		var err error
+		userRedemptions, err = co.couponRepo.CountRedemptionsByUser(ctx, c.ID(), userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := c.Redeem(userRedemptions, time.Now()); err != nil {
+		return err
+	}
+
+	if err := co.couponRepo.Update(ctx, c); err != nil {
+		return err
+	}
+
+	return co.couponRepo.RecordRedemption(ctx, c.ID(), userID, orderID)
+}
+
+// distinctProducts returns the products of priced lines once each; lines of variants of the same product share it
+func distinctProducts(lines []*pricing.Line) []*product.Product {
+	products := []*product.Product{}
+	seen := make(map[product.ID]bool)
+	for _, line := range lines {
+		if !seen[line.Product.ID()] {
+			seen[line.Product.ID()] = true
+			products = append(products, line.Product)
+		}
+	}
+	return products
+}
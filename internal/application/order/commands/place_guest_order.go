@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/pricing"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/coupon"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/shipping"
+)
+
+// PlaceGuestOrderCommand represents the command to place an order from the cart of an anonymous
+// visitor, identified by its session token, with just an email address.
+// Guests have no address book, so the shipping address is given inline; billing falls back to shipping.
+type PlaceGuestOrderCommand struct {
+	SessionToken    string        `json:"session_token"`
+	Email           string        `json:"email"`
+	ShippingAddress *AddressInput `json:"shipping_address"`
+	BillingAddress  *AddressInput `json:"billing_address"`
+	PaymentMethod   string        `json:"payment_method"`
+	ShippingMethod  string        `json:"shipping_method"`
+}
+
+// PlaceGuestOrderHandler handles the PlaceGuestOrderCommand
+type PlaceGuestOrderHandler struct {
+	cartRepo cart.Repository
+	checkout *checkout
+}
+
+// NewPlaceGuestOrderHandler creates a new PlaceGuestOrderHandler
+func NewPlaceGuestOrderHandler(
+	uow transaction.UnitOfWork,
+	orderRepo order.Repository,
+	cartRepo cart.Repository,
+	productRepo product.Repository,
+	couponRepo coupon.Repository,
+	pricer *pricing.Pricer,
+	allocator *inventory.Allocator,
+	publisher events.Publisher,
+) *PlaceGuestOrderHandler {
+	return &PlaceGuestOrderHandler{
+		cartRepo: cartRepo,
+		checkout: newCheckout(uow, orderRepo, cartRepo, productRepo, couponRepo, pricer, allocator, publisher),
+	}
+}
+
+// Handle processes the PlaceGuestOrderCommand
+func (h *PlaceGuestOrderHandler) Handle(ctx context.Context, cmd PlaceGuestOrderCommand) (string, error) {
+	if cmd.ShippingMethod == "" {
+		return "", shipping.ErrInvalidMethod
+	}
+
+	// Resolve the addresses to snapshot on the order
+	if cmd.ShippingAddress == nil {
+		return "", order.ErrInvalidShippingAddress
+	}
+
+	shippingAddress, err := toAddress(cmd.ShippingAddress)
+	if err != nil {
+		return "", err
+	}
+
+	billingAddress := shippingAddress
+	if cmd.BillingAddress != nil {
+		if billingAddress, err = toAddress(cmd.BillingAddress); err != nil {
+			return "", err
+		}
+	}
+
+	return h.checkout.place(ctx, func(ctx context.Context) (string, []events.Event, error) {
+		// Find the guest cart
+		c, err := h.cartRepo.FindBySessionToken(ctx, cart.SessionToken(cmd.SessionToken))
+		if err != nil {
+			return "", nil, err
+		}
+
+		if c.IsEmpty() {
+			return "", nil, cart.ErrEmptyCart
+		}
+
+		// Create a new guest order
+		newOrder, err := order.NewGuestOrder(cmd.Email, shippingAddress, billingAddress, cmd.PaymentMethod)
+		if err != nil {
+			return "", nil, err
+		}
+
+		// Fill the order from the cart
+		placed, err := h.checkout.fill(ctx, c, newOrder, cmd.ShippingMethod)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return newOrder.ID().String(), placed, nil
+	})
+}
+
+// toAddress converts an address given at checkout to an address snapshot
+func toAddress(input *AddressInput) (address.Address, error) {
+	return address.New(input.Name, input.Line1, input.Line2, input.City, input.PostalCode, input.Region, input.Country)
+}
@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/pricing"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/coupon"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/shipping"
+	"e-commerce/internal/domain/tax"
+	"e-commerce/internal/domain/user"
+	"fmt"
+)
+
+// AddressInput represents a postal address given at checkout
+type AddressInput struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code"`
+	Region     string `json:"region"`
+	Country    string `json:"country"`
+}
+
+// PlaceOrderCommand represents the command to place an order from a user's cart.
+// Each address is either given inline or picked from the user's address book by ID;
+// when neither is given the default address is used, and billing falls back to shipping.
+type PlaceOrderCommand struct {
+	UserID            string        `json:"user_id"`
+	ShippingAddressID string        `json:"shipping_address_id"`
+	ShippingAddress   *AddressInput `json:"shipping_address"`
+	BillingAddressID  string        `json:"billing_address_id"`
+	BillingAddress    *AddressInput `json:"billing_address"`
+	PaymentMethod     string        `json:"payment_method"`
+	ShippingMethod    string        `json:"shipping_method"`
+}
+
+// PlaceOrderHandler handles the PlaceOrderCommand
+type PlaceOrderHandler struct {
+	cartRepo             cart.Repository
+	userRepo             user.Repository
+	checkout             *checkout
+	requireVerifiedEmail bool
+}
+
+// NewPlaceOrderHandler creates a new PlaceOrderHandler; with requireVerifiedEmail, users have to verify
+// their email address before placing orders
+func NewPlaceOrderHandler(
+	uow transaction.UnitOfWork,
+	orderRepo order.Repository,
+	cartRepo cart.Repository,
+	productRepo product.Repository,
+	userRepo user.Repository,
+	couponRepo coupon.Repository,
+	pricer *pricing.Pricer,
+	allocator *inventory.Allocator,
+	publisher events.Publisher,
+	requireVerifiedEmail bool,
+) *PlaceOrderHandler {
+	return &PlaceOrderHandler{
+		cartRepo:             cartRepo,
+		userRepo:             userRepo,
+		checkout:             newCheckout(uow, orderRepo, cartRepo, productRepo, couponRepo, pricer, allocator, publisher),
+		requireVerifiedEmail: requireVerifiedEmail,
+	}
+}
+
+// Handle processes the PlaceOrderCommand
+func (h *PlaceOrderHandler) Handle(ctx context.Context, cmd PlaceOrderCommand) (string, error) {
+	// Convert strings to domain values
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	if cmd.ShippingMethod == "" {
+		return "", shipping.ErrInvalidMethod
+	}
+
+	// Resolve the addresses to snapshot on the order
+	u, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if h.requireVerifiedEmail && !u.IsEmailVerified() {
+		return "", user.ErrEmailNotVerified
+	}
+
+	shippingAddress, err := resolveAddress(u, cmd.ShippingAddress, cmd.ShippingAddressID, u.DefaultShippingAddress())
+	if err != nil {
+		return "", err
+	}
+	if shippingAddress.IsZero() {
+		return "", order.ErrInvalidShippingAddress
+	}
+
+	billingAddress, err := resolveAddress(u, cmd.BillingAddress, cmd.BillingAddressID, u.DefaultBillingAddress())
+	if err != nil {
+		return "", err
+	}
+	if billingAddress.IsZero() {
+		billingAddress = shippingAddress
+	}
+
+	return h.checkout.place(ctx, func(ctx context.Context) (string, []events.Event, error) {
+		// Find the user's cart
+		c, err := h.cartRepo.FindByUserID(ctx, userID)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if c.IsEmpty() {
+			return "", nil, cart.ErrEmptyCart
+		}
+
+		// Create a new order
+		newOrder, err := order.NewOrder(cmd.UserID, shippingAddress, billingAddress, cmd.PaymentMethod)
+		if err != nil {
+			return "", nil, err
+		}
+
+		// Fill the order from the cart
+		placed, err := h.checkout.fill(ctx, c, newOrder, cmd.ShippingMethod)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return newOrder.ID().String(), placed, nil
+	})
+}
+
+// resolveAddress returns the inline address when given, otherwise the saved
+// address with the given ID, otherwise the fallback saved address
+func resolveAddress(u *user.User, input *AddressInput, addressID string, fallback *user.SavedAddress) (address.Address, error) {
+	if input != nil {
+		return toAddress(input)
+	}
+
+	if addressID != "" {
+		saved, err := u.FindAddress(user.AddressID(addressID))
+		if err != nil {
+			return address.Address{}, err
+		}
+		return saved.Address(), nil
+	}
+
+	if fallback != nil {
+		return fallback.Address(), nil
+	}
+
+	return address.Address{}, nil
+}
+
+// describeCoupon returns a human readable description of a coupon
+func describeCoupon(c *coupon.Coupon) string {
+	switch c.Type() {
+	case coupon.TypePercentage:
+		return fmt.Sprintf("%g%% off", c.Value())
+	case coupon.TypeFixedAmount:
+		return fmt.Sprintf("%.2f off", c.Value())
+	case coupon.TypeFreeShipping:
+		return "Free shipping"
+	case coupon.TypeBuyXGetY:
+		return fmt.Sprintf("Buy %d get %d free", c.BuyQuantity(), c.GetQuantity())
+	}
+	return c.Code().String()
+}
+
+// toOrderTaxLines freezes calculated taxes as order tax lines
+func toOrderTaxLines(lines []tax.Line) ([]order.TaxLine, error) {
+	result := make([]order.TaxLine, 0, len(lines))
+	for _, line := range lines {
+		taxLine, err := order.NewTaxLine(line.Name, line.Rate, line.Amount, line.Inclusive)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, taxLine)
+	}
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/product"
+	"errors"
+)
+
+// maxAttempts is how many times a command changing product stock runs before giving up on the products
+// changing concurrently
+const maxAttempts = 3
+
+// runAndPublish runs work in a unit of work and publishes the events it returns once the unit of work is committed.
+// When a product it changed was changed by another order or an edit in the meantime, work runs again from scratch,
+// reading fresh copies of what it changes.
+func runAndPublish(
+	ctx context.Context,
+	uow transaction.UnitOfWork,
+	publisher events.Publisher,
+	work func(ctx context.Context) ([]events.Event, error),
+) error {
+	var changes []events.Event
+	for attempt := 1; ; attempt++ {
+		err := uow.Do(ctx, func(ctx context.Context) error {
+			var err error
+			changes, err = work(ctx)
+			return err
+		})
+		if errors.Is(err, product.ErrConcurrentUpdate) && attempt < maxAttempts {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		break
+	}
+
+	for _, event := range changes {
+		publisher.Publish(ctx, event)
+	}
+
+	return nil
+}
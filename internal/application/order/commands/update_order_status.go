@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+	"errors"
+)
+
+// UpdateOrderStatusCommand represents the command to change the status of an order
+type UpdateOrderStatusCommand struct {
+	ID     string `json:"-"`
+	Status string `json:"status"`
+}
+
+// UpdateOrderStatusHandler handles the UpdateOrderStatusCommand
+type UpdateOrderStatusHandler struct {
+	uow         transaction.UnitOfWork
+	orderRepo   order.Repository
+	productRepo product.Repository
+	backorders  *inventory.BackorderFiller
+	publisher   events.Publisher
+}
+
+// NewUpdateOrderStatusHandler creates a new UpdateOrderStatusHandler
+func NewUpdateOrderStatusHandler(
+	uow transaction.UnitOfWork,
+	orderRepo order.Repository,
+	productRepo product.Repository,
+	backorders *inventory.BackorderFiller,
+	publisher events.Publisher,
+) *UpdateOrderStatusHandler {
+	return &UpdateOrderStatusHandler{
+		uow:         uow,
+		orderRepo:   orderRepo,
+		productRepo: productRepo,
+		backorders:  backorders,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the UpdateOrderStatusCommand. Cancelling a pending or paid order stops it waiting for stock
+// and returns the stock allocated to it, in the same unit of work as the order.
+func (h *UpdateOrderStatusHandler) Handle(ctx context.Context, cmd UpdateOrderStatusCommand) error {
+	// Convert ID string to domain ID
+	id, err := order.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	return runAndPublish(ctx, h.uow, h.publisher, func(ctx context.Context) ([]events.Event, error) {
+		// Find the order
+		existingOrder, err := h.orderRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// Change the status
+		wasCancelled := existingOrder.IsCancelled()
+		if err := existingOrder.ChangeStatus(order.Status(cmd.Status)); err != nil {
+			return nil, err
+		}
+
+		// Release the stock of the order when it is cancelled
+		changes := []events.Event{}
+		if !wasCancelled && existingOrder.IsCancelled() {
+			released, err := h.releaseStock(ctx, existingOrder)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, released...)
+		}
+
+		// Save the updated order
+		if err := h.orderRepo.Update(ctx, existingOrder); err != nil {
+			return nil, err
+		}
+
+		for _, event := range existingOrder.PullEvents() {
+			changes = append(changes, event)
+		}
+
+		return changes, nil
+	})
+}
+
+// releaseStock removes the backorders of a cancelled order from the products it waits for and returns
+// the stock allocated to it to the warehouses it was taken from, where it fills the backorders of other
+// orders first, which are saved with it. It returns the stock changes of the products.
+func (h *UpdateOrderStatusHandler) releaseStock(ctx context.Context, o *order.Order) ([]events.Event, error) {
+	products := []*product.Product{}
+	byID := make(map[product.ID]*product.Product)
+	for _, item := range o.Items() {
+		if !item.IsBackordered() && len(item.Allocations()) == 0 {
+			continue
+		}
+		if _, ok := byID[item.ProductID()]; ok {
+			continue
+		}
+
+		// Products since deleted have no stock left to return
+		p, err := h.productRepo.FindByID(ctx, item.ProductID())
+		if errors.Is(err, product.ErrProductNotFound) {
+			byID[item.ProductID()] = nil
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		byID[item.ProductID()] = p
+		products = append(products, p)
+	}
+
+	// Stop the order waiting before returning its stock, so that the stock does not fill its own backorders
+	changed := make(map[product.ID]bool)
+	for _, p := range products {
+		changed[p.ID()] = p.CancelBackorders(o.ID().String())
+	}
+
+	for _, item := range o.Items() {
+		p := byID[item.ProductID()]
+		if p == nil {
+			continue
+		}
+
+		for _, allocation := range item.Allocations() {
+			if err := p.IncreaseStock(
+				allocation.WarehouseID(), item.VariantID(), allocation.Quantity(),
+				product.ReasonReturn, product.OrderReference(o.ID().String()),
+			); err != nil {
+				return nil, err
+			}
+			changed[p.ID()] = true
+		}
+	}
+
+	if err := o.ReleaseAllocations(); err != nil {
+		return nil, err
+	}
+
+	// Save the products, then the orders their stock was allocated to
+	productEvents := []product.Event{}
+	for _, p := range products {
+		if !changed[p.ID()] {
+			continue
+		}
+
+		if err := h.productRepo.Update(ctx, p); err != nil {
+			return nil, err
+		}
+		productEvents = append(productEvents, p.PullEvents()...)
+	}
+
+	if err := h.backorders.Fill(ctx, productEvents); err != nil {
+		return nil, err
+	}
+
+	changes := make([]events.Event, len(productEvents))
+	for i, event := range productEvents {
+		changes[i] = event
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,191 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/order"
+	"time"
+)
+
+// TaxLineDTO represents the data transfer object for an order item tax line
+type TaxLineDTO struct {
+	Name      string  `json:"name"`
+	Rate      float64 `json:"rate"`
+	Amount    float64 `json:"amount"`
+	Inclusive bool    `json:"inclusive"`
+}
+
+// AddressDTO represents the data transfer object for an address snapshot
+type AddressDTO struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code"`
+	Region     string `json:"region"`
+	Country    string `json:"country"`
+}
+
+// DiscountDTO represents the data transfer object for an order discount
+type DiscountDTO struct {
+	Code        string  `json:"code"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+// AllocationDTO represents the quantity of an order item shipped from a warehouse
+type AllocationDTO struct {
+	WarehouseID string `json:"warehouse_id"`
+	Quantity    int    `json:"quantity"`
+}
+
+// OrderItemDTO represents the data transfer object for an order item
+type OrderItemDTO struct {
+	ID          string           `json:"id"`
+	ProductID   string           `json:"product_id"`
+	VariantID   string           `json:"variant_id,omitempty"`
+	SKU         string           `json:"sku,omitempty"`
+	Quantity    int              `json:"quantity"`
+	Price       float64          `json:"price"`
+	Subtotal    float64          `json:"subtotal"`
+	Discount    float64          `json:"discount"`
+	Taxes       []*TaxLineDTO    `json:"taxes"`
+	Allocations []*AllocationDTO `json:"allocations"`
+	Backordered int              `json:"backordered"`
+}
+
+// OrderDTO represents the data transfer object for order information
+type OrderDTO struct {
+	ID              string          `json:"id"`
+	UserID          string          `json:"user_id,omitempty"`
+	GuestEmail      string          `json:"guest_email,omitempty"`
+	Status          string          `json:"status"`
+	Items           []*OrderItemDTO `json:"items"`
+	Subtotal        float64         `json:"subtotal"`
+	Discounts       []*DiscountDTO  `json:"discounts"`
+	DiscountAmount  float64         `json:"discount_amount"`
+	TaxAmount       float64         `json:"tax_amount"`
+	ShippingMethod  string          `json:"shipping_method"`
+	ShippingCost    float64         `json:"shipping_cost"`
+	TotalAmount     float64         `json:"total_amount"`
+	ShippingAddress *AddressDTO     `json:"shipping_address"`
+	BillingAddress  *AddressDTO     `json:"billing_address"`
+	PaymentMethod   string          `json:"payment_method"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// GetOrderQuery represents the query to get an order by ID
+type GetOrderQuery struct {
+	ID string
+}
+
+// GetOrderHandler handles the GetOrderQuery
+type GetOrderHandler struct {
+	orderRepo order.Repository
+}
+
+// NewGetOrderHandler creates a new GetOrderHandler
+func NewGetOrderHandler(orderRepo order.Repository) *GetOrderHandler {
+	return &GetOrderHandler{
+		orderRepo: orderRepo,
+	}
+}
+
+// Handle processes the GetOrderQuery
+func (h *GetOrderHandler) Handle(ctx context.Context, query GetOrderQuery) (*OrderDTO, error) {
+	// Convert ID string to domain ID
+	id, err := order.NewID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the order
+	o, err := h.orderRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain order to DTO
+	return toOrderDTO(o), nil
+}
+
+// toOrderDTO maps a domain order to a DTO
+func toOrderDTO(o *order.Order) *OrderDTO {
+	items := make([]*OrderItemDTO, len(o.Items()))
+	for i, item := range o.Items() {
+		taxes := make([]*TaxLineDTO, len(item.TaxLines()))
+		for j, line := range item.TaxLines() {
+			taxes[j] = &TaxLineDTO{
+				Name:      line.Name(),
+				Rate:      line.Rate(),
+				Amount:    line.Amount(),
+				Inclusive: line.Inclusive(),
+			}
+		}
+
+		allocations := make([]*AllocationDTO, len(item.Allocations()))
+		for j, allocation := range item.Allocations() {
+			allocations[j] = &AllocationDTO{
+				WarehouseID: allocation.WarehouseID().String(),
+				Quantity:    allocation.Quantity(),
+			}
+		}
+
+		items[i] = &OrderItemDTO{
+			ID:          item.ID().String(),
+			ProductID:   item.ProductID().String(),
+			VariantID:   item.VariantID().String(),
+			SKU:         item.SKU(),
+			Quantity:    item.Quantity(),
+			Price:       item.Price(),
+			Subtotal:    item.Subtotal(),
+			Discount:    item.Discount(),
+			Taxes:       taxes,
+			Allocations: allocations,
+			Backordered: item.Backordered(),
+		}
+	}
+
+	discounts := make([]*DiscountDTO, len(o.Discounts()))
+	for i, discount := range o.Discounts() {
+		discounts[i] = &DiscountDTO{
+			Code:        discount.Code(),
+			Description: discount.Description(),
+			Amount:      discount.Amount(),
+		}
+	}
+
+	return &OrderDTO{
+		ID:              o.ID().String(),
+		UserID:          o.UserID().String(),
+		GuestEmail:      o.GuestEmail().String(),
+		Status:          string(o.Status()),
+		Items:           items,
+		Subtotal:        o.Subtotal(),
+		Discounts:       discounts,
+		DiscountAmount:  o.DiscountAmount(),
+		TaxAmount:       o.TaxAmount(),
+		ShippingMethod:  o.ShippingMethod(),
+		ShippingCost:    o.ShippingCost(),
+		TotalAmount:     o.TotalAmount(),
+		ShippingAddress: toAddressDTO(o.ShippingAddress()),
+		BillingAddress:  toAddressDTO(o.BillingAddress()),
+		PaymentMethod:   o.PaymentMethod(),
+		CreatedAt:       o.CreatedAt(),
+		UpdatedAt:       o.UpdatedAt(),
+	}
+}
+
+// toAddressDTO maps an address snapshot to a DTO
+func toAddressDTO(a address.Address) *AddressDTO {
+	return &AddressDTO{
+		Name:       a.Name(),
+		Line1:      a.Line1(),
+		Line2:      a.Line2(),
+		City:       a.City(),
+		PostalCode: a.PostalCode(),
+		Region:     a.Region(),
+		Country:    a.Country(),
+	}
+}
@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/user"
+)
+
+// ListUserOrdersQuery represents the query to list the orders of a user
+type ListUserOrdersQuery struct {
+	UserID string
+	Limit  int
+	Offset int
+}
+
+// ListUserOrdersHandler handles the ListUserOrdersQuery
+type ListUserOrdersHandler struct {
+	orderRepo order.Repository
+}
+
+// NewListUserOrdersHandler creates a new ListUserOrdersHandler
+func NewListUserOrdersHandler(orderRepo order.Repository) *ListUserOrdersHandler {
+	return &ListUserOrdersHandler{
+		orderRepo: orderRepo,
+	}
+}
+
+// Handle processes the ListUserOrdersQuery
+func (h *ListUserOrdersHandler) Handle(ctx context.Context, query ListUserOrdersQuery) ([]*OrderDTO, error) {
+	// Convert ID string to domain ID
+	userID, err := user.NewID(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Get orders from repository
+	orders, err := h.orderRepo.FindByUserID(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain orders to DTOs
+	result := make([]*OrderDTO, len(orders))
+	for i, o := range orders {
+		result[i] = toOrderDTO(o)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,294 @@
+package pricing
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/coupon"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/promotion"
+	"e-commerce/internal/domain/shipping"
+	"e-commerce/internal/domain/tax"
+	"e-commerce/internal/domain/user"
+	"e-commerce/pkg/money"
+	"time"
+)
+
+// Item represents a quantity of a product, or of one of its variants, to price
+type Item struct {
+	ProductID product.ID
+	VariantID product.VariantID
+	Quantity  int
+}
+
+// Request represents what to price and for which destination.
+// Without a country no taxes or shipping are calculated; without a shipping
+// method no shipping is charged; the user is only needed for per-user coupon limits.
+type Request struct {
+	Items          []Item
+	UserID         user.ID
+	Country        shipping.CountryCode
+	Region         string
+	ShippingMethod string
+	CouponCode     string
+}
+
+// Line represents a priced item
+type Line struct {
+	Product *product.Product
+	// Variant is the priced variant, nil for products without variants
+	Variant   *product.Variant
+	Quantity  int
+	UnitPrice float64
+	LineTotal float64
+	Discount  float64
+	Taxes     []tax.Line
+
+	// CategoryIDs holds the product categories and all their ancestors, for discount eligibility
+	CategoryIDs []string
+}
+
+// Quote represents the priced contents of a request
+type Quote struct {
+	Lines            []*Line
+	Subtotal         float64
+	DiscountTotal    float64
+	TaxTotal         float64
+	ExclusiveTax     float64
+	ShippingMethod   string
+	ShippingCost     float64
+	ShippingDiscount float64
+	Total            float64
+
+	// Promotions explains every automatic promotion evaluated for the quote
+	Promotions        []*promotion.Outcome
+	PromotionDiscount float64
+
+	// Coupon is the coupon applied to the quote, nil when none applies
+	Coupon         *coupon.Coupon
+	CouponDiscount float64
+
+	// CouponError explains why a requested coupon was not applied
+	CouponError error
+}
+
+// Pricer prices carts and orders from the live catalog, tax rules, shipping
+// rates, promotions and coupons, so that quotes and placed orders agree
+type Pricer struct {
+	productRepo   product.Repository
+	taxRepo       tax.Repository
+	shippingRepo  shipping.Repository
+	couponRepo    coupon.Repository
+	promotionRepo promotion.Repository
+	categoryRepo  category.Repository
+}
+
+// NewPricer creates a new Pricer
+func NewPricer(
+	productRepo product.Repository,
+	taxRepo tax.Repository,
+	shippingRepo shipping.Repository,
+	couponRepo coupon.Repository,
+	promotionRepo promotion.Repository,
+	categoryRepo category.Repository,
+) *Pricer {
+	return &Pricer{
+		productRepo:   productRepo,
+		taxRepo:       taxRepo,
+		shippingRepo:  shippingRepo,
+		couponRepo:    couponRepo,
+		promotionRepo: promotionRepo,
+		categoryRepo:  categoryRepo,
+	}
+}
+
+// Price prices a request
+func (p *Pricer) Price(ctx context.Context, req Request) (*Quote, error) {
+	quote := &Quote{Lines: make([]*Line, 0, len(req.Items))}
+
+	// Load the taxonomy so discounts on a category also cover its subcategories
+	categories, err := p.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree := category.NewTree(categories)
+
+	// Price each item at current catalog prices. Lines of variants of the same
+	// product share one product, so that stock changes made on it add up.
+	var parcel shipping.Parcel
+	products := make(map[product.ID]*product.Product)
+	for _, item := range req.Items {
+		prod, ok := products[item.ProductID]
+		if !ok {
+			prod, err = p.productRepo.FindByID(ctx, item.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			products[item.ProductID] = prod
+		}
+
+		variant, err := prod.ResolveVariant(item.VariantID)
+		if err != nil {
+			return nil, err
+		}
+
+		unitPrice, err := prod.UnitPrice(item.VariantID)
+		if err != nil {
+			return nil, err
+		}
+
+		line := &Line{
+			Product:   prod,
+			Variant:   variant,
+			Quantity:  item.Quantity,
+			UnitPrice: unitPrice.Value(),
+			LineTotal: unitPrice.Value() * float64(item.Quantity),
+		}
+		for _, categoryID := range tree.AncestorIDs(prod.CategoryIDs()) {
+			line.CategoryIDs = append(line.CategoryIDs, categoryID.String())
+		}
+
+		quote.Lines = append(quote.Lines, line)
+		quote.Subtotal += line.LineTotal
+		parcel.Add(prod.Weight().Value(), prod.Dimensions().Volume(), item.Quantity, line.LineTotal)
+	}
+
+	// Quote the shipping method for the destination
+	if req.Country != "" && req.ShippingMethod != "" {
+		zone, err := p.shippingRepo.FindByCountry(ctx, req.Country)
+		if err != nil {
+			return nil, err
+		}
+
+		cost, err := zone.Quote(req.ShippingMethod, parcel)
+		if err != nil {
+			return nil, err
+		}
+
+		quote.ShippingMethod = req.ShippingMethod
+		quote.ShippingCost = cost
+	}
+
+	// Apply automatic promotions
+	if err := p.applyPromotions(ctx, quote); err != nil {
+		return nil, err
+	}
+
+	// Apply the coupon on top of promotions, recording why it does not apply instead of failing
+	if req.CouponCode != "" {
+		if err := p.applyCoupon(ctx, req, quote); err != nil {
+			quote.CouponError = err
+		}
+	}
+
+	// Tax each line on its discounted total
+	if req.Country != "" {
+		rules, err := p.taxRepo.FindByCountry(ctx, req.Country)
+		if err != nil {
+			return nil, err
+		}
+
+		calculator := tax.NewCalculator(rules, req.Country, req.Region)
+		for _, line := range quote.Lines {
+			line.Taxes = calculator.Calculate(line.Product.TaxCategory(), line.LineTotal-line.Discount)
+			quote.TaxTotal += tax.Total(line.Taxes)
+			quote.ExclusiveTax += tax.ExclusiveTotal(line.Taxes)
+		}
+	}
+
+	quote.TaxTotal = money.Round(quote.TaxTotal)
+	quote.ExclusiveTax = money.Round(quote.ExclusiveTax)
+	quote.Total = money.Round(quote.Subtotal - quote.DiscountTotal + quote.ExclusiveTax + quote.ShippingCost)
+
+	return quote, nil
+}
+
+// applyPromotions evaluates the active promotions and records their line adjustments
+func (p *Pricer) applyPromotions(ctx context.Context, quote *Quote) error {
+	promotions, err := p.promotionRepo.FindActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	lines := make([]promotion.Line, len(quote.Lines))
+	for i, line := range quote.Lines {
+		lines[i] = promotion.Line{
+			ProductID:   line.Product.ID(),
+			CategoryIDs: line.CategoryIDs,
+			Quantity:    line.Quantity,
+			Amount:      line.LineTotal,
+		}
+	}
+
+	result := promotion.Evaluate(promotions, lines, time.Now())
+	for i, adjustment := range result.LineAdjustments {
+		quote.Lines[i].Discount += adjustment
+	}
+
+	quote.Promotions = result.Outcomes
+	quote.PromotionDiscount = money.Round(result.Total())
+	quote.DiscountTotal += quote.PromotionDiscount
+	return nil
+}
+
+// applyCoupon evaluates the requested coupon and spreads its discount over the quote
+func (p *Pricer) applyCoupon(ctx context.Context, req Request, quote *Quote) error {
+	code, err := coupon.NewCode(req.CouponCode)
+	if err != nil {
+		return err
+	}
+
+	c, err := p.couponRepo.FindByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	userRedemptions := 0
+	if req.UserID != "" {
+		userRedemptions, err = p.couponRepo.CountRedemptionsByUser(ctx, c.ID(), req.UserID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Coupons discount the prices left after promotions
+	lines := make([]coupon.Line, len(quote.Lines))
+	for i, line := range quote.Lines {
+		lines[i] = coupon.Line{
+			ProductID:   line.Product.ID(),
+			CategoryIDs: line.CategoryIDs,
+			UnitPrice:   (line.LineTotal - line.Discount) / float64(line.Quantity),
+			Quantity:    line.Quantity,
+		}
+	}
+
+	result, err := c.Evaluate(lines, quote.ShippingCost, userRedemptions, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for i, discount := range result.LineDiscounts {
+		quote.Lines[i].Discount += discount
+	}
+
+	quote.Coupon = c
+	quote.CouponDiscount = result.Total()
+	quote.ShippingDiscount += result.ShippingDiscount
+	quote.DiscountTotal = money.Round(quote.DiscountTotal + result.Total())
+	return nil
+}
+
+// VariantID returns the ID of the priced variant, empty for products without variants
+func (l *Line) VariantID() string {
+	if l.Variant == nil {
+		return ""
+	}
+	return l.Variant.ID().String()
+}
+
+// SKU returns the SKU of the priced variant, or of the product for products without variants
+func (l *Line) SKU() string {
+	if l.Variant == nil {
+		return l.Product.SKU().String()
+	}
+	return l.Variant.SKU().String()
+}
@@ -0,0 +1,98 @@
+// Package catalogfile reads and writes product catalog files, one product or variant per row,
+// in CSV or newline-delimited JSON. Rows are identified by SKU; variant rows carry their
+// price override and stock only.
+package catalogfile
+
+import (
+	"e-commerce/internal/domain/importjob"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrMissingSKUColumn is returned when a CSV file has no sku column
+var ErrMissingSKUColumn = errors.New("catalog file has no sku column")
+
+// Columns lists the CSV columns in the order they are written
+var Columns = []string{"sku", "name", "description", "price", "stock", "weight", "tax_category", "language", "status"}
+
+// Row represents a product or variant in a catalog file. Empty strings and nil values
+// were not given and leave the product unchanged on import.
+type Row struct {
+	SKU         string   `json:"sku"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Price       *float64 `json:"price,omitempty"`
+	Stock       *int     `json:"stock,omitempty"`
+	Weight      *float64 `json:"weight,omitempty"`
+	TaxCategory string   `json:"tax_category,omitempty"`
+	Language    string   `json:"language,omitempty"`
+	Status      string   `json:"status,omitempty"`
+}
+
+// RowError reports a row that could not be read; reading continues with the next row
+type RowError struct {
+	SKU string
+	Err error
+}
+
+// Error returns the error message
+func (e *RowError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Reader reads the rows of a catalog file one at a time
+type Reader interface {
+	// Read returns the next row, a *RowError for a row that could not be read,
+	// or io.EOF once every row was read
+	Read() (Row, error)
+}
+
+// Writer writes the rows of a catalog file
+type Writer interface {
+	// Write writes a row
+	Write(row Row) error
+
+	// Flush writes any buffered rows to the underlying writer
+	Flush() error
+}
+
+// NewReader creates a reader of a catalog file in the given format
+func NewReader(format importjob.Format, r io.Reader) (Reader, error) {
+	switch format {
+	case importjob.FormatCSV:
+		return newCSVReader(r)
+	case importjob.FormatNDJSON:
+		return newNDJSONReader(r), nil
+	}
+	return nil, importjob.ErrInvalidFormat
+}
+
+// NewWriter creates a writer of a catalog file in the given format
+func NewWriter(format importjob.Format, w io.Writer) (Writer, error) {
+	switch format {
+	case importjob.FormatCSV:
+		return newCSVWriter(w)
+	case importjob.FormatNDJSON:
+		return newNDJSONWriter(w), nil
+	}
+	return nil, importjob.ErrInvalidFormat
+}
+
+// ContentType returns the MIME type of a catalog file in the given format
+func ContentType(format importjob.Format) string {
+	if format == importjob.FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/x-ndjson"
+}
+
+// invalidColumn builds the error of a column whose value could not be parsed
+func invalidColumn(column, value string) error {
+	return fmt.Errorf("invalid %s %q", column, value)
+}
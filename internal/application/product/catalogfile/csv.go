@@ -0,0 +1,136 @@
+package catalogfile
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// csvReader reads a CSV catalog file whose first record names the columns.
+// Columns may come in any order; unknown columns are ignored.
+type csvReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// newCSVReader creates a CSV reader, reading the header record
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrMissingSKUColumn
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = i
+	}
+
+	if _, ok := columns["sku"]; !ok {
+		return nil, ErrMissingSKUColumn
+	}
+
+	return &csvReader{reader: reader, columns: columns}, nil
+}
+
+// Read reads the next record as a row
+func (r *csvReader) Read() (Row, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return Row{}, &RowError{Err: err}
+		}
+		return Row{}, err
+	}
+
+	value := func(column string) string {
+		if i, ok := r.columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	row := Row{
+		SKU:         value("sku"),
+		Name:        value("name"),
+		Description: value("description"),
+		TaxCategory: value("tax_category"),
+		Language:    value("language"),
+		Status:      value("status"),
+	}
+
+	if raw := value("price"); raw != "" {
+		price, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return Row{}, &RowError{SKU: row.SKU, Err: invalidColumn("price", raw)}
+		}
+		row.Price = &price
+	}
+
+	if raw := value("stock"); raw != "" {
+		stock, err := strconv.Atoi(raw)
+		if err != nil {
+			return Row{}, &RowError{SKU: row.SKU, Err: invalidColumn("stock", raw)}
+		}
+		row.Stock = &stock
+	}
+
+	if raw := value("weight"); raw != "" {
+		weight, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return Row{}, &RowError{SKU: row.SKU, Err: invalidColumn("weight", raw)}
+		}
+		row.Weight = &weight
+	}
+
+	return row, nil
+}
+
+// csvWriter writes a CSV catalog file with every column
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+// newCSVWriter creates a CSV writer, writing the header record
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(Columns); err != nil {
+		return nil, err
+	}
+	return &csvWriter{writer: writer}, nil
+}
+
+// Write writes a row as a record; values not given are left empty
+func (w *csvWriter) Write(row Row) error {
+	var price, stock, weight string
+	if row.Price != nil {
+		price = strconv.FormatFloat(*row.Price, 'f', -1, 64)
+	}
+	if row.Stock != nil {
+		stock = strconv.Itoa(*row.Stock)
+	}
+	if row.Weight != nil {
+		weight = strconv.FormatFloat(*row.Weight, 'f', -1, 64)
+	}
+
+	return w.writer.Write([]string{
+		row.SKU, row.Name, row.Description, price, stock, weight, row.TaxCategory, row.Language, row.Status,
+	})
+}
+
+// Flush writes the buffered records
+func (w *csvWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
@@ -0,0 +1,66 @@
+package catalogfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// maxLineSize is the longest line of an NDJSON catalog file
+const maxLineSize = 1 << 20
+
+// ndjsonReader reads an NDJSON catalog file, one JSON object per line. Blank lines are skipped.
+type ndjsonReader struct {
+	scanner *bufio.Scanner
+}
+
+// newNDJSONReader creates an NDJSON reader
+func newNDJSONReader(r io.Reader) *ndjsonReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	return &ndjsonReader{scanner: scanner}
+}
+
+// Read reads the next line as a row
+func (r *ndjsonReader) Read() (Row, error) {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var row Row
+		if err := json.Unmarshal(line, &row); err != nil {
+			return Row{}, &RowError{SKU: row.SKU, Err: err}
+		}
+		return row, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return Row{}, err
+	}
+	return Row{}, io.EOF
+}
+
+// ndjsonWriter writes an NDJSON catalog file, omitting the values not given
+type ndjsonWriter struct {
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+// newNDJSONWriter creates an NDJSON writer
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	writer := bufio.NewWriter(w)
+	return &ndjsonWriter{writer: writer, encoder: json.NewEncoder(writer)}
+}
+
+// Write writes a row as a line
+func (w *ndjsonWriter) Write(row Row) error {
+	return w.encoder.Encode(row)
+}
+
+// Flush writes the buffered lines
+func (w *ndjsonWriter) Flush() error {
+	return w.writer.Flush()
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/warehouse"
+)
+
+// AddVariantCommand represents the command to add a variant to a product.
+// A zero price makes the variant sell at the product price; stock is kept in the given
+// warehouse, or in the default one, and recorded as an adjustment by the given user.
+type AddVariantCommand struct {
+	ProductID    string            `json:"-"`
+	SKU          string            `json:"sku"`
+	OptionValues map[string]string `json:"option_values"`
+	Price        float64           `json:"price"`
+	Stock        int               `json:"stock"`
+	WarehouseID  string            `json:"warehouse_id"`
+	UserID       string            `json:"user_id"`
+}
+
+// AddVariantHandler handles the AddVariantCommand
+type AddVariantHandler struct {
+	productRepo   product.Repository
+	warehouseRepo warehouse.Repository
+	publisher     events.Publisher
+}
+
+// NewAddVariantHandler creates a new AddVariantHandler
+func NewAddVariantHandler(productRepo product.Repository, warehouseRepo warehouse.Repository, publisher events.Publisher) *AddVariantHandler {
+	return &AddVariantHandler{
+		productRepo:   productRepo,
+		warehouseRepo: warehouseRepo,
+		publisher:     publisher,
+	}
+}
+
+// Handle processes the AddVariantCommand
+func (h *AddVariantHandler) Handle(ctx context.Context, cmd AddVariantCommand) (string, error) {
+	// Convert ID string to domain ID
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return "", err
+	}
+
+	// Add the variant
+	variant, err := existingProduct.AddVariant(cmd.SKU, cmd.OptionValues, cmd.Price)
+	if err != nil {
+		return "", err
+	}
+
+	// Stock the variant in the given warehouse, or in the default one
+	if cmd.Stock != 0 {
+		w, err := resolveWarehouse(ctx, h.warehouseRepo, cmd.WarehouseID)
+		if err != nil {
+			return "", err
+		}
+
+		if err := existingProduct.ChangeVariantStock(
+			variant.ID(), w.ID(), cmd.Stock, product.ReasonAdjustment, product.UserReference(cmd.UserID),
+		); err != nil {
+			return "", err
+		}
+	}
+
+	// Check that no other product uses the SKU
+	if err := checkSKUAvailable(ctx, h.productRepo, variant.SKU(), existingProduct.ID()); err != nil {
+		return "", err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return "", err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+
+	return variant.ID().String(), nil
+}
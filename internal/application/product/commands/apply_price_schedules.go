@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+	"time"
+)
+
+// ApplyPriceSchedulesCommand represents the command to start and end the price schedules due by a time
+type ApplyPriceSchedulesCommand struct {
+	Now time.Time
+}
+
+// ApplyPriceSchedulesHandler handles the ApplyPriceSchedulesCommand
+type ApplyPriceSchedulesHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewApplyPriceSchedulesHandler creates a new ApplyPriceSchedulesHandler
+func NewApplyPriceSchedulesHandler(productRepo product.Repository, publisher events.Publisher) *ApplyPriceSchedulesHandler {
+	return &ApplyPriceSchedulesHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the ApplyPriceSchedulesCommand and returns the number of repriced products
+func (h *ApplyPriceSchedulesHandler) Handle(ctx context.Context, cmd ApplyPriceSchedulesCommand) (int, error) {
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// Find the products with schedules due
+	ids, err := h.productRepo.FindDuePriceSchedules(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	repriced := 0
+	for _, id := range ids {
+		existingProduct, err := h.productRepo.FindByID(ctx, id)
+		if err != nil {
+			return repriced, err
+		}
+
+		// Start and end the due schedules
+		if !existingProduct.ApplyPriceSchedules(now) {
+			continue
+		}
+
+		// Save the updated product
+		if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+			return repriced, err
+		}
+
+		// Publish the product events
+		publishEvents(ctx, h.publisher, existingProduct)
+		repriced++
+	}
+
+	return repriced, nil
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+)
+
+// ArchiveProductCommand represents the command to archive a product, withdrawing it from sale
+// while keeping it for the orders referencing it
+type ArchiveProductCommand struct {
+	ID string
+}
+
+// ArchiveProductHandler handles the ArchiveProductCommand
+type ArchiveProductHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewArchiveProductHandler creates a new ArchiveProductHandler
+func NewArchiveProductHandler(productRepo product.Repository, publisher events.Publisher) *ArchiveProductHandler {
+	return &ArchiveProductHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the ArchiveProductCommand
+func (h *ArchiveProductHandler) Handle(ctx context.Context, cmd ArchiveProductCommand) error {
+	// Convert ID string to domain ID
+	id, err := product.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Archive the product
+	if err := existingProduct.Archive(); err != nil {
+		return err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+	return nil
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+)
+
+// CancelPriceScheduleCommand represents the command to cancel a price schedule of a product
+type CancelPriceScheduleCommand struct {
+	ProductID  string
+	ScheduleID string
+}
+
+// CancelPriceScheduleHandler handles the CancelPriceScheduleCommand
+type CancelPriceScheduleHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewCancelPriceScheduleHandler creates a new CancelPriceScheduleHandler
+func NewCancelPriceScheduleHandler(productRepo product.Repository, publisher events.Publisher) *CancelPriceScheduleHandler {
+	return &CancelPriceScheduleHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the CancelPriceScheduleCommand
+func (h *CancelPriceScheduleHandler) Handle(ctx context.Context, cmd CancelPriceScheduleCommand) error {
+	// Convert ID strings to domain IDs
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	scheduleID, err := product.NewPriceScheduleID(cmd.ScheduleID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	// Cancel the schedule
+	if err := existingProduct.CancelPriceSchedule(scheduleID); err != nil {
+		return err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+	return nil
+}
@@ -0,0 +1,265 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/warehouse"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// OptionInput represents an option type of a product, such as size or color, with its allowed values
+type OptionInput struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// AttributeInput represents a typed product attribute; the value may be given as a string, number or boolean
+type AttributeInput struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// CreateProductCommand represents the command to create a new product.
+// The initial stock is recorded in the stock ledger as an adjustment by the given user;
+// staff are alerted when stock later falls to the reorder threshold, unless it is zero.
+// A backorder or preorder policy sells beyond the stock, up to the limit when positive.
+type CreateProductCommand struct {
+	SKU             string           `json:"sku"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Price           float64          `json:"price"`
+	Stock           int              `json:"stock"`
+	WarehouseID     string           `json:"warehouse_id"`
+	UserID          string           `json:"user_id"`
+	Threshold       int              `json:"reorder_threshold"`
+	BackorderPolicy string           `json:"backorder_policy"`
+	BackorderLimit  int              `json:"backorder_limit"`
+	AvailableAt     *time.Time       `json:"available_at"`
+	Weight          float64          `json:"weight"`
+	Length          float64          `json:"length"`
+	Width           float64          `json:"width"`
+	Height          float64          `json:"height"`
+	TaxCategory     string           `json:"tax_category"`
+	Language        string           `json:"language"`
+	CategoryIDs     []string         `json:"category_ids"`
+	Options         []OptionInput    `json:"options"`
+	Attributes      []AttributeInput `json:"attributes"`
+}
+
+// CreateProductHandler handles the CreateProductCommand
+type CreateProductHandler struct {
+	productRepo   product.Repository
+	categoryRepo  category.Repository
+	warehouseRepo warehouse.Repository
+	publisher     events.Publisher
+}
+
+// NewCreateProductHandler creates a new CreateProductHandler
+func NewCreateProductHandler(
+	productRepo product.Repository,
+	categoryRepo category.Repository,
+	warehouseRepo warehouse.Repository,
+	publisher events.Publisher,
+) *CreateProductHandler {
+	return &CreateProductHandler{
+		productRepo:   productRepo,
+		categoryRepo:  categoryRepo,
+		warehouseRepo: warehouseRepo,
+		publisher:     publisher,
+	}
+}
+
+// Handle processes the CreateProductCommand
+func (h *CreateProductHandler) Handle(ctx context.Context, cmd CreateProductCommand) (string, error) {
+	// Create a new product
+	newProduct, err := product.NewProduct(cmd.Name, cmd.Description, cmd.Price)
+	if err != nil {
+		return "", err
+	}
+
+	// Stock the product in the given warehouse, or in the default one
+	if cmd.Stock != 0 {
+		w, err := resolveWarehouse(ctx, h.warehouseRepo, cmd.WarehouseID)
+		if err != nil {
+			return "", err
+		}
+
+		if err := newProduct.ChangeStock(w.ID(), cmd.Stock, product.ReasonAdjustment, product.UserReference(cmd.UserID)); err != nil {
+			return "", err
+		}
+	}
+
+	// Set the reorder threshold
+	if err := newProduct.ChangeReorderThreshold(cmd.Threshold); err != nil {
+		return "", err
+	}
+
+	// Set the backorder policy if provided
+	if cmd.BackorderPolicy != "" {
+		if err := newProduct.ChangeBackorderPolicy(cmd.BackorderPolicy, cmd.BackorderLimit, cmd.AvailableAt); err != nil {
+			return "", err
+		}
+	}
+
+	// Set the SKU if provided, unless another product or variant has it
+	if cmd.SKU != "" {
+		if err := newProduct.ChangeSKU(cmd.SKU); err != nil {
+			return "", err
+		}
+
+		if err := checkSKUAvailable(ctx, h.productRepo, newProduct.SKU(), newProduct.ID()); err != nil {
+			return "", err
+		}
+	}
+
+	// Set the shipping profile
+	if err := newProduct.ChangeShippingProfile(cmd.Weight, cmd.Length, cmd.Width, cmd.Height); err != nil {
+		return "", err
+	}
+
+	// Set the tax category if provided
+	if cmd.TaxCategory != "" {
+		if err := newProduct.ChangeTaxCategory(cmd.TaxCategory); err != nil {
+			return "", err
+		}
+	}
+
+	// Set the language if provided
+	if cmd.Language != "" {
+		if err := newProduct.ChangeLanguage(cmd.Language); err != nil {
+			return "", err
+		}
+	}
+
+	// Set the option types variants are made of
+	if len(cmd.Options) > 0 {
+		options, err := toOptions(cmd.Options)
+		if err != nil {
+			return "", err
+		}
+
+		if err := newProduct.ChangeOptions(options); err != nil {
+			return "", err
+		}
+	}
+
+	// Set the attributes
+	if len(cmd.Attributes) > 0 {
+		attributes, err := toAttributes(cmd.Attributes)
+		if err != nil {
+			return "", err
+		}
+
+		if err := newProduct.ChangeAttributes(attributes); err != nil {
+			return "", err
+		}
+	}
+
+	// Assign the categories
+	if err := assignCategories(ctx, h.categoryRepo, newProduct, cmd.CategoryIDs); err != nil {
+		return "", err
+	}
+
+	// Save the product
+	if err := h.productRepo.Save(ctx, newProduct); err != nil {
+		return "", err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, newProduct)
+
+	return newProduct.ID().String(), nil
+}
+
+// assignCategories checks that every category exists and assigns them to the product
+func assignCategories(ctx context.Context, categoryRepo category.Repository, p *product.Product, categoryIDs []string) error {
+	for _, categoryID := range categoryIDs {
+		if _, err := categoryRepo.FindByID(ctx, category.ID(categoryID)); err != nil {
+			return err
+		}
+	}
+
+	return p.AssignCategories(categoryIDs)
+}
+
+// checkSKUAvailable checks that no product other than the given one has the SKU,
+// either as its own SKU or as the SKU of one of its variants
+func checkSKUAvailable(ctx context.Context, productRepo product.Repository, sku product.SKU, productID product.ID) error {
+	existing, err := productRepo.FindBySKU(ctx, sku)
+	if errors.Is(err, product.ErrProductNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.ID() != productID {
+		return product.ErrDuplicateSKU
+	}
+	return nil
+}
+
+// resolveWarehouse returns the warehouse with the given ID, or the default warehouse when no ID is given
+func resolveWarehouse(ctx context.Context, warehouseRepo warehouse.Repository, warehouseID string) (*warehouse.Warehouse, error) {
+	if warehouseID == "" {
+		return warehouseRepo.FindDefault(ctx)
+	}
+
+	id, err := warehouse.NewID(warehouseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return warehouseRepo.FindByID(ctx, id)
+}
+
+// toMovementReason converts the reason given for a stock change, an adjustment by default
+func toMovementReason(reason string) (product.MovementReason, error) {
+	if reason == "" {
+		return product.ReasonAdjustment, nil
+	}
+	return product.NewMovementReason(reason)
+}
+
+// publishEvents publishes the events recorded by a product once it has been saved
+func publishEvents(ctx context.Context, publisher events.Publisher, p *product.Product) {
+	for _, event := range p.PullEvents() {
+		publisher.Publish(ctx, event)
+	}
+}
+
+// toOptions converts option inputs to domain options
+func toOptions(inputs []OptionInput) ([]product.Option, error) {
+	options := make([]product.Option, len(inputs))
+	for i, input := range inputs {
+		option, err := product.NewOption(input.Name, input.Values)
+		if err != nil {
+			return nil, err
+		}
+		options[i] = option
+	}
+	return options, nil
+}
+
+// toAttributes converts attribute inputs to domain attributes
+func toAttributes(inputs []AttributeInput) ([]product.Attribute, error) {
+	attributes := make([]product.Attribute, len(inputs))
+	for i, input := range inputs {
+		value := ""
+		if input.Value != nil {
+			value = fmt.Sprint(input.Value)
+		}
+
+		attribute, err := product.NewAttribute(input.Name, input.Type, value)
+		if err != nil {
+			return nil, err
+		}
+		attributes[i] = attribute
+	}
+	return attributes, nil
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/product/catalogfile"
+	"e-commerce/internal/application/storage"
+	"e-commerce/internal/domain/importjob"
+)
+
+// ImportProductsCommand represents the command to import a catalog file in the background.
+// The format is csv or ndjson.
+type ImportProductsCommand struct {
+	Format   string
+	FileName string
+	Data     []byte
+}
+
+// ImportProductsHandler handles the ImportProductsCommand
+type ImportProductsHandler struct {
+	jobRepo   importjob.Repository
+	blobStore storage.BlobStore
+}
+
+// NewImportProductsHandler creates a new ImportProductsHandler
+func NewImportProductsHandler(jobRepo importjob.Repository, blobStore storage.BlobStore) *ImportProductsHandler {
+	return &ImportProductsHandler{
+		jobRepo:   jobRepo,
+		blobStore: blobStore,
+	}
+}
+
+// Handle processes the ImportProductsCommand and returns the ID of the import job
+func (h *ImportProductsHandler) Handle(ctx context.Context, cmd ImportProductsCommand) (string, error) {
+	// Create a new pending job
+	job, err := importjob.NewJob(cmd.Format, cmd.FileName)
+	if err != nil {
+		return "", err
+	}
+
+	// Store the file for the job to read
+	if err := h.blobStore.Put(ctx, job.FileKey(), cmd.Data, catalogfile.ContentType(job.Format())); err != nil {
+		return "", err
+	}
+
+	// Save the job
+	if err := h.jobRepo.Save(ctx, job); err != nil {
+		return "", err
+	}
+
+	return job.ID().String(), nil
+}
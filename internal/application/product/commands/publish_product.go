@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+	"time"
+)
+
+// PublishProductCommand represents the command to publish a product,
+// now or at a scheduled time
+type PublishProductCommand struct {
+	ID        string     `json:"-"`
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+// PublishProductHandler handles the PublishProductCommand
+type PublishProductHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewPublishProductHandler creates a new PublishProductHandler
+func NewPublishProductHandler(productRepo product.Repository, publisher events.Publisher) *PublishProductHandler {
+	return &PublishProductHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the PublishProductCommand
+func (h *PublishProductHandler) Handle(ctx context.Context, cmd PublishProductCommand) error {
+	// Convert ID string to domain ID
+	id, err := product.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Publish the product, now unless a time is given
+	var publishAt time.Time
+	if cmd.PublishAt != nil {
+		publishAt = *cmd.PublishAt
+	}
+
+	if err := existingProduct.Publish(publishAt); err != nil {
+		return err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+	return nil
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/product/search"
+	"e-commerce/internal/domain/product"
+)
+
+// reindexBatchSize is the number of products loaded and indexed at a time
+const reindexBatchSize = 100
+
+// ReindexProductsCommand represents the command to rebuild the search index from the catalog
+type ReindexProductsCommand struct{}
+
+// ReindexProductsHandler handles the ReindexProductsCommand
+type ReindexProductsHandler struct {
+	productRepo product.Repository
+	index       search.SearchIndex
+}
+
+// NewReindexProductsHandler creates a new ReindexProductsHandler
+func NewReindexProductsHandler(productRepo product.Repository, index search.SearchIndex) *ReindexProductsHandler {
+	return &ReindexProductsHandler{
+		productRepo: productRepo,
+		index:       index,
+	}
+}
+
+// Handle processes the ReindexProductsCommand and returns the number of indexed products
+func (h *ReindexProductsHandler) Handle(ctx context.Context, cmd ReindexProductsCommand) (int, error) {
+	// Empty the index
+	if err := h.index.Clear(ctx); err != nil {
+		return 0, err
+	}
+
+	// Index the published catalog batch by batch, scheduled products included
+	indexed := 0
+	for {
+		products, err := h.productRepo.List(ctx, product.Filter{Status: product.StatusPublished}, reindexBatchSize, indexed)
+		if err != nil {
+			return indexed, err
+		}
+
+		documents := make([]search.Document, len(products))
+		for i, p := range products {
+			documents[i] = search.NewDocument(p)
+		}
+
+		if err := h.index.Index(ctx, documents...); err != nil {
+			return indexed, err
+		}
+
+		indexed += len(products)
+		if len(products) < reindexBatchSize {
+			return indexed, nil
+		}
+	}
+}
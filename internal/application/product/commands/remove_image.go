@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/storage"
+	"e-commerce/internal/domain/product"
+)
+
+// RemoveImageCommand represents the command to remove an image from a product
+type RemoveImageCommand struct {
+	ProductID string
+	ImageID   string
+}
+
+// RemoveImageHandler handles the RemoveImageCommand
+type RemoveImageHandler struct {
+	productRepo product.Repository
+	blobStore   storage.BlobStore
+	publisher   events.Publisher
+}
+
+// NewRemoveImageHandler creates a new RemoveImageHandler
+func NewRemoveImageHandler(productRepo product.Repository, blobStore storage.BlobStore, publisher events.Publisher) *RemoveImageHandler {
+	return &RemoveImageHandler{
+		productRepo: productRepo,
+		blobStore:   blobStore,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the RemoveImageCommand
+func (h *RemoveImageHandler) Handle(ctx context.Context, cmd RemoveImageCommand) error {
+	// Convert ID strings to domain IDs
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	imageID, err := product.NewImageID(cmd.ImageID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the image
+	removed, err := existingProduct.RemoveImage(imageID)
+	if err != nil {
+		return err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return err
+	}
+
+	// Delete the stored files once nothing references them
+	deleteRenditions(ctx, h.blobStore, removed.Renditions())
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+	return nil
+}
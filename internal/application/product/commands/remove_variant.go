@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+)
+
+// RemoveVariantCommand represents the command to remove a variant from a product
+type RemoveVariantCommand struct {
+	ProductID string
+	VariantID string
+}
+
+// RemoveVariantHandler handles the RemoveVariantCommand
+type RemoveVariantHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewRemoveVariantHandler creates a new RemoveVariantHandler
+func NewRemoveVariantHandler(productRepo product.Repository, publisher events.Publisher) *RemoveVariantHandler {
+	return &RemoveVariantHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the RemoveVariantCommand
+func (h *RemoveVariantHandler) Handle(ctx context.Context, cmd RemoveVariantCommand) error {
+	// Convert ID strings to domain IDs
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	variantID, err := product.NewVariantID(cmd.VariantID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the variant
+	if err := existingProduct.RemoveVariant(variantID); err != nil {
+		return err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+	return nil
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+)
+
+// ReorderImagesCommand represents the command to change the display order of the images of a product
+type ReorderImagesCommand struct {
+	ProductID string   `json:"-"`
+	ImageIDs  []string `json:"image_ids"`
+}
+
+// ReorderImagesHandler handles the ReorderImagesCommand
+type ReorderImagesHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewReorderImagesHandler creates a new ReorderImagesHandler
+func NewReorderImagesHandler(productRepo product.Repository, publisher events.Publisher) *ReorderImagesHandler {
+	return &ReorderImagesHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the ReorderImagesCommand
+func (h *ReorderImagesHandler) Handle(ctx context.Context, cmd ReorderImagesCommand) error {
+	// Convert ID strings to domain IDs
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	imageIDs := make([]product.ImageID, len(cmd.ImageIDs))
+	for i, id := range cmd.ImageIDs {
+		imageIDs[i], err = product.NewImageID(id)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	// Reorder the images
+	if err := existingProduct.ReorderImages(imageIDs); err != nil {
+		return err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+	return nil
+}
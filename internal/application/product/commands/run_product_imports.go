@@ -0,0 +1,323 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/product/catalogfile"
+	"e-commerce/internal/application/storage"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/importjob"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/warehouse"
+	"errors"
+	"io"
+	"time"
+)
+
+// Import processing settings
+const (
+	// importProgressInterval is the number of rows processed between progress saves
+	importProgressInterval = 100
+
+	// importStaleAfter is how long a running job may go without saving progress
+	// before its worker is considered stopped and another one starts it over
+	importStaleAfter = 5 * time.Minute
+)
+
+// RunProductImportsCommand represents the command to process the pending import jobs
+type RunProductImportsCommand struct {
+	Now time.Time
+}
+
+// RunProductImportsHandler handles the RunProductImportsCommand
+type RunProductImportsHandler struct {
+	uow           transaction.UnitOfWork
+	productRepo   product.Repository
+	warehouseRepo warehouse.Repository
+	jobRepo       importjob.Repository
+	blobStore     storage.BlobStore
+	backorders    *inventory.BackorderFiller
+	publisher     events.Publisher
+}
+
+// NewRunProductImportsHandler creates a new RunProductImportsHandler
+func NewRunProductImportsHandler(
+	uow transaction.UnitOfWork,
+	productRepo product.Repository,
+	warehouseRepo warehouse.Repository,
+	jobRepo importjob.Repository,
+	blobStore storage.BlobStore,
+	backorders *inventory.BackorderFiller,
+	publisher events.Publisher,
+) *RunProductImportsHandler {
+	return &RunProductImportsHandler{
+		uow:           uow,
+		productRepo:   productRepo,
+		warehouseRepo: warehouseRepo,
+		jobRepo:       jobRepo,
+		blobStore:     blobStore,
+		backorders:    backorders,
+		publisher:     publisher,
+	}
+}
+
+// Handle processes the RunProductImportsCommand, running jobs until none is pending,
+// and returns the number of jobs run
+func (h *RunProductImportsHandler) Handle(ctx context.Context, cmd RunProductImportsCommand) (int, error) {
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	run := 0
+	for {
+		// Claim the next pending job
+		job, err := h.jobRepo.ClaimNext(ctx, now, now.Add(-importStaleAfter))
+		if errors.Is(err, importjob.ErrNoPendingJob) {
+			return run, nil
+		}
+		if err != nil {
+			return run, err
+		}
+
+		if err := h.runJob(ctx, job); err != nil {
+			return run, err
+		}
+		run++
+	}
+}
+
+// runJob imports the rows of a job's file one at a time. Rows that cannot be applied are
+// recorded on the job and the import continues; the job only fails when its file cannot be read.
+func (h *RunProductImportsHandler) runJob(ctx context.Context, job *importjob.Job) error {
+	if err := job.Start(time.Now()); err != nil {
+		return err
+	}
+
+	if err := h.jobRepo.Update(ctx, job); err != nil {
+		return err
+	}
+
+	// Open the uploaded file
+	blob, err := h.blobStore.Get(ctx, job.FileKey())
+	if err != nil {
+		return h.failJob(ctx, job, err)
+	}
+	defer blob.Body.Close()
+
+	reader, err := catalogfile.NewReader(job.Format(), blob.Body)
+	if err != nil {
+		return h.failJob(ctx, job, err)
+	}
+
+	// Find the warehouse stock changes are made up in
+	defaultWarehouse, err := h.warehouseRepo.FindDefault(ctx)
+	if err != nil {
+		return h.failJob(ctx, job, err)
+	}
+
+	// Import the rows, saving progress as they go
+	for rowNumber := 1; ; rowNumber++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowErr *catalogfile.RowError
+		switch {
+		case errors.As(err, &rowErr):
+			job.RecordRowError(rowNumber, rowErr.SKU, rowErr.Error())
+		case err != nil:
+			return h.failJob(ctx, job, err)
+		default:
+			created, err := h.importRow(ctx, defaultWarehouse.ID(), row)
+			switch {
+			case err != nil:
+				job.RecordRowError(rowNumber, row.SKU, err.Error())
+			case created:
+				job.RecordCreated()
+			default:
+				job.RecordUpdated()
+			}
+		}
+
+		if rowNumber%importProgressInterval == 0 {
+			if err := h.jobRepo.Update(ctx, job); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := job.Complete(time.Now()); err != nil {
+		return err
+	}
+
+	return h.jobRepo.Update(ctx, job)
+}
+
+// failJob records why a job could not be run
+func (h *RunProductImportsHandler) failJob(ctx context.Context, job *importjob.Job, reason error) error {
+	if err := job.Fail(time.Now(), reason.Error()); err != nil {
+		return err
+	}
+
+	return h.jobRepo.Update(ctx, job)
+}
+
+// importRow upserts the product or variant with the row's SKU, reporting whether a product was created.
+// Rows of a variant SKU only change the variant price override and stock. The stock given is the
+// total across warehouses, made up in the given warehouse.
+func (h *RunProductImportsHandler) importRow(ctx context.Context, warehouseID warehouse.ID, row catalogfile.Row) (bool, error) {
+	sku, err := product.NewSKU(row.SKU)
+	if err != nil {
+		return false, err
+	}
+
+	// Find the product or variant with the SKU
+	existingProduct, err := h.productRepo.FindBySKU(ctx, sku)
+	if errors.Is(err, product.ErrProductNotFound) {
+		return true, h.createProduct(ctx, warehouseID, sku, row)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if variant, err := existingProduct.FindVariantBySKU(sku); err == nil {
+		if err := applyVariantRow(existingProduct, warehouseID, variant, row); err != nil {
+			return false, err
+		}
+	} else if err := applyProductRow(existingProduct, warehouseID, row); err != nil {
+		return false, err
+	}
+
+	// Save the updated product with the orders its stock was allocated to
+	if err := updateStock(ctx, h.uow, h.productRepo, h.backorders, h.publisher, existingProduct); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// createProduct creates a product from a row, which must give at least its name, description and price
+func (h *RunProductImportsHandler) createProduct(ctx context.Context, warehouseID warehouse.ID, sku product.SKU, row catalogfile.Row) error {
+	var price float64
+	if row.Price != nil {
+		price = *row.Price
+	}
+
+	// Create a new product
+	newProduct, err := product.NewProduct(row.Name, row.Description, price)
+	if err != nil {
+		return err
+	}
+
+	if err := newProduct.ChangeSKU(sku.String()); err != nil {
+		return err
+	}
+
+	if err := applyProductRow(newProduct, warehouseID, row); err != nil {
+		return err
+	}
+
+	// Save the product
+	if err := h.productRepo.Save(ctx, newProduct); err != nil {
+		return err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, newProduct)
+	return nil
+}
+
+// applyProductRow applies the values given in a row to a product
+func applyProductRow(p *product.Product, warehouseID warehouse.ID, row catalogfile.Row) error {
+	if row.Name != "" && row.Name != p.Name().String() {
+		if err := p.ChangeName(row.Name); err != nil {
+			return err
+		}
+	}
+
+	if row.Description != "" && row.Description != p.Description().String() {
+		if err := p.ChangeDescription(row.Description); err != nil {
+			return err
+		}
+	}
+
+	if row.Price != nil && *row.Price != p.Price().Value() {
+		if err := p.ChangePrice(*row.Price); err != nil {
+			return err
+		}
+	}
+
+	if row.Stock != nil && *row.Stock != p.Stock().Value() {
+		if err := p.ChangeTotalStock(warehouseID, "", *row.Stock, product.ReasonAdjustment, product.Reference{}); err != nil {
+			return err
+		}
+	}
+
+	if row.Weight != nil && *row.Weight != p.Weight().Value() {
+		dimensions := p.Dimensions()
+		if err := p.ChangeShippingProfile(*row.Weight, dimensions.Length(), dimensions.Width(), dimensions.Height()); err != nil {
+			return err
+		}
+	}
+
+	if row.TaxCategory != "" && row.TaxCategory != p.TaxCategory().String() {
+		if err := p.ChangeTaxCategory(row.TaxCategory); err != nil {
+			return err
+		}
+	}
+
+	if row.Language != "" && row.Language != p.Language().String() {
+		if err := p.ChangeLanguage(row.Language); err != nil {
+			return err
+		}
+	}
+
+	if row.Status != "" {
+		return applyStatus(p, row.Status)
+	}
+	return nil
+}
+
+// applyVariantRow applies the price and stock given in a row to a variant
+func applyVariantRow(p *product.Product, warehouseID warehouse.ID, v *product.Variant, row catalogfile.Row) error {
+	if row.Price != nil && *row.Price != v.PriceOverride().Value() {
+		if err := p.ChangeVariantPrice(v.ID(), *row.Price); err != nil {
+			return err
+		}
+	}
+
+	if row.Stock != nil && *row.Stock != v.Stock().Value() {
+		if err := p.ChangeTotalStock(warehouseID, v.ID(), *row.Stock, product.ReasonAdjustment, product.Reference{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyStatus moves a product to the given lifecycle status, publishing it now when published
+func applyStatus(p *product.Product, status string) error {
+	statusVO, err := product.NewStatus(status)
+	if err != nil {
+		return err
+	}
+
+	if statusVO == p.Status() {
+		return nil
+	}
+
+	switch statusVO {
+	case product.StatusPublished:
+		return p.Publish(time.Time{})
+	case product.StatusDraft:
+		return p.Unpublish()
+	default:
+		return p.Archive()
+	}
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SchedulePriceCommand represents the command to schedule a change of a product price.
+// With an end time the change is a sale, after which the current price is restored.
+type SchedulePriceCommand struct {
+	ProductID string     `json:"-"`
+	Price     float64    `json:"price"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+}
+
+// SchedulePriceHandler handles the SchedulePriceCommand
+type SchedulePriceHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewSchedulePriceHandler creates a new SchedulePriceHandler
+func NewSchedulePriceHandler(productRepo product.Repository, publisher events.Publisher) *SchedulePriceHandler {
+	return &SchedulePriceHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the SchedulePriceCommand and returns the ID of the schedule
+func (h *SchedulePriceHandler) Handle(ctx context.Context, cmd SchedulePriceCommand) (string, error) {
+	// Convert ID string to domain ID
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return "", err
+	}
+
+	// Schedule the price
+	schedule, err := existingProduct.SchedulePrice(
+		product.PriceScheduleID(uuid.New().String()),
+		cmd.Price,
+		cmd.StartsAt,
+		cmd.EndsAt,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return "", err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+
+	return schedule.ID().String(), nil
+}
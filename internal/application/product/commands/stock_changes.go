@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/product"
+)
+
+// updateStock saves a product whose stock may have come in. Stock coming in is allocated to the order
+// items waiting for it, so the product and those orders are written in one unit of work, and the product
+// events are published once it is committed.
+func updateStock(
+	ctx context.Context,
+	uow transaction.UnitOfWork,
+	productRepo product.Repository,
+	backorders *inventory.BackorderFiller,
+	publisher events.Publisher,
+	p *product.Product,
+) error {
+	var changes []product.Event
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		if err := productRepo.Update(ctx, p); err != nil {
+			return err
+		}
+
+		changes = p.PullEvents()
+		return backorders.Fill(ctx, changes)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range changes {
+		publisher.Publish(ctx, event)
+	}
+	return nil
+}
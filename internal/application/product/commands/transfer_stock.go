@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/warehouse"
+)
+
+// TransferStockCommand represents the command to move stock of a product, or of one of its
+// variants, from one warehouse to another on behalf of the given user
+type TransferStockCommand struct {
+	ProductID       string `json:"-"`
+	VariantID       string `json:"variant_id"`
+	FromWarehouseID string `json:"from_warehouse_id"`
+	ToWarehouseID   string `json:"to_warehouse_id"`
+	Quantity        int    `json:"quantity"`
+	UserID          string `json:"user_id"`
+}
+
+// TransferStockHandler handles the TransferStockCommand
+type TransferStockHandler struct {
+	uow           transaction.UnitOfWork
+	productRepo   product.Repository
+	warehouseRepo warehouse.Repository
+	backorders    *inventory.BackorderFiller
+	publisher     events.Publisher
+}
+
+// NewTransferStockHandler creates a new TransferStockHandler
+func NewTransferStockHandler(
+	uow transaction.UnitOfWork,
+	productRepo product.Repository,
+	warehouseRepo warehouse.Repository,
+	backorders *inventory.BackorderFiller,
+	publisher events.Publisher,
+) *TransferStockHandler {
+	return &TransferStockHandler{
+		uow:           uow,
+		productRepo:   productRepo,
+		warehouseRepo: warehouseRepo,
+		backorders:    backorders,
+		publisher:     publisher,
+	}
+}
+
+// Handle processes the TransferStockCommand
+func (h *TransferStockHandler) Handle(ctx context.Context, cmd TransferStockCommand) error {
+	// Convert ID strings to domain IDs
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	fromID, err := warehouse.NewID(cmd.FromWarehouseID)
+	if err != nil {
+		return err
+	}
+
+	toID, err := warehouse.NewID(cmd.ToWarehouseID)
+	if err != nil {
+		return err
+	}
+
+	// Check that both warehouses exist
+	if _, err := h.warehouseRepo.FindByID(ctx, fromID); err != nil {
+		return err
+	}
+
+	if _, err := h.warehouseRepo.FindByID(ctx, toID); err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	// Move the stock
+	if err := existingProduct.TransferStock(
+		product.VariantID(cmd.VariantID), fromID, toID, cmd.Quantity, product.UserReference(cmd.UserID),
+	); err != nil {
+		return err
+	}
+
+	// Save the updated product with the orders its stock was allocated to
+	return updateStock(ctx, h.uow, h.productRepo, h.backorders, h.publisher, existingProduct)
+}
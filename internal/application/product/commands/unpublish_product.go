@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+)
+
+// UnpublishProductCommand represents the command to take a published or scheduled product back to draft
+type UnpublishProductCommand struct {
+	ID string
+}
+
+// UnpublishProductHandler handles the UnpublishProductCommand
+type UnpublishProductHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewUnpublishProductHandler creates a new UnpublishProductHandler
+func NewUnpublishProductHandler(productRepo product.Repository, publisher events.Publisher) *UnpublishProductHandler {
+	return &UnpublishProductHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the UnpublishProductCommand
+func (h *UnpublishProductHandler) Handle(ctx context.Context, cmd UnpublishProductCommand) error {
+	// Convert ID string to domain ID
+	id, err := product.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Take the product back to draft
+	if err := existingProduct.Unpublish(); err != nil {
+		return err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+	return nil
+}
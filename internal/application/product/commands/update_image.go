@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+)
+
+// UpdateImageCommand represents the command to change the alt text of a product image
+type UpdateImageCommand struct {
+	ProductID string `json:"-"`
+	ImageID   string `json:"-"`
+	AltText   string `json:"alt_text"`
+}
+
+// UpdateImageHandler handles the UpdateImageCommand
+type UpdateImageHandler struct {
+	productRepo product.Repository
+	publisher   events.Publisher
+}
+
+// NewUpdateImageHandler creates a new UpdateImageHandler
+func NewUpdateImageHandler(productRepo product.Repository, publisher events.Publisher) *UpdateImageHandler {
+	return &UpdateImageHandler{
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the UpdateImageCommand
+func (h *UpdateImageHandler) Handle(ctx context.Context, cmd UpdateImageCommand) error {
+	// Convert ID strings to domain IDs
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	imageID, err := product.NewImageID(cmd.ImageID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	// Change the alt text
+	if err := existingProduct.ChangeImageAltText(imageID, cmd.AltText); err != nil {
+		return err
+	}
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		return err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+	return nil
+}
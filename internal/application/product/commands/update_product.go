@@ -0,0 +1,232 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/warehouse"
+	"time"
+)
+
+// UpdateProductCommand represents the command to update a product.
+// Pointer and slice fields are only applied when provided; an empty SKU removes it.
+// Stock is the stock held in the given warehouse, or the total across warehouses when none is given;
+// the change is recorded in the stock ledger with the given reason, an adjustment by default, and user.
+// The backorder policy, limit and expected availability date are replaced together when the policy is given.
+type UpdateProductCommand struct {
+	ID              string           `json:"-"`
+	SKU             *string          `json:"sku"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Price           *float64         `json:"price"`
+	Stock           *int             `json:"stock"`
+	WarehouseID     string           `json:"warehouse_id"`
+	StockReason     string           `json:"stock_reason"`
+	UserID          string           `json:"user_id"`
+	Threshold       *int             `json:"reorder_threshold"`
+	BackorderPolicy string           `json:"backorder_policy"`
+	BackorderLimit  int              `json:"backorder_limit"`
+	AvailableAt     *time.Time       `json:"available_at"`
+	Weight          *float64         `json:"weight"`
+	Length          *float64         `json:"length"`
+	Width           *float64         `json:"width"`
+	Height          *float64         `json:"height"`
+	TaxCategory     string           `json:"tax_category"`
+	Language        string           `json:"language"`
+	CategoryIDs     []string         `json:"category_ids"`
+	Options         []OptionInput    `json:"options"`
+	Attributes      []AttributeInput `json:"attributes"`
+}
+
+// UpdateProductHandler handles the UpdateProductCommand
+type UpdateProductHandler struct {
+	uow           transaction.UnitOfWork
+	productRepo   product.Repository
+	categoryRepo  category.Repository
+	warehouseRepo warehouse.Repository
+	backorders    *inventory.BackorderFiller
+	publisher     events.Publisher
+}
+
+// NewUpdateProductHandler creates a new UpdateProductHandler
+func NewUpdateProductHandler(
+	uow transaction.UnitOfWork,
+	productRepo product.Repository,
+	categoryRepo category.Repository,
+	warehouseRepo warehouse.Repository,
+	backorders *inventory.BackorderFiller,
+	publisher events.Publisher,
+) *UpdateProductHandler {
+	return &UpdateProductHandler{
+		uow:           uow,
+		productRepo:   productRepo,
+		categoryRepo:  categoryRepo,
+		warehouseRepo: warehouseRepo,
+		backorders:    backorders,
+		publisher:     publisher,
+	}
+}
+
+// Handle processes the UpdateProductCommand
+func (h *UpdateProductHandler) Handle(ctx context.Context, cmd UpdateProductCommand) error {
+	// Convert ID string to domain ID
+	id, err := product.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Update product fields if provided
+	if cmd.SKU != nil {
+		if err := existingProduct.ChangeSKU(*cmd.SKU); err != nil {
+			return err
+		}
+
+		if existingProduct.SKU() != "" {
+			if err := checkSKUAvailable(ctx, h.productRepo, existingProduct.SKU(), existingProduct.ID()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cmd.Name != "" && cmd.Name != existingProduct.Name().String() {
+		if err := existingProduct.ChangeName(cmd.Name); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Description != "" && cmd.Description != existingProduct.Description().String() {
+		if err := existingProduct.ChangeDescription(cmd.Description); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Price != nil {
+		if err := existingProduct.ChangePrice(*cmd.Price); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Threshold != nil {
+		if err := existingProduct.ChangeReorderThreshold(*cmd.Threshold); err != nil {
+			return err
+		}
+	}
+
+	if cmd.BackorderPolicy != "" {
+		if err := existingProduct.ChangeBackorderPolicy(cmd.BackorderPolicy, cmd.BackorderLimit, cmd.AvailableAt); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Stock != nil {
+		if err := changeStock(ctx, h.warehouseRepo, existingProduct, "", cmd.WarehouseID, *cmd.Stock, cmd.StockReason, cmd.UserID); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Weight != nil || cmd.Length != nil || cmd.Width != nil || cmd.Height != nil {
+		dimensions := existingProduct.Dimensions()
+		weight := valueOr(cmd.Weight, existingProduct.Weight().Value())
+		length := valueOr(cmd.Length, dimensions.Length())
+		width := valueOr(cmd.Width, dimensions.Width())
+		height := valueOr(cmd.Height, dimensions.Height())
+
+		if err := existingProduct.ChangeShippingProfile(weight, length, width, height); err != nil {
+			return err
+		}
+	}
+
+	if cmd.TaxCategory != "" && cmd.TaxCategory != existingProduct.TaxCategory().String() {
+		if err := existingProduct.ChangeTaxCategory(cmd.TaxCategory); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Language != "" && cmd.Language != existingProduct.Language().String() {
+		if err := existingProduct.ChangeLanguage(cmd.Language); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Options != nil {
+		options, err := toOptions(cmd.Options)
+		if err != nil {
+			return err
+		}
+
+		if err := existingProduct.ChangeOptions(options); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Attributes != nil {
+		attributes, err := toAttributes(cmd.Attributes)
+		if err != nil {
+			return err
+		}
+
+		if err := existingProduct.ChangeAttributes(attributes); err != nil {
+			return err
+		}
+	}
+
+	if cmd.CategoryIDs != nil {
+		if err := assignCategories(ctx, h.categoryRepo, existingProduct, cmd.CategoryIDs); err != nil {
+			return err
+		}
+	}
+
+	// Save the updated product with the orders its stock was allocated to
+	return updateStock(ctx, h.uow, h.productRepo, h.backorders, h.publisher, existingProduct)
+}
+
+// valueOr returns the pointed-to value or the fallback when nil
+func valueOr(value *float64, fallback float64) float64 {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
+
+// changeStock changes the stock of a product, or of one of its variants, held in the given warehouse.
+// Without a warehouse the stock is the total across warehouses, made up in the default warehouse.
+func changeStock(
+	ctx context.Context,
+	warehouseRepo warehouse.Repository,
+	p *product.Product,
+	variantID product.VariantID,
+	warehouseID string,
+	stock int,
+	reason string,
+	userID string,
+) error {
+	reasonVO, err := toMovementReason(reason)
+	if err != nil {
+		return err
+	}
+	reference := product.UserReference(userID)
+
+	w, err := resolveWarehouse(ctx, warehouseRepo, warehouseID)
+	if err != nil {
+		return err
+	}
+
+	if warehouseID == "" {
+		return p.ChangeTotalStock(w.ID(), variantID, stock, reasonVO, reference)
+	}
+
+	if variantID != "" {
+		return p.ChangeVariantStock(variantID, w.ID(), stock, reasonVO, reference)
+	}
+
+	return p.ChangeStock(w.ID(), stock, reasonVO, reference)
+}
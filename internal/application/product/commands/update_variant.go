@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/inventory"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/warehouse"
+)
+
+// UpdateVariantCommand represents the command to update the price or stock of a variant.
+// Pointer fields are only applied when provided; a zero price removes the price override.
+// Stock is the stock held in the given warehouse, or the total across warehouses when none is given;
+// the change is recorded in the stock ledger with the given reason, an adjustment by default, and user.
+type UpdateVariantCommand struct {
+	ProductID   string   `json:"-"`
+	VariantID   string   `json:"-"`
+	Price       *float64 `json:"price"`
+	Stock       *int     `json:"stock"`
+	WarehouseID string   `json:"warehouse_id"`
+	StockReason string   `json:"stock_reason"`
+	UserID      string   `json:"user_id"`
+}
+
+// UpdateVariantHandler handles the UpdateVariantCommand
+type UpdateVariantHandler struct {
+	uow           transaction.UnitOfWork
+	productRepo   product.Repository
+	warehouseRepo warehouse.Repository
+	backorders    *inventory.BackorderFiller
+	publisher     events.Publisher
+}
+
+// NewUpdateVariantHandler creates a new UpdateVariantHandler
+func NewUpdateVariantHandler(
+	uow transaction.UnitOfWork,
+	productRepo product.Repository,
+	warehouseRepo warehouse.Repository,
+	backorders *inventory.BackorderFiller,
+	publisher events.Publisher,
+) *UpdateVariantHandler {
+	return &UpdateVariantHandler{
+		uow:           uow,
+		productRepo:   productRepo,
+		warehouseRepo: warehouseRepo,
+		backorders:    backorders,
+		publisher:     publisher,
+	}
+}
+
+// Handle processes the UpdateVariantCommand
+func (h *UpdateVariantHandler) Handle(ctx context.Context, cmd UpdateVariantCommand) error {
+	// Convert ID strings to domain IDs
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	variantID, err := product.NewVariantID(cmd.VariantID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product and variant
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := existingProduct.FindVariant(variantID); err != nil {
+		return err
+	}
+
+	// Update variant fields if provided
+	if cmd.Price != nil {
+		if err := existingProduct.ChangeVariantPrice(variantID, *cmd.Price); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Stock != nil {
+		if err := changeStock(ctx, h.warehouseRepo, existingProduct, variantID, cmd.WarehouseID, *cmd.Stock, cmd.StockReason, cmd.UserID); err != nil {
+			return err
+		}
+	}
+
+	// Save the updated product with the orders its stock was allocated to
+	return updateStock(ctx, h.uow, h.productRepo, h.backorders, h.publisher, existingProduct)
+}
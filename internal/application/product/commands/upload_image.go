@@ -0,0 +1,189 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/storage"
+	"e-commerce/internal/domain/product"
+	"e-commerce/pkg/imaging"
+	"image"
+	_ "image/gif" // GIF decoder
+	"image/jpeg"
+	"image/png"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// Image upload limits
+const (
+	maxImageBytes  = 10 << 20
+	maxImagePixels = 40_000_000
+)
+
+// imageRenditions lists the resized renditions generated for every image with the box they fit in
+var imageRenditions = []struct {
+	name string
+	size int
+}{
+	{product.RenditionMedium, 800},
+	{product.RenditionThumbnail, 200},
+}
+
+// UploadImageCommand represents the command to upload an image of a product
+type UploadImageCommand struct {
+	ProductID string
+	AltText   string
+	Data      []byte
+}
+
+// UploadImageHandler handles the UploadImageCommand
+type UploadImageHandler struct {
+	productRepo product.Repository
+	blobStore   storage.BlobStore
+	mediaURL    string
+	publisher   events.Publisher
+}
+
+// NewUploadImageHandler creates a new UploadImageHandler; mediaURL is the URL prefix stored blobs are served under
+func NewUploadImageHandler(productRepo product.Repository, blobStore storage.BlobStore, mediaURL string, publisher events.Publisher) *UploadImageHandler {
+	return &UploadImageHandler{
+		productRepo: productRepo,
+		blobStore:   blobStore,
+		mediaURL:    mediaURL,
+		publisher:   publisher,
+	}
+}
+
+// Handle processes the UploadImageCommand
+func (h *UploadImageHandler) Handle(ctx context.Context, cmd UploadImageCommand) (string, error) {
+	// Convert ID string to domain ID
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the product
+	existingProduct, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return "", err
+	}
+
+	// Check the image before decoding it in full
+	if len(cmd.Data) > maxImageBytes {
+		return "", product.ErrImageTooLarge
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(cmd.Data))
+	if err != nil {
+		return "", product.ErrUnsupportedImage
+	}
+
+	if config.Width*config.Height > maxImagePixels {
+		return "", product.ErrImageTooLarge
+	}
+
+	source, _, err := image.Decode(bytes.NewReader(cmd.Data))
+	if err != nil {
+		return "", product.ErrUnsupportedImage
+	}
+
+	// Store the original and its resized renditions
+	imageID := product.ImageID(uuid.New().String())
+	prefix := "products/" + productID.String() + "/" + imageID.String() + "/"
+
+	var renditions []product.Rendition
+	store := func(name, extension, contentType string, data []byte, width, height int) error {
+		key := prefix + name + extension
+		if err := h.blobStore.Put(ctx, key, data, contentType); err != nil {
+			return err
+		}
+
+		rendition, err := product.NewRendition(name, key, h.mediaURL+"/"+key, width, height)
+		if err != nil {
+			return err
+		}
+
+		renditions = append(renditions, rendition)
+		return nil
+	}
+
+	extension, contentType := imageFormat(format)
+	if err := store(product.RenditionOriginal, extension, contentType, cmd.Data, config.Width, config.Height); err != nil {
+		return "", err
+	}
+
+	for _, size := range imageRenditions {
+		resized := imaging.Fit(source, size.size, size.size)
+		data, extension, contentType, err := encodeRendition(resized, format)
+		if err != nil {
+			deleteRenditions(ctx, h.blobStore, renditions)
+			return "", err
+		}
+
+		bounds := resized.Bounds()
+		if err := store(size.name, extension, contentType, data, bounds.Dx(), bounds.Dy()); err != nil {
+			deleteRenditions(ctx, h.blobStore, renditions)
+			return "", err
+		}
+	}
+
+	// Add the image to the product
+	newImage, err := product.NewImage(imageID, cmd.AltText, renditions)
+	if err != nil {
+		deleteRenditions(ctx, h.blobStore, renditions)
+		return "", err
+	}
+
+	existingProduct.AddImage(newImage)
+
+	// Save the updated product
+	if err := h.productRepo.Update(ctx, existingProduct); err != nil {
+		deleteRenditions(ctx, h.blobStore, renditions)
+		return "", err
+	}
+
+	// Publish the product events
+	publishEvents(ctx, h.publisher, existingProduct)
+
+	return imageID.String(), nil
+}
+
+// imageFormat returns the file extension and content type of a decoded image format
+func imageFormat(format string) (string, string) {
+	switch format {
+	case "png":
+		return ".png", "image/png"
+	case "gif":
+		return ".gif", "image/gif"
+	}
+	return ".jpg", "image/jpeg"
+}
+
+// encodeRendition encodes a resized image as JPEG for JPEG sources and as PNG otherwise,
+// keeping transparency
+func encodeRendition(img image.Image, format string) ([]byte, string, string, error) {
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), ".jpg", "image/jpeg", nil
+	}
+
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, "", "", err
+	}
+	return buf.Bytes(), ".png", "image/png", nil
+}
+
+// deleteRenditions deletes the stored files of renditions. Failures are only logged:
+// the renditions are no longer referenced, so a leftover file is harmless.
+func deleteRenditions(ctx context.Context, blobStore storage.BlobStore, renditions []product.Rendition) {
+	for _, rendition := range renditions {
+		if err := blobStore.Delete(ctx, rendition.Key()); err != nil {
+			log.Printf("Error deleting %s: %v", rendition.Key(), err)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/application/product/catalogfile"
+	"e-commerce/internal/domain/importjob"
+	"e-commerce/internal/domain/product"
+	"io"
+)
+
+// exportBatchSize is the number of products loaded at a time while exporting
+const exportBatchSize = 100
+
+// ExportProductsQuery represents the query to export the products matching a filter
+// as a csv or ndjson catalog file
+type ExportProductsQuery struct {
+	FilterInput
+	Format string
+}
+
+// ExportDTO represents a catalog export ready to be streamed
+type ExportDTO struct {
+	ContentType string
+	FileName    string
+
+	// Write writes the catalog file; products are loaded in batches as it goes
+	Write func(ctx context.Context, w io.Writer) error
+}
+
+// ExportProductsHandler handles the ExportProductsQuery
+type ExportProductsHandler struct {
+	productRepo product.Repository
+}
+
+// NewExportProductsHandler creates a new ExportProductsHandler
+func NewExportProductsHandler(productRepo product.Repository) *ExportProductsHandler {
+	return &ExportProductsHandler{
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the ExportProductsQuery, checking the format and filter before anything is written
+func (h *ExportProductsHandler) Handle(ctx context.Context, query ExportProductsQuery) (*ExportDTO, error) {
+	format, err := importjob.NewFormat(query.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := query.toFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportDTO{
+		ContentType: catalogfile.ContentType(format),
+		FileName:    "products." + format.String(),
+		Write: func(ctx context.Context, w io.Writer) error {
+			return h.write(ctx, format, filter, w)
+		},
+	}, nil
+}
+
+// write writes the products matching the filter, each followed by its variants
+func (h *ExportProductsHandler) write(ctx context.Context, format importjob.Format, filter product.Filter, w io.Writer) error {
+	writer, err := catalogfile.NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	for offset := 0; ; offset += exportBatchSize {
+		products, err := h.productRepo.List(ctx, filter, exportBatchSize, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range products {
+			if err := writer.Write(toCatalogRow(p)); err != nil {
+				return err
+			}
+
+			for _, v := range p.Variants() {
+				if err := writer.Write(toVariantCatalogRow(v)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		if len(products) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// toCatalogRow maps a product to a catalog file row
+func toCatalogRow(p *product.Product) catalogfile.Row {
+	price := p.Price().Value()
+	stock := p.Stock().Value()
+	weight := p.Weight().Value()
+
+	return catalogfile.Row{
+		SKU:         p.SKU().String(),
+		Name:        p.Name().String(),
+		Description: p.Description().String(),
+		Price:       &price,
+		Stock:       &stock,
+		Weight:      &weight,
+		TaxCategory: p.TaxCategory().String(),
+		Language:    p.Language().String(),
+		Status:      p.Status().String(),
+	}
+}
+
+// toVariantCatalogRow maps a variant to a catalog file row; the price is left out
+// for variants selling at the product price
+func toVariantCatalogRow(v *product.Variant) catalogfile.Row {
+	stock := v.Stock().Value()
+	row := catalogfile.Row{
+		SKU:   v.SKU().String(),
+		Stock: &stock,
+	}
+
+	if v.PriceOverride() > 0 {
+		price := v.PriceOverride().Value()
+		row.Price = &price
+	}
+
+	return row
+}
@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"e-commerce/internal/domain/product"
+	"strings"
+)
+
+// FilterInput represents the criteria to filter products by
+type FilterInput struct {
+	// Status lists the products with that status instead of those available to customers
+	Status string
+
+	// Attributes maps attribute names to accepted values
+	Attributes map[string][]string
+	MinPrice   float64
+	MaxPrice   float64
+	InStock    bool
+}
+
+// toFilter converts the filter input to a domain filter
+func (f FilterInput) toFilter() (product.Filter, error) {
+	var status product.Status
+	if f.Status != "" {
+		var err error
+		if status, err = product.NewStatus(f.Status); err != nil {
+			return product.Filter{}, err
+		}
+	}
+
+	attributes := make(map[string][]string, len(f.Attributes))
+	for name, values := range f.Attributes {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" && len(values) > 0 {
+			attributes[name] = append(attributes[name], values...)
+		}
+	}
+
+	return product.Filter{
+		Status:     status,
+		Attributes: attributes,
+		MinPrice:   f.MinPrice,
+		MaxPrice:   f.MaxPrice,
+		InStock:    f.InStock,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/importjob"
+	"time"
+)
+
+// ImportRowErrorDTO represents a row of an import that could not be applied
+type ImportRowErrorDTO struct {
+	Row     int    `json:"row"`
+	SKU     string `json:"sku"`
+	Message string `json:"message"`
+}
+
+// ImportJobDTO represents the data transfer object for the progress of a product import
+type ImportJobDTO struct {
+	ID         string               `json:"id"`
+	Format     string               `json:"format"`
+	FileName   string               `json:"file_name"`
+	Status     string               `json:"status"`
+	Processed  int                  `json:"processed"`
+	Created    int                  `json:"created"`
+	Updated    int                  `json:"updated"`
+	Failed     int                  `json:"failed"`
+	Errors     []*ImportRowErrorDTO `json:"errors"`
+	Failure    string               `json:"failure,omitempty"`
+	CreatedAt  time.Time            `json:"created_at"`
+	StartedAt  *time.Time           `json:"started_at"`
+	FinishedAt *time.Time           `json:"finished_at"`
+}
+
+// GetImportJobQuery represents the query to get a product import job by ID
+type GetImportJobQuery struct {
+	ID string
+}
+
+// GetImportJobHandler handles the GetImportJobQuery
+type GetImportJobHandler struct {
+	jobRepo importjob.Repository
+}
+
+// NewGetImportJobHandler creates a new GetImportJobHandler
+func NewGetImportJobHandler(jobRepo importjob.Repository) *GetImportJobHandler {
+	return &GetImportJobHandler{
+		jobRepo: jobRepo,
+	}
+}
+
+// Handle processes the GetImportJobQuery
+func (h *GetImportJobHandler) Handle(ctx context.Context, query GetImportJobQuery) (*ImportJobDTO, error) {
+	// Convert ID string to domain ID
+	id, err := importjob.NewID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the job
+	job, err := h.jobRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain job to DTO
+	rowErrors := make([]*ImportRowErrorDTO, len(job.RowErrors()))
+	for i, rowError := range job.RowErrors() {
+		rowErrors[i] = &ImportRowErrorDTO{
+			Row:     rowError.Row(),
+			SKU:     rowError.SKU(),
+			Message: rowError.Message(),
+		}
+	}
+
+	return &ImportJobDTO{
+		ID:         job.ID().String(),
+		Format:     job.Format().String(),
+		FileName:   job.FileName(),
+		Status:     job.Status().String(),
+		Processed:  job.Processed(),
+		Created:    job.Created(),
+		Updated:    job.Updated(),
+		Failed:     job.Failed(),
+		Errors:     rowErrors,
+		Failure:    job.Failure(),
+		CreatedAt:  job.CreatedAt(),
+		StartedAt:  job.StartedAt(),
+		FinishedAt: job.FinishedAt(),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/application/storage"
+)
+
+// GetMediaQuery represents the query to read a stored media file by key
+type GetMediaQuery struct {
+	Key string
+}
+
+// GetMediaHandler handles the GetMediaQuery
+type GetMediaHandler struct {
+	blobStore storage.BlobStore
+}
+
+// NewGetMediaHandler creates a new GetMediaHandler
+func NewGetMediaHandler(blobStore storage.BlobStore) *GetMediaHandler {
+	return &GetMediaHandler{
+		blobStore: blobStore,
+	}
+}
+
+// Handle processes the GetMediaQuery; the caller closes the body of the returned blob
+func (h *GetMediaHandler) Handle(ctx context.Context, query GetMediaQuery) (*storage.Blob, error) {
+	return h.blobStore.Get(ctx, query.Key)
+}
@@ -0,0 +1,76 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"time"
+)
+
+// PriceChangeDTO represents a change of a product price
+type PriceChangeDTO struct {
+	Price         float64   `json:"price"`
+	PreviousPrice float64   `json:"previous_price"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
+// GetPriceHistoryQuery represents the query to get the price history of a product with pagination
+type GetPriceHistoryQuery struct {
+	ProductID string
+	Limit     int
+	Offset    int
+}
+
+// GetPriceHistoryHandler handles the GetPriceHistoryQuery
+type GetPriceHistoryHandler struct {
+	productRepo product.Repository
+}
+
+// NewGetPriceHistoryHandler creates a new GetPriceHistoryHandler
+func NewGetPriceHistoryHandler(productRepo product.Repository) *GetPriceHistoryHandler {
+	return &GetPriceHistoryHandler{
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the GetPriceHistoryQuery, returning the most recent changes first
+func (h *GetPriceHistoryHandler) Handle(ctx context.Context, query GetPriceHistoryQuery) ([]*PriceChangeDTO, error) {
+	// Convert ID string to domain ID
+	id, err := product.NewID(query.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Check the product exists
+	if _, err := h.productRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	// Get the price changes
+	changes, err := h.productRepo.FindPriceHistory(ctx, id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain price changes to DTOs
+	result := make([]*PriceChangeDTO, len(changes))
+	for i, change := range changes {
+		result[i] = &PriceChangeDTO{
+			Price:         change.Price().Value(),
+			PreviousPrice: change.PreviousPrice().Value(),
+			ChangedAt:     change.ChangedAt(),
+		}
+	}
+
+	return result, nil
+}
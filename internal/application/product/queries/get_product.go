@@ -0,0 +1,377 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/review"
+	"time"
+)
+
+// CategoryRefDTO represents a category in a breadcrumb
+type CategoryRefDTO struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// ProductCategoryDTO represents a category a product is assigned to, with its breadcrumb from the root
+type ProductCategoryDTO struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Slug       string            `json:"slug"`
+	Breadcrumb []*CategoryRefDTO `json:"breadcrumb"`
+}
+
+// OptionDTO represents an option type of a product with its allowed values
+type OptionDTO struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// VariantDTO represents the data transfer object for a product variant
+type VariantDTO struct {
+	ID             string            `json:"id"`
+	SKU            string            `json:"sku"`
+	OptionValues   map[string]string `json:"option_values"`
+	Price          float64           `json:"price"`
+	CompareAtPrice float64           `json:"compare_at_price"`
+	PriceOverride  bool              `json:"price_override"`
+	Stock          int               `json:"stock"`
+	LowStock       bool              `json:"low_stock"`
+	Backordered    int               `json:"backordered"`
+	StockLevels    []*StockLevelDTO  `json:"stock_levels"`
+}
+
+// BackorderDTO represents whether a product can be ordered beyond its stock and the quantity waiting for stock,
+// which for products with variants is given per variant
+type BackorderDTO struct {
+	Policy      string     `json:"policy"`
+	Limit       int        `json:"limit"`
+	AvailableAt *time.Time `json:"available_at"`
+	Backordered int        `json:"backordered"`
+}
+
+// StockLevelDTO represents the stock held in a warehouse
+type StockLevelDTO struct {
+	WarehouseID string `json:"warehouse_id"`
+	Quantity    int    `json:"quantity"`
+}
+
+// AttributeDTO represents a typed product attribute; the value is a string, number or boolean
+type AttributeDTO struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// RenditionDTO represents a stored size of a product image
+type RenditionDTO struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// ImageDTO represents a product image with its renditions keyed by name: original, medium and thumbnail
+type ImageDTO struct {
+	ID         string                   `json:"id"`
+	AltText    string                   `json:"alt_text"`
+	Position   int                      `json:"position"`
+	Renditions map[string]*RenditionDTO `json:"renditions"`
+}
+
+// PriceScheduleDTO represents a scheduled change of a product price; a schedule with an end is a sale
+type PriceScheduleDTO struct {
+	ID       string     `json:"id"`
+	Price    float64    `json:"price"`
+	StartsAt time.Time  `json:"starts_at"`
+	EndsAt   *time.Time `json:"ends_at"`
+	Active   bool       `json:"active"`
+}
+
+// RatingDTO represents the average of the approved review ratings of a product and their distribution,
+// the number of ratings per number of stars
+type RatingDTO struct {
+	Average      float64     `json:"average"`
+	Count        int         `json:"count"`
+	Distribution map[int]int `json:"distribution"`
+}
+
+// ProductDTO represents the data transfer object for product information
+type ProductDTO struct {
+	ID             string                `json:"id"`
+	SKU            string                `json:"sku"`
+	Name           string                `json:"name"`
+	Description    string                `json:"description"`
+	Price          float64               `json:"price"`
+	CompareAtPrice float64               `json:"compare_at_price"`
+	PriceSchedules []*PriceScheduleDTO   `json:"price_schedules"`
+	Stock          int                   `json:"stock"`
+	AvailableStock int                   `json:"available_stock"`
+	StockLevels    []*StockLevelDTO      `json:"stock_levels"`
+	Threshold      int                   `json:"reorder_threshold"`
+	LowStock       bool                  `json:"low_stock"`
+	Backorder      *BackorderDTO         `json:"backorder"`
+	Weight         float64               `json:"weight"`
+	Length         float64               `json:"length"`
+	Width          float64               `json:"width"`
+	Height         float64               `json:"height"`
+	TaxCategory    string                `json:"tax_category"`
+	Language       string                `json:"language"`
+	Categories     []*ProductCategoryDTO `json:"categories"`
+	Options        []*OptionDTO          `json:"options"`
+	Variants       []*VariantDTO         `json:"variants"`
+	Attributes     []*AttributeDTO       `json:"attributes"`
+	Images         []*ImageDTO           `json:"images"`
+	Rating         *RatingDTO            `json:"rating"`
+	Status         string                `json:"status"`
+	PublishedAt    *time.Time            `json:"published_at"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// GetProductQuery represents the query to get a product by ID. Products that are not
+// available to customers are only returned as a preview.
+type GetProductQuery struct {
+	ID      string
+	Preview bool
+}
+
+// GetProductHandler handles the GetProductQuery
+type GetProductHandler struct {
+	productRepo  product.Repository
+	categoryRepo category.Repository
+	reviewRepo   review.Repository
+}
+
+// NewGetProductHandler creates a new GetProductHandler
+func NewGetProductHandler(productRepo product.Repository, categoryRepo category.Repository, reviewRepo review.Repository) *GetProductHandler {
+	return &GetProductHandler{
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		reviewRepo:   reviewRepo,
+	}
+}
+
+// Handle processes the GetProductQuery
+func (h *GetProductHandler) Handle(ctx context.Context, query GetProductQuery) (*ProductDTO, error) {
+	// Convert ID string to domain ID
+	id, err := product.NewID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the product
+	p, err := h.productRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !query.Preview && !p.IsAvailable(time.Now()) {
+		return nil, product.ErrProductUnavailable
+	}
+
+	// Build the taxonomy for the category breadcrumbs
+	tree, err := loadCategoryTree(ctx, h.categoryRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Summarize the ratings of the product
+	ratings, err := loadRatings(ctx, h.reviewRepo, []*product.Product{p})
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain product to DTO
+	return toProductDTO(p, tree, ratings[p.ID()]), nil
+}
+
+// loadCategoryTree builds the category taxonomy used to resolve product breadcrumbs
+func loadCategoryTree(ctx context.Context, categoryRepo category.Repository) (*category.Tree, error) {
+	categories, err := categoryRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return category.NewTree(categories), nil
+}
+
+// loadRatings summarizes the approved review ratings of the given products, keyed by product
+func loadRatings(ctx context.Context, reviewRepo review.Repository, products []*product.Product) (map[product.ID]review.RatingSummary, error) {
+	ids := make([]product.ID, len(products))
+	for i, p := range products {
+		ids[i] = p.ID()
+	}
+	return reviewRepo.Summarize(ctx, ids)
+}
+
+// toProductDTO maps a domain product to a DTO, resolving its categories in the tree
+func toProductDTO(p *product.Product, tree *category.Tree, rating review.RatingSummary) *ProductDTO {
+	categories := make([]*ProductCategoryDTO, 0, len(p.CategoryIDs()))
+	for _, categoryID := range p.CategoryIDs() {
+		c, ok := tree.Find(categoryID)
+		if !ok {
+			continue
+		}
+
+		path := tree.Path(categoryID)
+		breadcrumb := make([]*CategoryRefDTO, len(path))
+		for i, ancestor := range path {
+			breadcrumb[i] = &CategoryRefDTO{
+				ID:   ancestor.ID().String(),
+				Name: ancestor.Name(),
+				Slug: ancestor.Slug().String(),
+			}
+		}
+
+		categories = append(categories, &ProductCategoryDTO{
+			ID:         c.ID().String(),
+			Name:       c.Name(),
+			Slug:       c.Slug().String(),
+			Breadcrumb: breadcrumb,
+		})
+	}
+
+	options := make([]*OptionDTO, len(p.Options()))
+	for i, option := range p.Options() {
+		options[i] = &OptionDTO{
+			Name:   option.Name(),
+			Values: option.Values(),
+		}
+	}
+
+	// Products with variants are only sold through them, so their variants hold the available stock
+	availableStock := p.Stock().Value()
+	if p.HasVariants() {
+		availableStock = 0
+	}
+
+	variants := make([]*VariantDTO, len(p.Variants()))
+	for i, v := range p.Variants() {
+		availableStock += v.Stock().Value()
+
+		price, _ := p.UnitPrice(v.ID())
+
+		// Variants priced by the product share its sale
+		var compareAtPrice float64
+		if v.PriceOverride() == 0 {
+			compareAtPrice = p.CompareAtPrice().Value()
+		}
+		variants[i] = &VariantDTO{
+			ID:             v.ID().String(),
+			SKU:            v.SKU().String(),
+			OptionValues:   v.OptionValues(),
+			Price:          price.Value(),
+			CompareAtPrice: compareAtPrice,
+			PriceOverride:  v.PriceOverride() > 0,
+			Stock:          v.Stock().Value(),
+			LowStock:       p.IsLowOnStock(v.ID()),
+			Backordered:    p.Backordered(v.ID()),
+			StockLevels:    toStockLevelDTOs(p, v.ID()),
+		}
+	}
+
+	attributes := make([]*AttributeDTO, len(p.Attributes()))
+	for i, attribute := range p.Attributes() {
+		var value interface{} = attribute.Value()
+		switch attribute.Type() {
+		case product.AttributeTypeNumber:
+			value = attribute.Number()
+		case product.AttributeTypeBoolean:
+			value = attribute.Value() == "true"
+		}
+
+		attributes[i] = &AttributeDTO{
+			Name:  attribute.Name(),
+			Type:  attribute.Type().String(),
+			Value: value,
+		}
+	}
+
+	images := make([]*ImageDTO, len(p.Images()))
+	for i, image := range p.Images() {
+		renditions := make(map[string]*RenditionDTO, len(image.Renditions()))
+		for _, rendition := range image.Renditions() {
+			renditions[rendition.Name()] = &RenditionDTO{
+				URL:    rendition.URL(),
+				Width:  rendition.Width(),
+				Height: rendition.Height(),
+			}
+		}
+
+		images[i] = &ImageDTO{
+			ID:         image.ID().String(),
+			AltText:    image.AltText(),
+			Position:   i,
+			Renditions: renditions,
+		}
+	}
+
+	schedules := make([]*PriceScheduleDTO, len(p.PriceSchedules()))
+	for i, schedule := range p.PriceSchedules() {
+		schedules[i] = &PriceScheduleDTO{
+			ID:       schedule.ID().String(),
+			Price:    schedule.Price().Value(),
+			StartsAt: schedule.StartsAt(),
+			EndsAt:   schedule.EndsAt(),
+			Active:   schedule.IsActive(),
+		}
+	}
+
+	return &ProductDTO{
+		ID:             p.ID().String(),
+		SKU:            p.SKU().String(),
+		Name:           p.Name().String(),
+		Description:    p.Description().String(),
+		Price:          p.Price().Value(),
+		CompareAtPrice: p.CompareAtPrice().Value(),
+		PriceSchedules: schedules,
+		Stock:          p.Stock().Value(),
+		AvailableStock: availableStock,
+		StockLevels:    toStockLevelDTOs(p, ""),
+		Threshold:      p.ReorderThreshold().Value(),
+		LowStock:       !p.HasVariants() && p.IsLowOnStock(""),
+		Backorder: &BackorderDTO{
+			Policy:      p.BackorderPolicy().String(),
+			Limit:       p.BackorderLimit(),
+			AvailableAt: p.AvailableAt(),
+			Backordered: p.Backordered(""),
+		},
+		Weight:      p.Weight().Value(),
+		Length:      p.Dimensions().Length(),
+		Width:       p.Dimensions().Width(),
+		Height:      p.Dimensions().Height(),
+		TaxCategory: p.TaxCategory().String(),
+		Language:    p.Language().String(),
+		Categories:  categories,
+		Options:     options,
+		Variants:    variants,
+		Attributes:  attributes,
+		Images:      images,
+		Rating: &RatingDTO{
+			Average:      rating.Average(),
+			Count:        rating.Count(),
+			Distribution: rating.Distribution(),
+		},
+		Status:      p.Status().String(),
+		PublishedAt: p.PublishedAt(),
+		CreatedAt:   p.CreatedAt(),
+		UpdatedAt:   p.UpdatedAt(),
+	}
+}
+
+// toStockLevelDTOs maps the stock of a product, or of one of its variants, held in each warehouse to DTOs
+func toStockLevelDTOs(p *product.Product, variantID product.VariantID) []*StockLevelDTO {
+	levels := []*StockLevelDTO{}
+	for _, level := range p.StockLevels() {
+		if level.VariantID() != variantID {
+			continue
+		}
+
+		levels = append(levels, &StockLevelDTO{
+			WarehouseID: level.WarehouseID().String(),
+			Quantity:    level.Quantity().Value(),
+		})
+	}
+	return levels
+}
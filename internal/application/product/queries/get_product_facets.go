@@ -0,0 +1,85 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+)
+
+// FacetValueDTO represents an attribute value and how many products have it
+type FacetValueDTO struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// FacetDTO represents the values of an attribute across the filtered products
+type FacetDTO struct {
+	Name   string           `json:"name"`
+	Type   string           `json:"type"`
+	Values []*FacetValueDTO `json:"values"`
+}
+
+// ProductFacetsDTO represents the facets of the filtered products
+type ProductFacetsDTO struct {
+	Total  int         `json:"total"`
+	Facets []*FacetDTO `json:"facets"`
+}
+
+// GetProductFacetsQuery represents the query to count filtered products per attribute value
+type GetProductFacetsQuery struct {
+	FilterInput
+}
+
+// GetProductFacetsHandler handles the GetProductFacetsQuery
+type GetProductFacetsHandler struct {
+	productRepo product.Repository
+}
+
+// NewGetProductFacetsHandler creates a new GetProductFacetsHandler
+func NewGetProductFacetsHandler(productRepo product.Repository) *GetProductFacetsHandler {
+	return &GetProductFacetsHandler{
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the GetProductFacetsQuery
+func (h *GetProductFacetsHandler) Handle(ctx context.Context, query GetProductFacetsQuery) (*ProductFacetsDTO, error) {
+	filter, err := query.toFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	// Count the matching products
+	total, err := h.productRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count the matching products per attribute value
+	facets, err := h.productRepo.Facets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain facets to DTOs
+	result := &ProductFacetsDTO{
+		Total:  total,
+		Facets: make([]*FacetDTO, len(facets)),
+	}
+	for i, facet := range facets {
+		values := make([]*FacetValueDTO, len(facet.Values))
+		for j, value := range facet.Values {
+			values[j] = &FacetValueDTO{
+				Value: value.Value,
+				Count: value.Count,
+			}
+		}
+
+		result.Facets[i] = &FacetDTO{
+			Name:   facet.Name,
+			Type:   facet.Type.String(),
+			Values: values,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,108 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"time"
+)
+
+// Reorder report defaults, in days
+const (
+	DefaultSalesWindowDays = 30
+	DefaultCoverDays       = 30
+)
+
+// ReorderSuggestionDTO represents a suggested reorder of a product, or of one of its variants
+type ReorderSuggestionDTO struct {
+	ProductID         string   `json:"product_id"`
+	VariantID         string   `json:"variant_id,omitempty"`
+	Name              string   `json:"name"`
+	SKU               string   `json:"sku,omitempty"`
+	Stock             int      `json:"stock"`
+	ReorderThreshold  int      `json:"reorder_threshold"`
+	LowStock          bool     `json:"low_stock"`
+	UnitsSold         int      `json:"units_sold"`
+	DailyVelocity     float64  `json:"daily_velocity"`
+	DaysOfStock       *float64 `json:"days_of_stock"`
+	SuggestedQuantity int      `json:"suggested_quantity"`
+}
+
+// ReorderReportDTO represents the products and variants to reorder, based on recent sales velocity
+type ReorderReportDTO struct {
+	SalesWindowDays int                     `json:"sales_window_days"`
+	CoverDays       int                     `json:"cover_days"`
+	Suggestions     []*ReorderSuggestionDTO `json:"suggestions"`
+}
+
+// GetReorderReportQuery represents the query to suggest reorder quantities from the sales of the
+// last Days days, so that stock covers CoverDays days of sales above the reorder threshold
+type GetReorderReportQuery struct {
+	Days      int
+	CoverDays int
+}
+
+// GetReorderReportHandler handles the GetReorderReportQuery
+type GetReorderReportHandler struct {
+	productRepo product.Repository
+}
+
+// NewGetReorderReportHandler creates a new GetReorderReportHandler
+func NewGetReorderReportHandler(productRepo product.Repository) *GetReorderReportHandler {
+	return &GetReorderReportHandler{
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the GetReorderReportQuery
+func (h *GetReorderReportHandler) Handle(ctx context.Context, query GetReorderReportQuery) (*ReorderReportDTO, error) {
+	// Apply the default windows
+	if query.Days <= 0 {
+		query.Days = DefaultSalesWindowDays
+	}
+	if query.CoverDays <= 0 {
+		query.CoverDays = DefaultCoverDays
+	}
+
+	// Find the stock and recent sales
+	since := time.Now().AddDate(0, 0, -query.Days)
+	sales, err := h.productRepo.FindStockSales(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the products and variants that are low on stock or need reordering
+	suggestions := []*ReorderSuggestionDTO{}
+	for _, s := range sales {
+		quantity := s.SuggestedReorder(query.Days, query.CoverDays)
+		if quantity == 0 && !s.IsLow() {
+			continue
+		}
+
+		velocity := s.Velocity(query.Days)
+		var daysOfStock *float64
+		if velocity > 0 {
+			days := float64(s.Stock().Value()) / velocity
+			daysOfStock = &days
+		}
+
+		suggestions = append(suggestions, &ReorderSuggestionDTO{
+			ProductID:         s.ProductID().String(),
+			VariantID:         s.VariantID().String(),
+			Name:              s.Name().String(),
+			SKU:               s.SKU().String(),
+			Stock:             s.Stock().Value(),
+			ReorderThreshold:  s.Threshold().Value(),
+			LowStock:          s.IsLow(),
+			UnitsSold:         s.UnitsSold(),
+			DailyVelocity:     velocity,
+			DaysOfStock:       daysOfStock,
+			SuggestedQuantity: quantity,
+		})
+	}
+
+	return &ReorderReportDTO{
+		SalesWindowDays: query.Days,
+		CoverDays:       query.CoverDays,
+		Suggestions:     suggestions,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"time"
+)
+
+// StockMovementDTO represents a change of the stock held in a warehouse, as recorded in the stock ledger
+type StockMovementDTO struct {
+	ID            string    `json:"id"`
+	WarehouseID   string    `json:"warehouse_id"`
+	VariantID     string    `json:"variant_id,omitempty"`
+	Reason        string    `json:"reason"`
+	ReferenceType string    `json:"reference_type,omitempty"`
+	ReferenceID   string    `json:"reference_id,omitempty"`
+	Quantity      int       `json:"quantity"`
+	Before        int       `json:"before"`
+	After         int       `json:"after"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// GetStockMovementsQuery represents the query to get the stock ledger of a product with pagination
+type GetStockMovementsQuery struct {
+	ProductID string
+	Limit     int
+	Offset    int
+}
+
+// GetStockMovementsHandler handles the GetStockMovementsQuery
+type GetStockMovementsHandler struct {
+	productRepo product.Repository
+}
+
+// NewGetStockMovementsHandler creates a new GetStockMovementsHandler
+func NewGetStockMovementsHandler(productRepo product.Repository) *GetStockMovementsHandler {
+	return &GetStockMovementsHandler{
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the GetStockMovementsQuery, returning the most recent movements first
+func (h *GetStockMovementsHandler) Handle(ctx context.Context, query GetStockMovementsQuery) ([]*StockMovementDTO, error) {
+	// Convert ID string to domain ID
+	id, err := product.NewID(query.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Check the product exists
+	if _, err := h.productRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	// Get the stock movements
+	movements, err := h.productRepo.FindStockMovements(ctx, id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain stock movements to DTOs
+	result := make([]*StockMovementDTO, len(movements))
+	for i, movement := range movements {
+		result[i] = &StockMovementDTO{
+			ID:            movement.ID(),
+			WarehouseID:   movement.WarehouseID().String(),
+			VariantID:     movement.VariantID().String(),
+			Reason:        movement.Reason().String(),
+			ReferenceType: movement.Reference().Type(),
+			ReferenceID:   movement.Reference().ID(),
+			Quantity:      movement.Quantity(),
+			Before:        movement.Before().Value(),
+			After:         movement.After().Value(),
+			OccurredAt:    movement.OccurredAt(),
+		}
+	}
+
+	return result, nil
+}
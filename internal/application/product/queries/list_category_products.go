@@ -0,0 +1,82 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/review"
+)
+
+// ListCategoryProductsQuery represents the query to list the products of a category
+// and of all its subcategories with pagination
+type ListCategoryProductsQuery struct {
+	CategoryID string
+	Limit      int
+	Offset     int
+}
+
+// ListCategoryProductsHandler handles the ListCategoryProductsQuery
+type ListCategoryProductsHandler struct {
+	productRepo  product.Repository
+	categoryRepo category.Repository
+	reviewRepo   review.Repository
+}
+
+// NewListCategoryProductsHandler creates a new ListCategoryProductsHandler
+func NewListCategoryProductsHandler(productRepo product.Repository, categoryRepo category.Repository, reviewRepo review.Repository) *ListCategoryProductsHandler {
+	return &ListCategoryProductsHandler{
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		reviewRepo:   reviewRepo,
+	}
+}
+
+// Handle processes the ListCategoryProductsQuery
+func (h *ListCategoryProductsHandler) Handle(ctx context.Context, query ListCategoryProductsQuery) ([]*ProductDTO, error) {
+	// Convert ID string to domain ID
+	categoryID, err := category.NewID(query.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Find the category and its descendants
+	tree, err := loadCategoryTree(ctx, h.categoryRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := tree.Find(categoryID); !ok {
+		return nil, category.ErrCategoryNotFound
+	}
+
+	// Get products from repository
+	products, err := h.productRepo.FindByCategories(ctx, tree.DescendantIDs(categoryID), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Summarize the ratings of the products
+	ratings, err := loadRatings(ctx, h.reviewRepo, products)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain products to DTOs
+	result := make([]*ProductDTO, len(products))
+	for i, p := range products {
+		result[i] = toProductDTO(p, tree, ratings[p.ID()])
+	}
+
+	return result, nil
+}
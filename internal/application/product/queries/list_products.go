@@ -0,0 +1,76 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/review"
+)
+
+// ListProductsQuery represents the query to list products matching a filter with pagination
+type ListProductsQuery struct {
+	FilterInput
+	Limit  int
+	Offset int
+}
+
+// ListProductsHandler handles the ListProductsQuery
+type ListProductsHandler struct {
+	productRepo  product.Repository
+	categoryRepo category.Repository
+	reviewRepo   review.Repository
+}
+
+// NewListProductsHandler creates a new ListProductsHandler
+func NewListProductsHandler(productRepo product.Repository, categoryRepo category.Repository, reviewRepo review.Repository) *ListProductsHandler {
+	return &ListProductsHandler{
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		reviewRepo:   reviewRepo,
+	}
+}
+
+// Handle processes the ListProductsQuery
+func (h *ListProductsHandler) Handle(ctx context.Context, query ListProductsQuery) ([]*ProductDTO, error) {
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	filter, err := query.toFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get products from repository
+	products, err := h.productRepo.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the taxonomy for the category breadcrumbs
+	tree, err := loadCategoryTree(ctx, h.categoryRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Summarize the ratings of the products
+	ratings, err := loadRatings(ctx, h.reviewRepo, products)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain products to DTOs
+	result := make([]*ProductDTO, len(products))
+	for i, p := range products {
+		result[i] = toProductDTO(p, tree, ratings[p.ID()])
+	}
+
+	return result, nil
+}
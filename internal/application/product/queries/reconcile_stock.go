@@ -0,0 +1,64 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+)
+
+// StockDriftDTO represents stock held in a warehouse that differs from the stock recomputed from the ledger
+type StockDriftDTO struct {
+	ProductID   string `json:"product_id"`
+	VariantID   string `json:"variant_id,omitempty"`
+	WarehouseID string `json:"warehouse_id"`
+	Recorded    int    `json:"recorded"`
+	Ledger      int    `json:"ledger"`
+	Drift       int    `json:"drift"`
+}
+
+// StockReconciliationDTO represents the outcome of reconciling stock levels with the stock ledger
+type StockReconciliationDTO struct {
+	Balanced bool             `json:"balanced"`
+	Drifts   []*StockDriftDTO `json:"drifts"`
+}
+
+// ReconcileStockQuery represents the query to recompute stock from the ledger and flag drift
+type ReconcileStockQuery struct{}
+
+// ReconcileStockHandler handles the ReconcileStockQuery
+type ReconcileStockHandler struct {
+	productRepo product.Repository
+}
+
+// NewReconcileStockHandler creates a new ReconcileStockHandler
+func NewReconcileStockHandler(productRepo product.Repository) *ReconcileStockHandler {
+	return &ReconcileStockHandler{
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the ReconcileStockQuery
+func (h *ReconcileStockHandler) Handle(ctx context.Context, query ReconcileStockQuery) (*StockReconciliationDTO, error) {
+	// Recompute the stock from the ledger
+	drifts, err := h.productRepo.ReconcileStock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain drifts to DTOs
+	result := make([]*StockDriftDTO, len(drifts))
+	for i, drift := range drifts {
+		result[i] = &StockDriftDTO{
+			ProductID:   drift.ProductID().String(),
+			VariantID:   drift.VariantID().String(),
+			WarehouseID: drift.WarehouseID().String(),
+			Recorded:    drift.Recorded(),
+			Ledger:      drift.Ledger(),
+			Drift:       drift.Drift(),
+		}
+	}
+
+	return &StockReconciliationDTO{
+		Balanced: len(result) == 0,
+		Drifts:   result,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/application/product/search"
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/review"
+)
+
+// SearchHighlightDTO represents the product name and a description excerpt with the matched words marked
+type SearchHighlightDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// SearchResultDTO represents a product matching a search with its relevance
+type SearchResultDTO struct {
+	*ProductDTO
+	Rank      float64             `json:"rank"`
+	Fuzzy     bool                `json:"fuzzy"`
+	Highlight *SearchHighlightDTO `json:"highlight"`
+}
+
+// SearchProductsQuery represents the query to search products by keyword
+type SearchProductsQuery struct {
+	Query    string
+	Language string
+	Limit    int
+	Offset   int
+}
+
+// SearchProductsHandler handles the SearchProductsQuery
+type SearchProductsHandler struct {
+	productRepo  product.Repository
+	categoryRepo category.Repository
+	reviewRepo   review.Repository
+	index        search.SearchIndex
+}
+
+// NewSearchProductsHandler creates a new SearchProductsHandler
+func NewSearchProductsHandler(productRepo product.Repository, categoryRepo category.Repository, reviewRepo review.Repository, index search.SearchIndex) *SearchProductsHandler {
+	return &SearchProductsHandler{
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		reviewRepo:   reviewRepo,
+		index:        index,
+	}
+}
+
+// Handle processes the SearchProductsQuery
+func (h *SearchProductsHandler) Handle(ctx context.Context, query SearchProductsQuery) ([]*SearchResultDTO, error) {
+	// Parse the search text and its language
+	searchQuery, err := product.NewSearchQuery(query.Query, query.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Search products in the index
+	hits, err := h.index.Search(ctx, searchQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the matching products
+	ids := make([]product.ID, len(hits))
+	for i, hit := range hits {
+		ids[i] = product.ID(hit.ProductID)
+	}
+
+	products, err := h.productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	productsByID := make(map[string]*product.Product, len(products))
+	for _, p := range products {
+		productsByID[p.ID().String()] = p
+	}
+
+	// Build the taxonomy for the category breadcrumbs
+	tree, err := loadCategoryTree(ctx, h.categoryRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Summarize the ratings of the products
+	ratings, err := loadRatings(ctx, h.reviewRepo, products)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map hits to DTOs in relevance order, skipping products deleted since they were indexed
+	result := make([]*SearchResultDTO, 0, len(hits))
+	for _, hit := range hits {
+		p, ok := productsByID[hit.ProductID]
+		if !ok {
+			continue
+		}
+
+		result = append(result, &SearchResultDTO{
+			ProductDTO: toProductDTO(p, tree, ratings[p.ID()]),
+			Rank:       hit.Rank,
+			Fuzzy:      hit.Fuzzy,
+			Highlight: &SearchHighlightDTO{
+				Name:        hit.NameHighlight,
+				Description: hit.Snippet,
+			},
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/application/product/search"
+	"strings"
+)
+
+// SuggestionDTO represents a product name completing a search prefix
+type SuggestionDTO struct {
+	ProductID string `json:"product_id"`
+	Text      string `json:"text"`
+}
+
+// SuggestProductsQuery represents the query to autocomplete a search prefix
+type SuggestProductsQuery struct {
+	Prefix string
+	Limit  int
+}
+
+// SuggestProductsHandler handles the SuggestProductsQuery
+type SuggestProductsHandler struct {
+	index search.SearchIndex
+}
+
+// NewSuggestProductsHandler creates a new SuggestProductsHandler
+func NewSuggestProductsHandler(index search.SearchIndex) *SuggestProductsHandler {
+	return &SuggestProductsHandler{
+		index: index,
+	}
+}
+
+// Handle processes the SuggestProductsQuery
+func (h *SuggestProductsHandler) Handle(ctx context.Context, query SuggestProductsQuery) ([]*SuggestionDTO, error) {
+	// An empty prefix has nothing to complete
+	prefix := strings.TrimSpace(query.Prefix)
+	if prefix == "" {
+		return []*SuggestionDTO{}, nil
+	}
+
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	// Complete the prefix from the index
+	suggestions, err := h.index.Suggest(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map suggestions to DTOs
+	result := make([]*SuggestionDTO, len(suggestions))
+	for i, suggestion := range suggestions {
+		result[i] = &SuggestionDTO{
+			ProductID: suggestion.ProductID,
+			Text:      suggestion.Text,
+		}
+	}
+
+	return result, nil
+}
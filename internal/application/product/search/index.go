@@ -0,0 +1,89 @@
+package search
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"slices"
+	"time"
+)
+
+// Document represents the searchable content of a product
+type Document struct {
+	ID          string
+	Name        string
+	Description string
+	Language    string
+	Keywords    []string
+
+	// PublishedAt is when the product goes live; it is not found before
+	PublishedAt time.Time
+}
+
+// NewDocument builds the search document of a published product; text attributes are indexed as keywords
+func NewDocument(p *product.Product) Document {
+	keywords := []string{}
+	for _, attribute := range p.Attributes() {
+		if attribute.Type() == product.AttributeTypeText {
+			keywords = append(keywords, attribute.Value())
+		}
+	}
+
+	return Document{
+		ID:          p.ID().String(),
+		Name:        p.Name().String(),
+		Description: p.Description().String(),
+		Language:    p.Language().String(),
+		Keywords:    keywords,
+		PublishedAt: *p.PublishedAt(),
+	}
+}
+
+// Equal reports whether two documents have the same searchable content
+func (d Document) Equal(other Document) bool {
+	return d.ID == other.ID &&
+		d.Name == other.Name &&
+		d.Description == other.Description &&
+		d.Language == other.Language &&
+		slices.Equal(d.Keywords, other.Keywords) &&
+		d.PublishedAt.Equal(other.PublishedAt)
+}
+
+// Hit represents a product matching a search
+type Hit struct {
+	ProductID string
+
+	// Rank is the relevance of the product between 0 and 1
+	Rank float64
+
+	// Fuzzy is set when the product only matched on words similar to the search text
+	Fuzzy bool
+
+	// NameHighlight and Snippet are the name and an excerpt of the description
+	// as HTML, escaped, with the matched words wrapped in <mark> tags
+	NameHighlight string
+	Snippet       string
+}
+
+// Suggestion represents a product name completing a search prefix
+type Suggestion struct {
+	ProductID string
+	Text      string
+}
+
+// SearchIndex defines the interface for product search backends
+type SearchIndex interface {
+	// Index adds or replaces documents in the index
+	Index(ctx context.Context, documents ...Document) error
+
+	// Remove removes the documents of products from the index
+	Remove(ctx context.Context, productIDs ...string) error
+
+	// Clear removes every document from the index
+	Clear(ctx context.Context) error
+
+	// Search searches the documents published by now, most relevant first
+	Search(ctx context.Context, query product.SearchQuery, limit, offset int) ([]Hit, error)
+
+	// Suggest returns the names of products published by now completing a prefix, for autocomplete
+	Suggest(ctx context.Context, prefix string, limit int) ([]Suggestion, error)
+}
@@ -0,0 +1,47 @@
+package search
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/product"
+)
+
+// Syncer keeps a search index in sync with the product catalog from product events
+type Syncer struct {
+	productRepo product.Repository
+	index       SearchIndex
+}
+
+// NewSyncer creates a new Syncer
+func NewSyncer(productRepo product.Repository, index SearchIndex) *Syncer {
+	return &Syncer{
+		productRepo: productRepo,
+		index:       index,
+	}
+}
+
+// Subscribe subscribes the syncer to the product events of a bus
+func (s *Syncer) Subscribe(bus *events.Bus) {
+	bus.Subscribe(product.EventCreated, s.reindex)
+	bus.Subscribe(product.EventUpdated, s.reindex)
+}
+
+// reindex indexes the current state of the changed product, or removes it from the
+// index when it is not published
+func (s *Syncer) reindex(ctx context.Context, event events.Event) error {
+	productEvent, ok := event.(product.Event)
+	if !ok {
+		return nil
+	}
+
+	p, err := s.productRepo.FindByID(ctx, productEvent.ProductID())
+	if err != nil {
+		return err
+	}
+
+	if p.Status() != product.StatusPublished {
+		return s.index.Remove(ctx, p.ID().String())
+	}
+
+	return s.index.Index(ctx, NewDocument(p))
+}
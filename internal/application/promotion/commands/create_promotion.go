@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/promotion"
+	"time"
+)
+
+// TierInput represents a quantity tier of a volume-tiered promotion
+type TierInput struct {
+	MinQuantity int     `json:"min_quantity"`
+	Percentage  float64 `json:"percentage"`
+}
+
+// CreatePromotionCommand represents the command to create an automatic promotion
+type CreatePromotionCommand struct {
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	Type          string      `json:"type"`
+	Value         float64     `json:"value"`
+	Tiers         []TierInput `json:"tiers"`
+	Priority      int         `json:"priority"`
+	Stackable     bool        `json:"stackable"`
+	MinOrderValue float64     `json:"min_order_value"`
+	ValidFrom     *time.Time  `json:"valid_from"`
+	ValidUntil    *time.Time  `json:"valid_until"`
+	ProductIDs    []string    `json:"product_ids"`
+	CategoryIDs   []string    `json:"category_ids"`
+}
+
+// CreatePromotionHandler handles the CreatePromotionCommand
+type CreatePromotionHandler struct {
+	promotionRepo promotion.Repository
+}
+
+// NewCreatePromotionHandler creates a new CreatePromotionHandler
+func NewCreatePromotionHandler(promotionRepo promotion.Repository) *CreatePromotionHandler {
+	return &CreatePromotionHandler{
+		promotionRepo: promotionRepo,
+	}
+}
+
+// Handle processes the CreatePromotionCommand
+func (h *CreatePromotionHandler) Handle(ctx context.Context, cmd CreatePromotionCommand) (string, error) {
+	tiers, err := toTiers(cmd.Tiers)
+	if err != nil {
+		return "", err
+	}
+
+	// Create a new promotion
+	newPromotion, err := promotion.NewPromotion(cmd.Name, cmd.Type, cmd.Value, tiers, cmd.Priority, cmd.Stackable)
+	if err != nil {
+		return "", err
+	}
+
+	// Apply the promotion conditions
+	if err := newPromotion.ChangeDetails(cmd.Name, cmd.Description); err != nil {
+		return "", err
+	}
+
+	if err := newPromotion.ChangeMinOrderValue(cmd.MinOrderValue); err != nil {
+		return "", err
+	}
+
+	if err := newPromotion.ChangeValidity(timeOrZero(cmd.ValidFrom), timeOrZero(cmd.ValidUntil)); err != nil {
+		return "", err
+	}
+
+	if err := newPromotion.ChangeEligibility(cmd.ProductIDs, cmd.CategoryIDs); err != nil {
+		return "", err
+	}
+
+	// Save the promotion
+	if err := h.promotionRepo.Save(ctx, newPromotion); err != nil {
+		return "", err
+	}
+
+	return newPromotion.ID().String(), nil
+}
+
+// toTiers converts tier inputs to domain tiers
+func toTiers(inputs []TierInput) ([]promotion.Tier, error) {
+	tiers := make([]promotion.Tier, 0, len(inputs))
+	for _, input := range inputs {
+		tier, err := promotion.NewTier(input.MinQuantity, input.Percentage)
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+	return tiers, nil
+}
+
+// timeOrZero returns the pointed-to time or the zero time when nil
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
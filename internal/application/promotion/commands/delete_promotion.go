@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/promotion"
+)
+
+// DeletePromotionCommand represents the command to delete a promotion
+type DeletePromotionCommand struct {
+	ID string
+}
+
+// DeletePromotionHandler handles the DeletePromotionCommand
+type DeletePromotionHandler struct {
+	promotionRepo promotion.Repository
+}
+
+// NewDeletePromotionHandler creates a new DeletePromotionHandler
+func NewDeletePromotionHandler(promotionRepo promotion.Repository) *DeletePromotionHandler {
+	return &DeletePromotionHandler{
+		promotionRepo: promotionRepo,
+	}
+}
+
+// Handle processes the DeletePromotionCommand
+func (h *DeletePromotionHandler) Handle(ctx context.Context, cmd DeletePromotionCommand) error {
+	// Convert ID string to domain ID
+	id, err := promotion.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check if promotion exists
+	if _, err := h.promotionRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	// Delete the promotion
+	return h.promotionRepo.Delete(ctx, id)
+}
@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/promotion"
+	"time"
+)
+
+// UpdatePromotionCommand represents the command to update a promotion.
+// Pointer and slice fields are only applied when provided.
+type UpdatePromotionCommand struct {
+	ID            string      `json:"-"`
+	Name          string      `json:"name"`
+	Description   *string     `json:"description"`
+	Value         *float64    `json:"value"`
+	Tiers         []TierInput `json:"tiers"`
+	Priority      *int        `json:"priority"`
+	Stackable     *bool       `json:"stackable"`
+	MinOrderValue *float64    `json:"min_order_value"`
+	ValidFrom     *time.Time  `json:"valid_from"`
+	ValidUntil    *time.Time  `json:"valid_until"`
+	ProductIDs    []string    `json:"product_ids"`
+	CategoryIDs   []string    `json:"category_ids"`
+	Active        *bool       `json:"active"`
+}
+
+// UpdatePromotionHandler handles the UpdatePromotionCommand
+type UpdatePromotionHandler struct {
+	promotionRepo promotion.Repository
+}
+
+// NewUpdatePromotionHandler creates a new UpdatePromotionHandler
+func NewUpdatePromotionHandler(promotionRepo promotion.Repository) *UpdatePromotionHandler {
+	return &UpdatePromotionHandler{
+		promotionRepo: promotionRepo,
+	}
+}
+
+// Handle processes the UpdatePromotionCommand
+func (h *UpdatePromotionHandler) Handle(ctx context.Context, cmd UpdatePromotionCommand) error {
+	// Convert ID string to domain ID
+	id, err := promotion.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the promotion
+	existingPromotion, err := h.promotionRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Update promotion fields if provided
+	if cmd.Name != "" || cmd.Description != nil {
+		name := existingPromotion.Name()
+		if cmd.Name != "" {
+			name = cmd.Name
+		}
+
+		description := existingPromotion.Description()
+		if cmd.Description != nil {
+			description = *cmd.Description
+		}
+
+		if err := existingPromotion.ChangeDetails(name, description); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Value != nil || cmd.Tiers != nil {
+		value := existingPromotion.Value()
+		if cmd.Value != nil {
+			value = *cmd.Value
+		}
+
+		tiers := existingPromotion.Tiers()
+		if cmd.Tiers != nil {
+			tiers, err = toTiers(cmd.Tiers)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := existingPromotion.ChangeReward(value, tiers); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Priority != nil || cmd.Stackable != nil {
+		priority := existingPromotion.Priority()
+		if cmd.Priority != nil {
+			priority = *cmd.Priority
+		}
+
+		stackable := existingPromotion.IsStackable()
+		if cmd.Stackable != nil {
+			stackable = *cmd.Stackable
+		}
+
+		existingPromotion.ChangeStacking(priority, stackable)
+	}
+
+	if cmd.MinOrderValue != nil {
+		if err := existingPromotion.ChangeMinOrderValue(*cmd.MinOrderValue); err != nil {
+			return err
+		}
+	}
+
+	if cmd.ValidFrom != nil || cmd.ValidUntil != nil {
+		validFrom := existingPromotion.ValidFrom()
+		if cmd.ValidFrom != nil {
+			validFrom = *cmd.ValidFrom
+		}
+
+		validUntil := existingPromotion.ValidUntil()
+		if cmd.ValidUntil != nil {
+			validUntil = *cmd.ValidUntil
+		}
+
+		if err := existingPromotion.ChangeValidity(validFrom, validUntil); err != nil {
+			return err
+		}
+	}
+
+	if cmd.ProductIDs != nil || cmd.CategoryIDs != nil {
+		productIDs := cmd.ProductIDs
+		if productIDs == nil {
+			productIDs = make([]string, len(existingPromotion.ProductIDs()))
+			for i, productID := range existingPromotion.ProductIDs() {
+				productIDs[i] = productID.String()
+			}
+		}
+
+		categoryIDs := cmd.CategoryIDs
+		if categoryIDs == nil {
+			categoryIDs = existingPromotion.CategoryIDs()
+		}
+
+		if err := existingPromotion.ChangeEligibility(productIDs, categoryIDs); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Active != nil {
+		if *cmd.Active {
+			existingPromotion.Activate()
+		} else {
+			existingPromotion.Deactivate()
+		}
+	}
+
+	// Save the updated promotion
+	return h.promotionRepo.Update(ctx, existingPromotion)
+}
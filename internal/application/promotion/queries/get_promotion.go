@@ -0,0 +1,111 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/promotion"
+	"time"
+)
+
+// TierDTO represents the data transfer object for a promotion tier
+type TierDTO struct {
+	MinQuantity int     `json:"min_quantity"`
+	Percentage  float64 `json:"percentage"`
+}
+
+// PromotionDTO represents the data transfer object for a promotion
+type PromotionDTO struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Description   string     `json:"description"`
+	Type          string     `json:"type"`
+	Value         float64    `json:"value"`
+	Tiers         []*TierDTO `json:"tiers"`
+	Priority      int        `json:"priority"`
+	Stackable     bool       `json:"stackable"`
+	MinOrderValue float64    `json:"min_order_value"`
+	ValidFrom     *time.Time `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	ProductIDs    []string   `json:"product_ids"`
+	CategoryIDs   []string   `json:"category_ids"`
+	Active        bool       `json:"active"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// GetPromotionQuery represents the query to get a promotion by ID
+type GetPromotionQuery struct {
+	ID string
+}
+
+// GetPromotionHandler handles the GetPromotionQuery
+type GetPromotionHandler struct {
+	promotionRepo promotion.Repository
+}
+
+// NewGetPromotionHandler creates a new GetPromotionHandler
+func NewGetPromotionHandler(promotionRepo promotion.Repository) *GetPromotionHandler {
+	return &GetPromotionHandler{
+		promotionRepo: promotionRepo,
+	}
+}
+
+// Handle processes the GetPromotionQuery
+func (h *GetPromotionHandler) Handle(ctx context.Context, query GetPromotionQuery) (*PromotionDTO, error) {
+	// Convert ID string to domain ID
+	id, err := promotion.NewID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the promotion
+	p, err := h.promotionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain promotion to DTO
+	return toPromotionDTO(p), nil
+}
+
+// toPromotionDTO maps a domain promotion to a DTO
+func toPromotionDTO(p *promotion.Promotion) *PromotionDTO {
+	tiers := make([]*TierDTO, len(p.Tiers()))
+	for i, tier := range p.Tiers() {
+		tiers[i] = &TierDTO{
+			MinQuantity: tier.MinQuantity(),
+			Percentage:  tier.Percentage(),
+		}
+	}
+
+	productIDs := make([]string, len(p.ProductIDs()))
+	for i, productID := range p.ProductIDs() {
+		productIDs[i] = productID.String()
+	}
+
+	return &PromotionDTO{
+		ID:            p.ID().String(),
+		Name:          p.Name(),
+		Description:   p.Description(),
+		Type:          p.Type().String(),
+		Value:         p.Value(),
+		Tiers:         tiers,
+		Priority:      p.Priority(),
+		Stackable:     p.IsStackable(),
+		MinOrderValue: p.MinOrderValue(),
+		ValidFrom:     timePtr(p.ValidFrom()),
+		ValidUntil:    timePtr(p.ValidUntil()),
+		ProductIDs:    productIDs,
+		CategoryIDs:   p.CategoryIDs(),
+		Active:        p.IsActive(),
+		CreatedAt:     p.CreatedAt(),
+		UpdatedAt:     p.UpdatedAt(),
+	}
+}
+
+// timePtr returns nil for the zero time so open validity bounds serialize as null
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/promotion"
+)
+
+// ListPromotionsQuery represents the query to list promotions with pagination
+type ListPromotionsQuery struct {
+	Limit  int
+	Offset int
+}
+
+// ListPromotionsHandler handles the ListPromotionsQuery
+type ListPromotionsHandler struct {
+	promotionRepo promotion.Repository
+}
+
+// NewListPromotionsHandler creates a new ListPromotionsHandler
+func NewListPromotionsHandler(promotionRepo promotion.Repository) *ListPromotionsHandler {
+	return &ListPromotionsHandler{
+		promotionRepo: promotionRepo,
+	}
+}
+
+// Handle processes the ListPromotionsQuery
+func (h *ListPromotionsHandler) Handle(ctx context.Context, query ListPromotionsQuery) ([]*PromotionDTO, error) {
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Get promotions from repository
+	promotions, err := h.promotionRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain promotions to DTOs
+	result := make([]*PromotionDTO, len(promotions))
+	for i, p := range promotions {
+		result[i] = toPromotionDTO(p)
+	}
+
+	return result, nil
+}
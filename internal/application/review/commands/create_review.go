@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/review"
+	"e-commerce/internal/domain/user"
+)
+
+// CreateReviewCommand represents the command for a user to review a product
+type CreateReviewCommand struct {
+	ProductID string `json:"-"`
+	UserID    string `json:"user_id"`
+	Rating    int    `json:"rating"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+}
+
+// CreateReviewHandler handles the CreateReviewCommand
+type CreateReviewHandler struct {
+	reviewRepo  review.Repository
+	productRepo product.Repository
+	userRepo    user.Repository
+	orderRepo   order.Repository
+}
+
+// NewCreateReviewHandler creates a new CreateReviewHandler
+func NewCreateReviewHandler(
+	reviewRepo review.Repository,
+	productRepo product.Repository,
+	userRepo user.Repository,
+	orderRepo order.Repository,
+) *CreateReviewHandler {
+	return &CreateReviewHandler{
+		reviewRepo:  reviewRepo,
+		productRepo: productRepo,
+		userRepo:    userRepo,
+		orderRepo:   orderRepo,
+	}
+}
+
+// Handle processes the CreateReviewCommand and returns the ID of the review, which awaits moderation
+func (h *CreateReviewHandler) Handle(ctx context.Context, cmd CreateReviewCommand) (string, error) {
+	// Convert ID strings to domain IDs
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return "", err
+	}
+
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if product and user exist
+	if _, err := h.productRepo.FindByID(ctx, productID); err != nil {
+		return "", err
+	}
+
+	if _, err := h.userRepo.FindByID(ctx, userID); err != nil {
+		return "", err
+	}
+
+	// Check if the user has already reviewed the product
+	if existing, err := h.reviewRepo.FindByProductAndUser(ctx, productID, userID); err == nil && existing != nil {
+		return "", review.ErrDuplicateReview
+	}
+
+	// Check if the user has received the product
+	verified, err := h.orderRepo.HasDelivered(ctx, userID, productID)
+	if err != nil {
+		return "", err
+	}
+
+	// Create a new review
+	newReview, err := review.NewReview(cmd.ProductID, cmd.UserID, cmd.Rating, cmd.Title, cmd.Body, verified)
+	if err != nil {
+		return "", err
+	}
+
+	// Save the review
+	if err := h.reviewRepo.Save(ctx, newReview); err != nil {
+		return "", err
+	}
+
+	return newReview.ID().String(), nil
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/review"
+)
+
+// DeleteReviewCommand represents the command to delete a review
+type DeleteReviewCommand struct {
+	ID string
+}
+
+// DeleteReviewHandler handles the DeleteReviewCommand
+type DeleteReviewHandler struct {
+	reviewRepo review.Repository
+}
+
+// NewDeleteReviewHandler creates a new DeleteReviewHandler
+func NewDeleteReviewHandler(reviewRepo review.Repository) *DeleteReviewHandler {
+	return &DeleteReviewHandler{
+		reviewRepo: reviewRepo,
+	}
+}
+
+// Handle processes the DeleteReviewCommand
+func (h *DeleteReviewHandler) Handle(ctx context.Context, cmd DeleteReviewCommand) error {
+	// Convert ID string to domain ID
+	id, err := review.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check if review exists
+	if _, err := h.reviewRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	// Delete the review
+	return h.reviewRepo.Delete(ctx, id)
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/review"
+)
+
+// ModerateReviewCommand represents the command to approve or reject a review, with an optional note
+type ModerateReviewCommand struct {
+	ID     string `json:"-"`
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
+
+// ModerateReviewHandler handles the ModerateReviewCommand
+type ModerateReviewHandler struct {
+	reviewRepo review.Repository
+}
+
+// NewModerateReviewHandler creates a new ModerateReviewHandler
+func NewModerateReviewHandler(reviewRepo review.Repository) *ModerateReviewHandler {
+	return &ModerateReviewHandler{
+		reviewRepo: reviewRepo,
+	}
+}
+
+// Handle processes the ModerateReviewCommand
+func (h *ModerateReviewHandler) Handle(ctx context.Context, cmd ModerateReviewCommand) error {
+	// Convert ID string to domain ID
+	id, err := review.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the review
+	existingReview, err := h.reviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Moderate the review
+	if err := existingReview.Moderate(cmd.Status, cmd.Note); err != nil {
+		return err
+	}
+
+	// Save the updated review
+	return h.reviewRepo.Update(ctx, existingReview)
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/review"
+)
+
+// UpdateReviewCommand represents the command for the author of a review to change it,
+// which sends it back to moderation
+type UpdateReviewCommand struct {
+	ID     string `json:"-"`
+	UserID string `json:"user_id"`
+	Rating int    `json:"rating"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// UpdateReviewHandler handles the UpdateReviewCommand
+type UpdateReviewHandler struct {
+	reviewRepo review.Repository
+	orderRepo  order.Repository
+}
+
+// NewUpdateReviewHandler creates a new UpdateReviewHandler
+func NewUpdateReviewHandler(reviewRepo review.Repository, orderRepo order.Repository) *UpdateReviewHandler {
+	return &UpdateReviewHandler{
+		reviewRepo: reviewRepo,
+		orderRepo:  orderRepo,
+	}
+}
+
+// Handle processes the UpdateReviewCommand
+func (h *UpdateReviewHandler) Handle(ctx context.Context, cmd UpdateReviewCommand) error {
+	// Convert ID string to domain ID
+	id, err := review.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the review
+	existingReview, err := h.reviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Check if the author has received the product since reviewing it
+	verified, err := h.orderRepo.HasDelivered(ctx, existingReview.UserID(), existingReview.ProductID())
+	if err != nil {
+		return err
+	}
+
+	// Edit the review
+	if err := existingReview.Edit(cmd.UserID, cmd.Rating, cmd.Title, cmd.Body, verified); err != nil {
+		return err
+	}
+
+	// Save the updated review
+	return h.reviewRepo.Update(ctx, existingReview)
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/review"
+	"e-commerce/internal/domain/user"
+)
+
+// VoteReviewCommand represents the command for a user to vote on whether a review is helpful
+type VoteReviewCommand struct {
+	ID      string `json:"-"`
+	UserID  string `json:"user_id"`
+	Helpful bool   `json:"helpful"`
+}
+
+// VoteReviewHandler handles the VoteReviewCommand
+type VoteReviewHandler struct {
+	reviewRepo review.Repository
+	userRepo   user.Repository
+}
+
+// NewVoteReviewHandler creates a new VoteReviewHandler
+func NewVoteReviewHandler(reviewRepo review.Repository, userRepo user.Repository) *VoteReviewHandler {
+	return &VoteReviewHandler{
+		reviewRepo: reviewRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Handle processes the VoteReviewCommand
+func (h *VoteReviewHandler) Handle(ctx context.Context, cmd VoteReviewCommand) error {
+	// Convert ID strings to domain IDs
+	id, err := review.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Check if user exists
+	if _, err := h.userRepo.FindByID(ctx, userID); err != nil {
+		return err
+	}
+
+	// Find the review
+	existingReview, err := h.reviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Record the vote
+	if err := existingReview.Vote(cmd.UserID, cmd.Helpful); err != nil {
+		return err
+	}
+
+	// Save the updated review
+	return h.reviewRepo.Update(ctx, existingReview)
+}
@@ -0,0 +1,87 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/review"
+	"time"
+)
+
+// ReviewDTO represents the data transfer object for a review
+type ReviewDTO struct {
+	ID             string    `json:"id"`
+	ProductID      string    `json:"product_id"`
+	UserID         string    `json:"user_id"`
+	Rating         int       `json:"rating"`
+	Title          string    `json:"title"`
+	Body           string    `json:"body"`
+	Verified       bool      `json:"verified_purchase"`
+	Status         string    `json:"status"`
+	ModerationNote string    `json:"moderation_note,omitempty"`
+	HelpfulVotes   int       `json:"helpful_votes"`
+	UnhelpfulVotes int       `json:"unhelpful_votes"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// GetReviewQuery represents the query to get a review by ID
+type GetReviewQuery struct {
+	ID string
+}
+
+// GetReviewHandler handles the GetReviewQuery
+type GetReviewHandler struct {
+	reviewRepo review.Repository
+}
+
+// NewGetReviewHandler creates a new GetReviewHandler
+func NewGetReviewHandler(reviewRepo review.Repository) *GetReviewHandler {
+	return &GetReviewHandler{
+		reviewRepo: reviewRepo,
+	}
+}
+
+// Handle processes the GetReviewQuery
+func (h *GetReviewHandler) Handle(ctx context.Context, query GetReviewQuery) (*ReviewDTO, error) {
+	// Convert ID string to domain ID
+	id, err := review.NewID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the review
+	r, err := h.reviewRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain review to DTO
+	return toReviewDTO(r), nil
+}
+
+// toReviewDTO maps a domain review to a DTO
+func toReviewDTO(r *review.Review) *ReviewDTO {
+	return &ReviewDTO{
+		ID:             r.ID().String(),
+		ProductID:      r.ProductID().String(),
+		UserID:         r.UserID().String(),
+		Rating:         r.Rating().Value(),
+		Title:          r.Title().String(),
+		Body:           r.Body().String(),
+		Verified:       r.IsVerified(),
+		Status:         r.Status().String(),
+		ModerationNote: r.ModerationNote(),
+		HelpfulVotes:   r.HelpfulVotes(),
+		UnhelpfulVotes: r.UnhelpfulVotes(),
+		CreatedAt:      r.CreatedAt(),
+		UpdatedAt:      r.UpdatedAt(),
+	}
+}
+
+// toReviewDTOs maps domain reviews to DTOs
+func toReviewDTOs(reviews []*review.Review) []*ReviewDTO {
+	result := make([]*ReviewDTO, len(reviews))
+	for i, r := range reviews {
+		result[i] = toReviewDTO(r)
+	}
+	return result
+}
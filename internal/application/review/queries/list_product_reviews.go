@@ -0,0 +1,100 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/review"
+)
+
+// RatingSummaryDTO represents the average of the approved ratings of a product and their distribution,
+// the number of ratings per number of stars
+type RatingSummaryDTO struct {
+	Average      float64     `json:"average"`
+	Count        int         `json:"count"`
+	Distribution map[int]int `json:"distribution"`
+}
+
+// ProductReviewsDTO represents the approved reviews of a product with its rating summary
+type ProductReviewsDTO struct {
+	ProductID string            `json:"product_id"`
+	Rating    *RatingSummaryDTO `json:"rating"`
+	Reviews   []*ReviewDTO      `json:"reviews"`
+}
+
+// ListProductReviewsQuery represents the query to list the approved reviews of a product,
+// optionally only verified purchases, newest first unless sorted otherwise, with pagination
+type ListProductReviewsQuery struct {
+	ProductID    string
+	Sort         string
+	VerifiedOnly bool
+	Limit        int
+	Offset       int
+}
+
+// ListProductReviewsHandler handles the ListProductReviewsQuery
+type ListProductReviewsHandler struct {
+	reviewRepo  review.Repository
+	productRepo product.Repository
+}
+
+// NewListProductReviewsHandler creates a new ListProductReviewsHandler
+func NewListProductReviewsHandler(reviewRepo review.Repository, productRepo product.Repository) *ListProductReviewsHandler {
+	return &ListProductReviewsHandler{
+		reviewRepo:  reviewRepo,
+		productRepo: productRepo,
+	}
+}
+
+// Handle processes the ListProductReviewsQuery
+func (h *ListProductReviewsHandler) Handle(ctx context.Context, query ListProductReviewsQuery) (*ProductReviewsDTO, error) {
+	// Convert ID string to domain ID
+	productID, err := product.NewID(query.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := review.NewSort(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default values if not provided
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Check if product exists
+	if _, err := h.productRepo.FindByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	// Get reviews and ratings from repository
+	reviews, err := h.reviewRepo.ListByProduct(ctx, productID, review.StatusApproved, query.VerifiedOnly, sort, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := h.reviewRepo.Summarize(ctx, []product.ID{productID})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summaries[productID]
+
+	// Map domain reviews to DTOs
+	return &ProductReviewsDTO{
+		ProductID: productID.String(),
+		Rating: &RatingSummaryDTO{
+			Average:      summary.Average(),
+			Count:        summary.Count(),
+			Distribution: summary.Distribution(),
+		},
+		Reviews: toReviewDTOs(reviews),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/review"
+)
+
+// ListReviewsQuery represents the query to list the reviews in a moderation state, pending by default,
+// oldest first with pagination
+type ListReviewsQuery struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// ListReviewsHandler handles the ListReviewsQuery
+type ListReviewsHandler struct {
+	reviewRepo review.Repository
+}
+
+// NewListReviewsHandler creates a new ListReviewsHandler
+func NewListReviewsHandler(reviewRepo review.Repository) *ListReviewsHandler {
+	return &ListReviewsHandler{
+		reviewRepo: reviewRepo,
+	}
+}
+
+// Handle processes the ListReviewsQuery
+func (h *ListReviewsHandler) Handle(ctx context.Context, query ListReviewsQuery) ([]*ReviewDTO, error) {
+	// Set default values if not provided
+	status := review.StatusPending
+	if query.Status != "" {
+		var err error
+		if status, err = review.NewStatus(query.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Get reviews from repository
+	reviews, err := h.reviewRepo.ListByStatus(ctx, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain reviews to DTOs
+	return toReviewDTOs(reviews), nil
+}
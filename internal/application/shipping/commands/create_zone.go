@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/shipping"
+)
+
+// WeightTierInput represents a weight tier of a shipping rate
+type WeightTierInput struct {
+	MaxWeight float64 `json:"max_weight"`
+	Price     float64 `json:"price"`
+}
+
+// RateInput represents a shipping rate offered in a zone
+type RateInput struct {
+	Method        string            `json:"method"`
+	Type          string            `json:"type"`
+	Amount        float64           `json:"amount"`
+	FreeThreshold float64           `json:"free_threshold"`
+	Tiers         []WeightTierInput `json:"tiers"`
+}
+
+// CreateZoneCommand represents the command to create a shipping zone
+type CreateZoneCommand struct {
+	Name      string      `json:"name"`
+	Countries []string    `json:"countries"`
+	Rates     []RateInput `json:"rates"`
+}
+
+// CreateZoneHandler handles the CreateZoneCommand
+type CreateZoneHandler struct {
+	shippingRepo shipping.Repository
+}
+
+// NewCreateZoneHandler creates a new CreateZoneHandler
+func NewCreateZoneHandler(shippingRepo shipping.Repository) *CreateZoneHandler {
+	return &CreateZoneHandler{
+		shippingRepo: shippingRepo,
+	}
+}
+
+// Handle processes the CreateZoneCommand
+func (h *CreateZoneHandler) Handle(ctx context.Context, cmd CreateZoneCommand) (string, error) {
+	// Create a new zone
+	zone, err := shipping.NewZone(cmd.Name, cmd.Countries)
+	if err != nil {
+		return "", err
+	}
+
+	// Add the rates
+	for _, input := range cmd.Rates {
+		tiers := make([]shipping.WeightTier, 0, len(input.Tiers))
+		for _, tierInput := range input.Tiers {
+			tier, err := shipping.NewWeightTier(tierInput.MaxWeight, tierInput.Price)
+			if err != nil {
+				return "", err
+			}
+			tiers = append(tiers, tier)
+		}
+
+		rate, err := shipping.NewRate(input.Method, input.Type, input.Amount, input.FreeThreshold, tiers)
+		if err != nil {
+			return "", err
+		}
+
+		if err := zone.AddRate(rate); err != nil {
+			return "", err
+		}
+	}
+
+	// Save the zone
+	if err := h.shippingRepo.Save(ctx, zone); err != nil {
+		return "", err
+	}
+
+	return zone.ID().String(), nil
+}
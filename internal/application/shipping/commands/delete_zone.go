@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/shipping"
+)
+
+// DeleteZoneCommand represents the command to delete a shipping zone
+type DeleteZoneCommand struct {
+	ID string
+}
+
+// DeleteZoneHandler handles the DeleteZoneCommand
+type DeleteZoneHandler struct {
+	shippingRepo shipping.Repository
+}
+
+// NewDeleteZoneHandler creates a new DeleteZoneHandler
+func NewDeleteZoneHandler(shippingRepo shipping.Repository) *DeleteZoneHandler {
+	return &DeleteZoneHandler{
+		shippingRepo: shippingRepo,
+	}
+}
+
+// Handle processes the DeleteZoneCommand
+func (h *DeleteZoneHandler) Handle(ctx context.Context, cmd DeleteZoneCommand) error {
+	// Convert ID string to domain ID
+	id, err := shipping.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check if zone exists
+	if _, err := h.shippingRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	// Delete the zone
+	return h.shippingRepo.Delete(ctx, id)
+}
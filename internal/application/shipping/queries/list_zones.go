@@ -0,0 +1,92 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/shipping"
+	"time"
+)
+
+// WeightTierDTO represents the data transfer object for a weight tier
+type WeightTierDTO struct {
+	MaxWeight float64 `json:"max_weight"`
+	Price     float64 `json:"price"`
+}
+
+// RateDTO represents the data transfer object for a shipping rate
+type RateDTO struct {
+	Method        string           `json:"method"`
+	Type          string           `json:"type"`
+	Amount        float64          `json:"amount"`
+	FreeThreshold float64          `json:"free_threshold"`
+	Tiers         []*WeightTierDTO `json:"tiers"`
+}
+
+// ZoneDTO represents the data transfer object for a shipping zone
+type ZoneDTO struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Countries []string   `json:"countries"`
+	Rates     []*RateDTO `json:"rates"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// ListZonesQuery represents the query to list shipping zones
+type ListZonesQuery struct{}
+
+// ListZonesHandler handles the ListZonesQuery
+type ListZonesHandler struct {
+	shippingRepo shipping.Repository
+}
+
+// NewListZonesHandler creates a new ListZonesHandler
+func NewListZonesHandler(shippingRepo shipping.Repository) *ListZonesHandler {
+	return &ListZonesHandler{
+		shippingRepo: shippingRepo,
+	}
+}
+
+// Handle processes the ListZonesQuery
+func (h *ListZonesHandler) Handle(ctx context.Context, query ListZonesQuery) ([]*ZoneDTO, error) {
+	// Get zones from repository
+	zones, err := h.shippingRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain zones to DTOs
+	result := make([]*ZoneDTO, len(zones))
+	for i, zone := range zones {
+		countries := make([]string, len(zone.Countries()))
+		for j, country := range zone.Countries() {
+			countries[j] = country.String()
+		}
+
+		rates := make([]*RateDTO, len(zone.Rates()))
+		for j, rate := range zone.Rates() {
+			tiers := make([]*WeightTierDTO, len(rate.Tiers()))
+			for k, tier := range rate.Tiers() {
+				tiers[k] = &WeightTierDTO{MaxWeight: tier.MaxWeight(), Price: tier.Price()}
+			}
+
+			rates[j] = &RateDTO{
+				Method:        rate.Method().String(),
+				Type:          rate.Type().String(),
+				Amount:        rate.Amount(),
+				FreeThreshold: rate.FreeThreshold(),
+				Tiers:         tiers,
+			}
+		}
+
+		result[i] = &ZoneDTO{
+			ID:        zone.ID().String(),
+			Name:      zone.Name(),
+			Countries: countries,
+			Rates:     rates,
+			CreatedAt: zone.CreatedAt(),
+			UpdatedAt: zone.UpdatedAt(),
+		}
+	}
+
+	return result, nil
+}
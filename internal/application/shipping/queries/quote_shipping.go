@@ -0,0 +1,119 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/shipping"
+)
+
+// ShippingOptionDTO represents a shipping method available for a cart
+type ShippingOptionDTO struct {
+	Method string  `json:"method"`
+	Cost   float64 `json:"cost"`
+}
+
+// ShippingQuoteDTO represents the shipping options for a cart and destination
+type ShippingQuoteDTO struct {
+	CartID   string               `json:"cart_id"`
+	Country  string               `json:"country"`
+	Zone     string               `json:"zone"`
+	Weight   float64              `json:"weight"`
+	Subtotal float64              `json:"subtotal"`
+	Options  []*ShippingOptionDTO `json:"options"`
+}
+
+// QuoteShippingQuery represents the query to quote shipping for a cart
+type QuoteShippingQuery struct {
+	CartID  string
+	Country string
+}
+
+// QuoteShippingHandler handles the QuoteShippingQuery
+type QuoteShippingHandler struct {
+	cartRepo     cart.Repository
+	productRepo  product.Repository
+	shippingRepo shipping.Repository
+}
+
+// NewQuoteShippingHandler creates a new QuoteShippingHandler
+func NewQuoteShippingHandler(
+	cartRepo cart.Repository,
+	productRepo product.Repository,
+	shippingRepo shipping.Repository,
+) *QuoteShippingHandler {
+	return &QuoteShippingHandler{
+		cartRepo:     cartRepo,
+		productRepo:  productRepo,
+		shippingRepo: shippingRepo,
+	}
+}
+
+// Handle processes the QuoteShippingQuery
+func (h *QuoteShippingHandler) Handle(ctx context.Context, query QuoteShippingQuery) (*ShippingQuoteDTO, error) {
+	// Convert strings to domain values
+	cartID, err := cart.NewID(query.CartID)
+	if err != nil {
+		return nil, err
+	}
+
+	country, err := shipping.NewCountryCode(query.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the cart
+	c, err := h.cartRepo.FindByID(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.IsEmpty() {
+		return nil, cart.ErrEmptyCart
+	}
+
+	// Build the parcel from the cart items
+	var parcel shipping.Parcel
+	for _, item := range c.Items() {
+		p, err := h.productRepo.FindByID(ctx, item.ProductID())
+		if err != nil {
+			return nil, err
+		}
+
+		parcel.Add(
+			p.Weight().Value(),
+			p.Dimensions().Volume(),
+			item.Quantity(),
+			p.Price().Value()*float64(item.Quantity()),
+		)
+	}
+
+	// Find the zone covering the destination
+	zone, err := h.shippingRepo.FindByCountry(ctx, country)
+	if err != nil {
+		return nil, err
+	}
+
+	// Quote every method of the zone, skipping those that cannot carry the parcel
+	options := []*ShippingOptionDTO{}
+	for _, rate := range zone.Rates() {
+		cost, err := zone.Quote(rate.Method().String(), parcel)
+		if err != nil {
+			continue
+		}
+
+		options = append(options, &ShippingOptionDTO{
+			Method: rate.Method().String(),
+			Cost:   cost,
+		})
+	}
+
+	return &ShippingQuoteDTO{
+		CartID:   c.ID().String(),
+		Country:  country.String(),
+		Zone:     zone.Name(),
+		Weight:   parcel.Weight(),
+		Subtotal: parcel.Subtotal(),
+		Options:  options,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// Storage errors
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+	ErrInvalidKey   = errors.New("invalid blob key")
+)
+
+// Blob represents a stored file being read
+type Blob struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+}
+
+// BlobStore defines the interface for file storage backends. Keys are slash-separated
+// paths such as "products/<id>/<image>/thumbnail.jpg".
+type BlobStore interface {
+	// Put stores data under a key, replacing any existing blob
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+
+	// Get opens the blob stored under a key; the caller closes its body
+	Get(ctx context.Context, key string) (*Blob, error)
+
+	// Delete removes the blob stored under a key, succeeding when there is none
+	Delete(ctx context.Context, key string) error
+}
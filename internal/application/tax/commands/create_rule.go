@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/tax"
+)
+
+// CreateRuleCommand represents the command to create a tax rule
+type CreateRuleCommand struct {
+	Name      string  `json:"name"`
+	Country   string  `json:"country"`
+	Region    string  `json:"region"`
+	Category  string  `json:"category"`
+	Rate      float64 `json:"rate"`
+	Inclusive bool    `json:"inclusive"`
+}
+
+// CreateRuleHandler handles the CreateRuleCommand
+type CreateRuleHandler struct {
+	taxRepo tax.Repository
+}
+
+// NewCreateRuleHandler creates a new CreateRuleHandler
+func NewCreateRuleHandler(taxRepo tax.Repository) *CreateRuleHandler {
+	return &CreateRuleHandler{
+		taxRepo: taxRepo,
+	}
+}
+
+// Handle processes the CreateRuleCommand
+func (h *CreateRuleHandler) Handle(ctx context.Context, cmd CreateRuleCommand) (string, error) {
+	// Create a new rule
+	rule, err := tax.NewRule(cmd.Name, cmd.Country, cmd.Region, cmd.Category, cmd.Rate, cmd.Inclusive)
+	if err != nil {
+		return "", err
+	}
+
+	// Save the rule
+	if err := h.taxRepo.Save(ctx, rule); err != nil {
+		return "", err
+	}
+
+	return rule.ID().String(), nil
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/tax"
+)
+
+// DeleteRuleCommand represents the command to delete a tax rule
+type DeleteRuleCommand struct {
+	ID string
+}
+
+// DeleteRuleHandler handles the DeleteRuleCommand
+type DeleteRuleHandler struct {
+	taxRepo tax.Repository
+}
+
+// NewDeleteRuleHandler creates a new DeleteRuleHandler
+func NewDeleteRuleHandler(taxRepo tax.Repository) *DeleteRuleHandler {
+	return &DeleteRuleHandler{
+		taxRepo: taxRepo,
+	}
+}
+
+// Handle processes the DeleteRuleCommand
+func (h *DeleteRuleHandler) Handle(ctx context.Context, cmd DeleteRuleCommand) error {
+	// Convert ID string to domain ID
+	id, err := tax.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check if rule exists
+	if _, err := h.taxRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	// Delete the rule
+	return h.taxRepo.Delete(ctx, id)
+}
@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/tax"
+	"time"
+)
+
+// RuleDTO represents the data transfer object for a tax rule
+type RuleDTO struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Country   string    `json:"country"`
+	Region    string    `json:"region"`
+	Category  string    `json:"category"`
+	Rate      float64   `json:"rate"`
+	Inclusive bool      `json:"inclusive"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListRulesQuery represents the query to list tax rules
+type ListRulesQuery struct{}
+
+// ListRulesHandler handles the ListRulesQuery
+type ListRulesHandler struct {
+	taxRepo tax.Repository
+}
+
+// NewListRulesHandler creates a new ListRulesHandler
+func NewListRulesHandler(taxRepo tax.Repository) *ListRulesHandler {
+	return &ListRulesHandler{
+		taxRepo: taxRepo,
+	}
+}
+
+// Handle processes the ListRulesQuery
+func (h *ListRulesHandler) Handle(ctx context.Context, query ListRulesQuery) ([]*RuleDTO, error) {
+	// Get rules from repository
+	rules, err := h.taxRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain rules to DTOs
+	result := make([]*RuleDTO, len(rules))
+	for i, rule := range rules {
+		result[i] = &RuleDTO{
+			ID:        rule.ID().String(),
+			Name:      rule.Name(),
+			Country:   rule.Country().String(),
+			Region:    rule.Region(),
+			Category:  rule.Category().String(),
+			Rate:      rule.Rate().Value(),
+			Inclusive: rule.Inclusive(),
+			CreatedAt: rule.CreatedAt(),
+			UpdatedAt: rule.UpdatedAt(),
+		}
+	}
+
+	return result, nil
+}
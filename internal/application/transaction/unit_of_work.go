@@ -0,0 +1,10 @@
+package transaction
+
+import "context"
+
+// UnitOfWork runs work atomically: the repository writes made with the context passed to the work
+// are committed together when it succeeds, and all rolled back when it fails
+type UnitOfWork interface {
+	// Do runs work in a unit of work, joining the unit of work already running in ctx if any
+	Do(ctx context.Context, work func(ctx context.Context) error) error
+}
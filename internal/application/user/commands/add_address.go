@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/user"
+)
+
+// AddressInput represents a postal address
+type AddressInput struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code"`
+	Region     string `json:"region"`
+	Country    string `json:"country"`
+}
+
+// toAddress validates the input as an address
+func (in AddressInput) toAddress() (address.Address, error) {
+	return address.New(in.Name, in.Line1, in.Line2, in.City, in.PostalCode, in.Region, in.Country)
+}
+
+// AddAddressCommand represents the command to save an address in a user's address book
+type AddAddressCommand struct {
+	UserID string `json:"-"`
+	Label  string `json:"label"`
+	AddressInput
+	DefaultShipping bool `json:"default_shipping"`
+	DefaultBilling  bool `json:"default_billing"`
+}
+
+// AddAddressHandler handles the AddAddressCommand
+type AddAddressHandler struct {
+	userRepo user.Repository
+}
+
+// NewAddAddressHandler creates a new AddAddressHandler
+func NewAddAddressHandler(userRepo user.Repository) *AddAddressHandler {
+	return &AddAddressHandler{
+		userRepo: userRepo,
+	}
+}
+
+// Handle processes the AddAddressCommand
+func (h *AddAddressHandler) Handle(ctx context.Context, cmd AddAddressCommand) (string, error) {
+	// Convert ID string to domain ID
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Validate the address
+	addr, err := cmd.toAddress()
+	if err != nil {
+		return "", err
+	}
+
+	// Find the user
+	u, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	// Save the address in the address book
+	saved, err := u.AddAddress(cmd.Label, addr)
+	if err != nil {
+		return "", err
+	}
+
+	if cmd.DefaultShipping {
+		if err := u.SetDefaultShippingAddress(saved.ID()); err != nil {
+			return "", err
+		}
+	}
+
+	if cmd.DefaultBilling {
+		if err := u.SetDefaultBillingAddress(saved.ID()); err != nil {
+			return "", err
+		}
+	}
+
+	// Save the updated user
+	if err := h.userRepo.Update(ctx, u); err != nil {
+		return "", err
+	}
+
+	return saved.ID().String(), nil
+}
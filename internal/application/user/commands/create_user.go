@@ -2,29 +2,47 @@ package commands
 
 import (
 	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/transaction"
+	"e-commerce/internal/domain/cart"
 	"e-commerce/internal/domain/user"
+	"time"
 )
 
-// CreateUserCommand represents the command to create a new user
+// CreateUserCommand represents the command to create a new user, optionally with the locale they
+// are written to in. A visitor registering with a guest cart gives its session token to keep the cart.
 type CreateUserCommand struct {
-	Email    string
-	Password string
-	Name     string
+	Email            string
+	Password         string
+	Name             string
+	Locale           string
+	CartSessionToken string `json:"cart_session_token"`
 }
 
 // CreateUserHandler handles the CreateUserCommand
 type CreateUserHandler struct {
-	userRepo user.Repository
+	uow       transaction.UnitOfWork
+	userRepo  user.Repository
+	cartRepo  cart.Repository
+	publisher events.Publisher
 }
 
 // NewCreateUserHandler creates a new CreateUserHandler
-func NewCreateUserHandler(userRepo user.Repository) *CreateUserHandler {
+func NewCreateUserHandler(
+	uow transaction.UnitOfWork,
+	userRepo user.Repository,
+	cartRepo cart.Repository,
+	publisher events.Publisher,
+) *CreateUserHandler {
 	return &CreateUserHandler{
-		userRepo: userRepo,
+		uow:       uow,
+		userRepo:  userRepo,
+		cartRepo:  cartRepo,
+		publisher: publisher,
 	}
 }
 
-// Handle processes the CreateUserCommand
+// Handle processes the CreateUserCommand. The user is saved and the guest cart handed over in one unit of work.
 func (h *CreateUserHandler) Handle(ctx context.Context, cmd CreateUserCommand) (string, error) {
 	// Check if user with the same email already exists
 	existingUser, err := h.userRepo.FindByEmail(ctx, user.Email(cmd.Email))
@@ -32,16 +50,57 @@ func (h *CreateUserHandler) Handle(ctx context.Context, cmd CreateUserCommand) (
 		return "", user.ErrInvalidEmail
 	}
 
+	// Find the guest cart to keep
+	var guestCart *cart.Cart
+	if cmd.CartSessionToken != "" {
+		guestCart, err = h.cartRepo.FindBySessionToken(ctx, cart.SessionToken(cmd.CartSessionToken))
+		if err != nil {
+			return "", err
+		}
+	}
+
 	// Create a new user
 	newUser, err := user.NewUser(cmd.Email, cmd.Password, cmd.Name)
 	if err != nil {
 		return "", err
 	}
 
-	// Save the user
-	if err := h.userRepo.Save(ctx, newUser); err != nil {
+	if cmd.Locale != "" {
+		if err := newUser.ChangeLocale(cmd.Locale); err != nil {
+			return "", err
+		}
+	}
+
+	// Start the verification of the email address
+	if _, err := newUser.RequestEmailVerification(time.Now(), 0); err != nil {
 		return "", err
 	}
 
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		// Save the user
+		if err := h.userRepo.Save(ctx, newUser); err != nil {
+			return err
+		}
+
+		// Hand the guest cart over to the new user
+		if guestCart == nil {
+			return nil
+		}
+
+		if err := guestCart.Claim(newUser.ID().String()); err != nil {
+			return err
+		}
+
+		return h.cartRepo.Update(ctx, guestCart)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Publish the registration, which sends the verification link
+	for _, event := range newUser.PullEvents() {
+		h.publisher.Publish(ctx, event)
+	}
+
 	return newUser.ID().String(), nil
 }
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/user"
+)
+
+// RemoveAddressCommand represents the command to remove a saved address
+type RemoveAddressCommand struct {
+	UserID    string
+	AddressID string
+}
+
+// RemoveAddressHandler handles the RemoveAddressCommand
+type RemoveAddressHandler struct {
+	userRepo user.Repository
+}
+
+// NewRemoveAddressHandler creates a new RemoveAddressHandler
+func NewRemoveAddressHandler(userRepo user.Repository) *RemoveAddressHandler {
+	return &RemoveAddressHandler{
+		userRepo: userRepo,
+	}
+}
+
+// Handle processes the RemoveAddressCommand
+func (h *RemoveAddressHandler) Handle(ctx context.Context, cmd RemoveAddressCommand) error {
+	// Convert ID strings to domain IDs
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	addressID, err := user.NewAddressID(cmd.AddressID)
+	if err != nil {
+		return err
+	}
+
+	// Find the user
+	u, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the saved address
+	if err := u.RemoveAddress(addressID); err != nil {
+		return err
+	}
+
+	// Save the updated user
+	return h.userRepo.Update(ctx, u)
+}
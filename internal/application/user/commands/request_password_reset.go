@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/user"
+	"errors"
+	"time"
+)
+
+// RequestPasswordResetCommand represents the command to send a user the link to choose a new password
+type RequestPasswordResetCommand struct {
+	Email string
+}
+
+// RequestPasswordResetHandler handles the RequestPasswordResetCommand
+type RequestPasswordResetHandler struct {
+	userRepo  user.Repository
+	publisher events.Publisher
+	ttl       time.Duration
+	cooldown  time.Duration
+}
+
+// NewRequestPasswordResetHandler creates a new RequestPasswordResetHandler; reset links are valid for ttl
+// and a user is sent at most one per cooldown
+func NewRequestPasswordResetHandler(userRepo user.Repository, publisher events.Publisher, ttl time.Duration, cooldown time.Duration) *RequestPasswordResetHandler {
+	return &RequestPasswordResetHandler{
+		userRepo:  userRepo,
+		publisher: publisher,
+		ttl:       ttl,
+		cooldown:  cooldown,
+	}
+}
+
+// Handle processes the RequestPasswordResetCommand. Unknown addresses and requests within the
+// cooldown are ignored, so that the outcome does not tell which addresses have an account.
+func (h *RequestPasswordResetHandler) Handle(ctx context.Context, cmd RequestPasswordResetCommand) error {
+	email, err := user.NewEmail(cmd.Email)
+	if err != nil {
+		return err
+	}
+
+	// Find the user
+	existingUser, err := h.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+
+	// Start the password reset
+	if _, err := existingUser.RequestPasswordReset(time.Now(), h.ttl, h.cooldown); err != nil {
+		if errors.Is(err, user.ErrPasswordResetRateLimited) {
+			return nil
+		}
+		return err
+	}
+
+	// Save the updated user
+	if err := h.userRepo.Update(ctx, existingUser); err != nil {
+		return err
+	}
+
+	// Publish the request, which sends the reset link
+	for _, event := range existingUser.PullEvents() {
+		h.publisher.Publish(ctx, event)
+	}
+
+	return nil
+}
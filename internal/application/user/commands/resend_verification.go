@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/domain/user"
+	"time"
+)
+
+// ResendVerificationCommand represents the command to send a user a new email verification link
+type ResendVerificationCommand struct {
+	UserID string
+}
+
+// ResendVerificationHandler handles the ResendVerificationCommand
+type ResendVerificationHandler struct {
+	userRepo  user.Repository
+	publisher events.Publisher
+	cooldown  time.Duration
+}
+
+// NewResendVerificationHandler creates a new ResendVerificationHandler; a user is sent at most one
+// verification link per cooldown
+func NewResendVerificationHandler(userRepo user.Repository, publisher events.Publisher, cooldown time.Duration) *ResendVerificationHandler {
+	return &ResendVerificationHandler{
+		userRepo:  userRepo,
+		publisher: publisher,
+		cooldown:  cooldown,
+	}
+}
+
+// Handle processes the ResendVerificationCommand. The links sent before stop working.
+func (h *ResendVerificationHandler) Handle(ctx context.Context, cmd ResendVerificationCommand) error {
+	// Convert ID string to domain ID
+	id, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Find the user
+	existingUser, err := h.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Start a new verification
+	if _, err := existingUser.RequestEmailVerification(time.Now(), h.cooldown); err != nil {
+		return err
+	}
+
+	// Save the updated user
+	if err := h.userRepo.Update(ctx, existingUser); err != nil {
+		return err
+	}
+
+	// Publish the request, which sends the verification link
+	for _, event := range existingUser.PullEvents() {
+		h.publisher.Publish(ctx, event)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/user"
+	"time"
+)
+
+// ResetPasswordCommand represents the command to choose a new password with the token of a reset link
+type ResetPasswordCommand struct {
+	Token    string
+	Password string
+}
+
+// ResetPasswordHandler handles the ResetPasswordCommand
+type ResetPasswordHandler struct {
+	userRepo user.Repository
+}
+
+// NewResetPasswordHandler creates a new ResetPasswordHandler
+func NewResetPasswordHandler(userRepo user.Repository) *ResetPasswordHandler {
+	return &ResetPasswordHandler{
+		userRepo: userRepo,
+	}
+}
+
+// Handle processes the ResetPasswordCommand
+func (h *ResetPasswordHandler) Handle(ctx context.Context, cmd ResetPasswordCommand) error {
+	if cmd.Token == "" {
+		return user.ErrInvalidResetToken
+	}
+
+	token := user.PasswordResetToken(cmd.Token)
+
+	// Find the user
+	existingUser, err := h.userRepo.FindByPasswordResetTokenHash(ctx, token.Hash())
+	if err != nil {
+		return user.ErrInvalidResetToken
+	}
+
+	// Change the password
+	if err := existingUser.ResetPassword(token, cmd.Password, time.Now()); err != nil {
+		return err
+	}
+
+	// Save the updated user
+	return h.userRepo.Update(ctx, existingUser)
+}
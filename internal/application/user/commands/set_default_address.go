@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/user"
+)
+
+// SetDefaultAddressCommand represents the command to make a saved address
+// the default shipping and/or billing address
+type SetDefaultAddressCommand struct {
+	UserID    string `json:"-"`
+	AddressID string `json:"-"`
+	Shipping  bool   `json:"shipping"`
+	Billing   bool   `json:"billing"`
+}
+
+// SetDefaultAddressHandler handles the SetDefaultAddressCommand
+type SetDefaultAddressHandler struct {
+	userRepo user.Repository
+}
+
+// NewSetDefaultAddressHandler creates a new SetDefaultAddressHandler
+func NewSetDefaultAddressHandler(userRepo user.Repository) *SetDefaultAddressHandler {
+	return &SetDefaultAddressHandler{
+		userRepo: userRepo,
+	}
+}
+
+// Handle processes the SetDefaultAddressCommand
+func (h *SetDefaultAddressHandler) Handle(ctx context.Context, cmd SetDefaultAddressCommand) error {
+	// Convert ID strings to domain IDs
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	addressID, err := user.NewAddressID(cmd.AddressID)
+	if err != nil {
+		return err
+	}
+
+	// Find the user
+	u, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Change the defaults
+	if cmd.Shipping {
+		if err := u.SetDefaultShippingAddress(addressID); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Billing {
+		if err := u.SetDefaultBillingAddress(addressID); err != nil {
+			return err
+		}
+	}
+
+	// Save the updated user
+	return h.userRepo.Update(ctx, u)
+}
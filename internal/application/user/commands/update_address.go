@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/user"
+)
+
+// UpdateAddressCommand represents the command to replace a saved address
+type UpdateAddressCommand struct {
+	UserID    string `json:"-"`
+	AddressID string `json:"-"`
+	Label     string `json:"label"`
+	AddressInput
+}
+
+// UpdateAddressHandler handles the UpdateAddressCommand
+type UpdateAddressHandler struct {
+	userRepo user.Repository
+}
+
+// NewUpdateAddressHandler creates a new UpdateAddressHandler
+func NewUpdateAddressHandler(userRepo user.Repository) *UpdateAddressHandler {
+	return &UpdateAddressHandler{
+		userRepo: userRepo,
+	}
+}
+
+// Handle processes the UpdateAddressCommand
+func (h *UpdateAddressHandler) Handle(ctx context.Context, cmd UpdateAddressCommand) error {
+	// Convert ID strings to domain IDs
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	addressID, err := user.NewAddressID(cmd.AddressID)
+	if err != nil {
+		return err
+	}
+
+	// Validate the address
+	addr, err := cmd.toAddress()
+	if err != nil {
+		return err
+	}
+
+	// Find the user
+	u, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Replace the saved address
+	if err := u.UpdateAddress(addressID, cmd.Label, addr); err != nil {
+		return err
+	}
+
+	// Save the updated user
+	return h.userRepo.Update(ctx, u)
+}
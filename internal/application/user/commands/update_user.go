@@ -2,26 +2,34 @@ package commands
 
 import (
 	"context"
+	"e-commerce/internal/application/events"
 	"e-commerce/internal/domain/user"
+	"time"
 )
 
-// UpdateUserCommand represents the command to update a user
+// UpdateUserCommand represents the command to update a user. A new email address has to be verified again.
 type UpdateUserCommand struct {
 	ID       string
 	Email    string
 	Name     string
 	Password string
+	Locale   string
 }
 
 // UpdateUserHandler handles the UpdateUserCommand
 type UpdateUserHandler struct {
-	userRepo user.Repository
+	userRepo  user.Repository
+	publisher events.Publisher
+	cooldown  time.Duration
 }
 
-// NewUpdateUserHandler creates a new UpdateUserHandler
-func NewUpdateUserHandler(userRepo user.Repository) *UpdateUserHandler {
+// NewUpdateUserHandler creates a new UpdateUserHandler; the verification email of a changed address waits
+// for the cooldown since the last one
+func NewUpdateUserHandler(userRepo user.Repository, publisher events.Publisher, cooldown time.Duration) *UpdateUserHandler {
 	return &UpdateUserHandler{
-		userRepo: userRepo,
+		userRepo:  userRepo,
+		publisher: publisher,
+		cooldown:  cooldown,
 	}
 }
 
@@ -44,6 +52,10 @@ func (h *UpdateUserHandler) Handle(ctx context.Context, cmd UpdateUserCommand) e
 		if err := existingUser.ChangeEmail(cmd.Email); err != nil {
 			return err
 		}
+
+		if _, err := existingUser.RequestChangedEmailVerification(time.Now(), h.cooldown); err != nil {
+			return err
+		}
 	}
 
 	if cmd.Name != "" && cmd.Name != existingUser.Name().String() {
@@ -52,6 +64,12 @@ func (h *UpdateUserHandler) Handle(ctx context.Context, cmd UpdateUserCommand) e
 		}
 	}
 
+	if cmd.Locale != "" && cmd.Locale != existingUser.Locale().String() {
+		if err := existingUser.ChangeLocale(cmd.Locale); err != nil {
+			return err
+		}
+	}
+
 	if cmd.Password != "" {
 		if err := existingUser.ChangePassword(cmd.Password); err != nil {
 			return err
@@ -59,5 +77,14 @@ func (h *UpdateUserHandler) Handle(ctx context.Context, cmd UpdateUserCommand) e
 	}
 
 	// Save the updated user
-	return h.userRepo.Update(ctx, existingUser)
+	if err := h.userRepo.Update(ctx, existingUser); err != nil {
+		return err
+	}
+
+	// Publish the changes, which sends the verification link of a new email address
+	for _, event := range existingUser.PullEvents() {
+		h.publisher.Publish(ctx, event)
+	}
+
+	return nil
 }
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/application/user/verification"
+	"e-commerce/internal/domain/user"
+	"time"
+)
+
+// VerifyEmailCommand represents the command to verify a user's email address with the token of a verification link
+type VerifyEmailCommand struct {
+	Token string
+}
+
+// VerifyEmailHandler handles the VerifyEmailCommand
+type VerifyEmailHandler struct {
+	userRepo user.Repository
+	tokens   *verification.Tokens
+}
+
+// NewVerifyEmailHandler creates a new VerifyEmailHandler
+func NewVerifyEmailHandler(userRepo user.Repository, tokens *verification.Tokens) *VerifyEmailHandler {
+	return &VerifyEmailHandler{
+		userRepo: userRepo,
+		tokens:   tokens,
+	}
+}
+
+// Handle processes the VerifyEmailCommand and returns the ID of the verified user
+func (h *VerifyEmailHandler) Handle(ctx context.Context, cmd VerifyEmailCommand) (string, error) {
+	now := time.Now()
+
+	// Check the token
+	userID, nonce, err := h.tokens.Parse(cmd.Token, now)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the user
+	existingUser, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", user.ErrInvalidVerificationToken
+	}
+
+	// Verify the email address
+	if err := existingUser.VerifyEmail(nonce, now); err != nil {
+		return "", err
+	}
+
+	// Save the updated user
+	if err := h.userRepo.Update(ctx, existingUser); err != nil {
+		return "", err
+	}
+
+	return existingUser.ID().String(), nil
+}
@@ -8,11 +8,14 @@ import (
 
 // UserDTO represents the data transfer object for user information
 type UserDTO struct {
-	ID        string    `json:"id"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              string     `json:"id"`
+	Email           string     `json:"email"`
+	Name            string     `json:"name"`
+	Locale          string     `json:"locale,omitempty"`
+	EmailVerified   bool       `json:"email_verified"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
 // GetUserQuery represents the query to get a user by ID
@@ -48,10 +51,13 @@ func (h *GetUserHandler) Handle(ctx context.Context, query GetUserQuery) (*UserD
 
 	// Map domain user to DTO
 	return &UserDTO{
-		ID:        u.ID().String(),
-		Email:     u.Email().String(),
-		Name:      u.Name().String(),
-		CreatedAt: u.CreatedAt(),
-		UpdatedAt: u.UpdatedAt(),
+		ID:              u.ID().String(),
+		Email:           u.Email().String(),
+		Name:            u.Name().String(),
+		Locale:          u.Locale().String(),
+		EmailVerified:   u.IsEmailVerified(),
+		EmailVerifiedAt: u.EmailVerifiedAt(),
+		CreatedAt:       u.CreatedAt(),
+		UpdatedAt:       u.UpdatedAt(),
 	}, nil
 }
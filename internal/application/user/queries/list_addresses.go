@@ -0,0 +1,79 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/user"
+	"time"
+)
+
+// SavedAddressDTO represents the data transfer object for a saved address
+type SavedAddressDTO struct {
+	ID              string    `json:"id"`
+	Label           string    `json:"label"`
+	Name            string    `json:"name"`
+	Line1           string    `json:"line1"`
+	Line2           string    `json:"line2"`
+	City            string    `json:"city"`
+	PostalCode      string    `json:"postal_code"`
+	Region          string    `json:"region"`
+	Country         string    `json:"country"`
+	DefaultShipping bool      `json:"default_shipping"`
+	DefaultBilling  bool      `json:"default_billing"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ListAddressesQuery represents the query to list a user's address book
+type ListAddressesQuery struct {
+	UserID string
+}
+
+// ListAddressesHandler handles the ListAddressesQuery
+type ListAddressesHandler struct {
+	userRepo user.Repository
+}
+
+// NewListAddressesHandler creates a new ListAddressesHandler
+func NewListAddressesHandler(userRepo user.Repository) *ListAddressesHandler {
+	return &ListAddressesHandler{
+		userRepo: userRepo,
+	}
+}
+
+// Handle processes the ListAddressesQuery
+func (h *ListAddressesHandler) Handle(ctx context.Context, query ListAddressesQuery) ([]*SavedAddressDTO, error) {
+	// Convert ID string to domain ID
+	id, err := user.NewID(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the user
+	u, err := h.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map saved addresses to DTOs
+	result := make([]*SavedAddressDTO, len(u.Addresses()))
+	for i, saved := range u.Addresses() {
+		addr := saved.Address()
+		result[i] = &SavedAddressDTO{
+			ID:              saved.ID().String(),
+			Label:           saved.Label(),
+			Name:            addr.Name(),
+			Line1:           addr.Line1(),
+			Line2:           addr.Line2(),
+			City:            addr.City(),
+			PostalCode:      addr.PostalCode(),
+			Region:          addr.Region(),
+			Country:         addr.Country(),
+			DefaultShipping: saved.ID() == u.DefaultShippingAddressID(),
+			DefaultBilling:  saved.ID() == u.DefaultBillingAddressID(),
+			CreatedAt:       saved.CreatedAt(),
+			UpdatedAt:       saved.UpdatedAt(),
+		}
+	}
+
+	return result, nil
+}
@@ -46,11 +46,14 @@ func (h *ListUsersHandler) Handle(ctx context.Context, query ListUsersQuery) ([]
 	result := make([]*UserDTO, len(users))
 	for i, u := range users {
 		result[i] = &UserDTO{
-			ID:        u.ID().String(),
-			Email:     u.Email().String(),
-			Name:      u.Name().String(),
-			CreatedAt: u.CreatedAt(),
-			UpdatedAt: u.UpdatedAt(),
+			ID:              u.ID().String(),
+			Email:           u.Email().String(),
+			Name:            u.Name().String(),
+			Locale:          u.Locale().String(),
+			EmailVerified:   u.IsEmailVerified(),
+			EmailVerifiedAt: u.EmailVerifiedAt(),
+			CreatedAt:       u.CreatedAt(),
+			UpdatedAt:       u.UpdatedAt(),
 		}
 	}
 
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/warehouse"
+)
+
+// AddressInput represents the address a warehouse ships from
+type AddressInput struct {
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code"`
+	Region     string `json:"region"`
+	Country    string `json:"country"`
+}
+
+// toAddress converts an address input to a domain address named after the warehouse
+func (a AddressInput) toAddress(name string) (address.Address, error) {
+	return address.New(name, a.Line1, a.Line2, a.City, a.PostalCode, a.Region, a.Country)
+}
+
+// CreateWarehouseCommand represents the command to create a new warehouse.
+// Warehouses with a lower priority are filled and shipped from first.
+type CreateWarehouseCommand struct {
+	Code     string       `json:"code"`
+	Name     string       `json:"name"`
+	Address  AddressInput `json:"address"`
+	Priority int          `json:"priority"`
+}
+
+// CreateWarehouseHandler handles the CreateWarehouseCommand
+type CreateWarehouseHandler struct {
+	warehouseRepo warehouse.Repository
+}
+
+// NewCreateWarehouseHandler creates a new CreateWarehouseHandler
+func NewCreateWarehouseHandler(warehouseRepo warehouse.Repository) *CreateWarehouseHandler {
+	return &CreateWarehouseHandler{
+		warehouseRepo: warehouseRepo,
+	}
+}
+
+// Handle processes the CreateWarehouseCommand
+func (h *CreateWarehouseHandler) Handle(ctx context.Context, cmd CreateWarehouseCommand) (string, error) {
+	// Convert the address
+	addr, err := cmd.Address.toAddress(cmd.Name)
+	if err != nil {
+		return "", err
+	}
+
+	// Create a new warehouse
+	newWarehouse, err := warehouse.NewWarehouse(cmd.Code, cmd.Name, addr, cmd.Priority)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if the code is already in use
+	if _, err := h.warehouseRepo.FindByCode(ctx, newWarehouse.Code()); err == nil {
+		return "", warehouse.ErrDuplicateCode
+	}
+
+	// Save the warehouse
+	if err := h.warehouseRepo.Save(ctx, newWarehouse); err != nil {
+		return "", err
+	}
+
+	return newWarehouse.ID().String(), nil
+}
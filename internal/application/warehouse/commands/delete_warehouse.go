@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/warehouse"
+)
+
+// DeleteWarehouseCommand represents the command to delete a warehouse
+type DeleteWarehouseCommand struct {
+	ID string
+}
+
+// DeleteWarehouseHandler handles the DeleteWarehouseCommand
+type DeleteWarehouseHandler struct {
+	warehouseRepo warehouse.Repository
+}
+
+// NewDeleteWarehouseHandler creates a new DeleteWarehouseHandler
+func NewDeleteWarehouseHandler(warehouseRepo warehouse.Repository) *DeleteWarehouseHandler {
+	return &DeleteWarehouseHandler{
+		warehouseRepo: warehouseRepo,
+	}
+}
+
+// Handle processes the DeleteWarehouseCommand.
+// Warehouses still holding stock cannot be deleted; their stock must be transferred first.
+func (h *DeleteWarehouseHandler) Handle(ctx context.Context, cmd DeleteWarehouseCommand) error {
+	// Convert ID string to domain ID
+	id, err := warehouse.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check if the warehouse exists and is empty
+	if _, err := h.warehouseRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	hasStock, err := h.warehouseRepo.HasStock(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if hasStock {
+		return warehouse.ErrWarehouseInUse
+	}
+
+	// Delete the warehouse
+	return h.warehouseRepo.Delete(ctx, id)
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/warehouse"
+)
+
+// UpdateWarehouseCommand represents the command to update a warehouse.
+// Pointer fields are only applied when provided; the code cannot be changed.
+type UpdateWarehouseCommand struct {
+	ID       string        `json:"-"`
+	Name     string        `json:"name"`
+	Address  *AddressInput `json:"address"`
+	Priority *int          `json:"priority"`
+}
+
+// UpdateWarehouseHandler handles the UpdateWarehouseCommand
+type UpdateWarehouseHandler struct {
+	warehouseRepo warehouse.Repository
+}
+
+// NewUpdateWarehouseHandler creates a new UpdateWarehouseHandler
+func NewUpdateWarehouseHandler(warehouseRepo warehouse.Repository) *UpdateWarehouseHandler {
+	return &UpdateWarehouseHandler{
+		warehouseRepo: warehouseRepo,
+	}
+}
+
+// Handle processes the UpdateWarehouseCommand
+func (h *UpdateWarehouseHandler) Handle(ctx context.Context, cmd UpdateWarehouseCommand) error {
+	// Convert ID string to domain ID
+	id, err := warehouse.NewID(cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// Find the warehouse
+	existingWarehouse, err := h.warehouseRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Update warehouse fields if provided
+	if cmd.Name != "" {
+		if err := existingWarehouse.ChangeName(cmd.Name); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Address != nil {
+		addr, err := cmd.Address.toAddress(existingWarehouse.Name())
+		if err != nil {
+			return err
+		}
+
+		if err := existingWarehouse.ChangeAddress(addr); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Priority != nil {
+		if err := existingWarehouse.ChangePriority(*cmd.Priority); err != nil {
+			return err
+		}
+	}
+
+	// Save the updated warehouse
+	return h.warehouseRepo.Update(ctx, existingWarehouse)
+}
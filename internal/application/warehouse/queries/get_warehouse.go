@@ -0,0 +1,83 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/warehouse"
+	"time"
+)
+
+// AddressDTO represents the address a warehouse ships from
+type AddressDTO struct {
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code"`
+	Region     string `json:"region"`
+	Country    string `json:"country"`
+}
+
+// WarehouseDTO represents the data transfer object for warehouse information
+type WarehouseDTO struct {
+	ID        string      `json:"id"`
+	Code      string      `json:"code"`
+	Name      string      `json:"name"`
+	Address   *AddressDTO `json:"address"`
+	Priority  int         `json:"priority"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// GetWarehouseQuery represents the query to get a warehouse by ID
+type GetWarehouseQuery struct {
+	ID string
+}
+
+// GetWarehouseHandler handles the GetWarehouseQuery
+type GetWarehouseHandler struct {
+	warehouseRepo warehouse.Repository
+}
+
+// NewGetWarehouseHandler creates a new GetWarehouseHandler
+func NewGetWarehouseHandler(warehouseRepo warehouse.Repository) *GetWarehouseHandler {
+	return &GetWarehouseHandler{
+		warehouseRepo: warehouseRepo,
+	}
+}
+
+// Handle processes the GetWarehouseQuery
+func (h *GetWarehouseHandler) Handle(ctx context.Context, query GetWarehouseQuery) (*WarehouseDTO, error) {
+	// Convert ID string to domain ID
+	id, err := warehouse.NewID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the warehouse
+	w, err := h.warehouseRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain warehouse to DTO
+	return toWarehouseDTO(w), nil
+}
+
+// toWarehouseDTO maps a domain warehouse to a DTO
+func toWarehouseDTO(w *warehouse.Warehouse) *WarehouseDTO {
+	return &WarehouseDTO{
+		ID:   w.ID().String(),
+		Code: w.Code().String(),
+		Name: w.Name(),
+		Address: &AddressDTO{
+			Line1:      w.Address().Line1(),
+			Line2:      w.Address().Line2(),
+			City:       w.Address().City(),
+			PostalCode: w.Address().PostalCode(),
+			Region:     w.Address().Region(),
+			Country:    w.Address().Country(),
+		},
+		Priority:  w.Priority(),
+		CreatedAt: w.CreatedAt(),
+		UpdatedAt: w.UpdatedAt(),
+	}
+}
@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/warehouse"
+)
+
+// ListWarehousesQuery represents the query to list all warehouses by priority
+type ListWarehousesQuery struct{}
+
+// ListWarehousesHandler handles the ListWarehousesQuery
+type ListWarehousesHandler struct {
+	warehouseRepo warehouse.Repository
+}
+
+// NewListWarehousesHandler creates a new ListWarehousesHandler
+func NewListWarehousesHandler(warehouseRepo warehouse.Repository) *ListWarehousesHandler {
+	return &ListWarehousesHandler{
+		warehouseRepo: warehouseRepo,
+	}
+}
+
+// Handle processes the ListWarehousesQuery
+func (h *ListWarehousesHandler) Handle(ctx context.Context, query ListWarehousesQuery) ([]*WarehouseDTO, error) {
+	// Find the warehouses
+	warehouses, err := h.warehouseRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain warehouses to DTOs
+	dtos := make([]*WarehouseDTO, len(warehouses))
+	for i, w := range warehouses {
+		dtos[i] = toWarehouseDTO(w)
+	}
+
+	return dtos, nil
+}
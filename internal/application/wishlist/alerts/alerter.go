@@ -0,0 +1,128 @@
+package alerts
+
+import (
+	"context"
+	"e-commerce/internal/application/events"
+	"e-commerce/internal/application/notification"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"e-commerce/internal/domain/wishlist"
+	"fmt"
+)
+
+// Alerter notifies users when a product, or one of its variants, saved to their wishlist drops in price
+// or comes back in stock
+type Alerter struct {
+	wishlistRepo wishlist.Repository
+	productRepo  product.Repository
+	userRepo     user.Repository
+	notifier     notification.Notifier
+}
+
+// NewAlerter creates a new Alerter
+func NewAlerter(
+	wishlistRepo wishlist.Repository,
+	productRepo product.Repository,
+	userRepo user.Repository,
+	notifier notification.Notifier,
+) *Alerter {
+	return &Alerter{
+		wishlistRepo: wishlistRepo,
+		productRepo:  productRepo,
+		userRepo:     userRepo,
+		notifier:     notifier,
+	}
+}
+
+// Subscribe subscribes the alerter to the price dropped and back in stock events of a bus
+func (a *Alerter) Subscribe(bus *events.Bus) {
+	bus.Subscribe(product.EventPriceDropped, a.alertPriceDrop)
+	bus.Subscribe(product.EventBackInStock, a.alertBackInStock)
+}
+
+// alertPriceDrop notifies the users who saved the product or variant at a higher price than it dropped to
+func (a *Alerter) alertPriceDrop(ctx context.Context, event events.Event) error {
+	productEvent, ok := event.(product.Event)
+	if !ok {
+		return nil
+	}
+
+	return a.alert(ctx, productEvent, func(p *product.Product, item *wishlist.Item) (notification.Message, bool) {
+		if productEvent.VariantID() != "" && item.VariantID() != productEvent.VariantID() {
+			return notification.Message{}, false
+		}
+
+		// Products dropping in price leave variants with a price of their own unchanged
+		price, err := p.UnitPrice(item.VariantID())
+		if err != nil || price != productEvent.Price() || price >= item.AddedPrice() {
+			return notification.Message{}, false
+		}
+
+		return notification.Message{
+			Subject: "Price drop: " + p.Name().String(),
+			Body: fmt.Sprintf(
+				"%s from your wishlist is now %.2f, down from %.2f when you saved it.",
+				p.Name().String(), price.Value(), item.AddedPrice().Value(),
+			),
+		}, true
+	})
+}
+
+// alertBackInStock notifies the users who saved the product or variant that came back in stock
+func (a *Alerter) alertBackInStock(ctx context.Context, event events.Event) error {
+	productEvent, ok := event.(product.Event)
+	if !ok {
+		return nil
+	}
+
+	return a.alert(ctx, productEvent, func(p *product.Product, item *wishlist.Item) (notification.Message, bool) {
+		if item.VariantID() != productEvent.VariantID() {
+			return notification.Message{}, false
+		}
+
+		return notification.Message{
+			Subject: "Back in stock: " + p.Name().String(),
+			Body:    fmt.Sprintf("%s from your wishlist is back in stock.", p.Name().String()),
+		}, true
+	})
+}
+
+// alert sends each user who saved the product of an event the message built for the first of their items
+// the event concerns, at most once per user
+func (a *Alerter) alert(
+	ctx context.Context,
+	event product.Event,
+	build func(p *product.Product, item *wishlist.Item) (notification.Message, bool),
+) error {
+	p, err := a.productRepo.FindByID(ctx, event.ProductID())
+	if err != nil {
+		return err
+	}
+
+	wishlists, err := a.wishlistRepo.FindByProduct(ctx, event.ProductID())
+	if err != nil {
+		return err
+	}
+
+	for _, w := range wishlists {
+		for _, item := range w.ItemsOf(event.ProductID()) {
+			msg, ok := build(p, item)
+			if !ok {
+				continue
+			}
+
+			u, err := a.userRepo.FindByID(ctx, w.UserID())
+			if err != nil {
+				return err
+			}
+
+			msg.To = []string{u.Email().String()}
+			if err := a.notifier.Send(ctx, msg); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"e-commerce/internal/domain/wishlist"
+)
+
+// AddWishlistItemCommand represents the command to save a product to a list of the wishlist of a user.
+// Products with variants require the variant to save.
+type AddWishlistItemCommand struct {
+	UserID    string `json:"-"`
+	ListID    string `json:"-"`
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id"`
+}
+
+// AddWishlistItemHandler handles the AddWishlistItemCommand
+type AddWishlistItemHandler struct {
+	wishlistRepo wishlist.Repository
+	productRepo  product.Repository
+	userRepo     user.Repository
+}
+
+// NewAddWishlistItemHandler creates a new AddWishlistItemHandler
+func NewAddWishlistItemHandler(wishlistRepo wishlist.Repository, productRepo product.Repository, userRepo user.Repository) *AddWishlistItemHandler {
+	return &AddWishlistItemHandler{
+		wishlistRepo: wishlistRepo,
+		productRepo:  productRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Handle processes the AddWishlistItemCommand
+func (h *AddWishlistItemHandler) Handle(ctx context.Context, cmd AddWishlistItemCommand) error {
+	// Convert ID strings to domain IDs
+	listID, err := wishlist.NewListID(cmd.ListID)
+	if err != nil {
+		return err
+	}
+
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// Find the product and the price of the variant
+	p, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	price, err := p.UnitPrice(product.VariantID(cmd.VariantID))
+	if err != nil {
+		return err
+	}
+
+	// Find the wishlist of the user
+	w, err := findOrCreateWishlist(ctx, h.wishlistRepo, h.userRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Save the item
+	if err := w.AddItem(listID, cmd.ProductID, cmd.VariantID, price); err != nil {
+		return err
+	}
+
+	// Save the updated wishlist
+	return h.wishlistRepo.Update(ctx, w)
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/user"
+	"e-commerce/internal/domain/wishlist"
+	"errors"
+)
+
+// CreateListCommand represents the command to add a named list to the wishlist of a user
+type CreateListCommand struct {
+	UserID string `json:"-"`
+	Name   string `json:"name"`
+}
+
+// CreateListHandler handles the CreateListCommand
+type CreateListHandler struct {
+	wishlistRepo wishlist.Repository
+	userRepo     user.Repository
+}
+
+// NewCreateListHandler creates a new CreateListHandler
+func NewCreateListHandler(wishlistRepo wishlist.Repository, userRepo user.Repository) *CreateListHandler {
+	return &CreateListHandler{
+		wishlistRepo: wishlistRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Handle processes the CreateListCommand and returns the ID of the new list
+func (h *CreateListHandler) Handle(ctx context.Context, cmd CreateListCommand) (string, error) {
+	// Find the wishlist of the user
+	w, err := findOrCreateWishlist(ctx, h.wishlistRepo, h.userRepo, cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Add the list
+	l, err := w.CreateList(cmd.Name)
+	if err != nil {
+		return "", err
+	}
+
+	// Save the updated wishlist
+	if err := h.wishlistRepo.Update(ctx, w); err != nil {
+		return "", err
+	}
+
+	return l.ID().String(), nil
+}
+
+// findOrCreateWishlist returns the wishlist of a user, creating an empty one for an existing user without one
+func findOrCreateWishlist(ctx context.Context, wishlistRepo wishlist.Repository, userRepo user.Repository, userID string) (*wishlist.Wishlist, error) {
+	// Convert ID string to domain ID
+	userIDVO, err := user.NewID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := wishlistRepo.FindByUserID(ctx, userIDVO)
+	if err == nil {
+		return w, nil
+	}
+	if !errors.Is(err, wishlist.ErrWishlistNotFound) {
+		return nil, err
+	}
+
+	// Check if user exists
+	if _, err := userRepo.FindByID(ctx, userIDVO); err != nil {
+		return nil, err
+	}
+
+	w, err = wishlist.NewWishlist(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wishlistRepo.Save(ctx, w); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// findWishlist returns the wishlist of a user
+func findWishlist(ctx context.Context, wishlistRepo wishlist.Repository, userID string) (*wishlist.Wishlist, error) {
+	// Convert ID string to domain ID
+	userIDVO, err := user.NewID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlistRepo.FindByUserID(ctx, userIDVO)
+}
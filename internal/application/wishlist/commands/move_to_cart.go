@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"e-commerce/internal/domain/wishlist"
+	"time"
+)
+
+// MoveToCartCommand represents the command to move an item from a list of the wishlist of a user
+// to the cart of the user, one unit unless a quantity is given
+type MoveToCartCommand struct {
+	UserID    string `json:"-"`
+	ListID    string `json:"-"`
+	ProductID string `json:"-"`
+	VariantID string `json:"variant_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+// MoveToCartHandler handles the MoveToCartCommand
+type MoveToCartHandler struct {
+	wishlistRepo wishlist.Repository
+	cartRepo     cart.Repository
+	productRepo  product.Repository
+}
+
+// NewMoveToCartHandler creates a new MoveToCartHandler
+func NewMoveToCartHandler(wishlistRepo wishlist.Repository, cartRepo cart.Repository, productRepo product.Repository) *MoveToCartHandler {
+	return &MoveToCartHandler{
+		wishlistRepo: wishlistRepo,
+		cartRepo:     cartRepo,
+		productRepo:  productRepo,
+	}
+}
+
+// Handle processes the MoveToCartCommand
+func (h *MoveToCartHandler) Handle(ctx context.Context, cmd MoveToCartCommand) error {
+	// Convert ID strings to domain IDs
+	listID, err := wishlist.NewListID(cmd.ListID)
+	if err != nil {
+		return err
+	}
+
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	quantity := cmd.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	// Find the wishlist of the user and remove the item from the list
+	w, err := h.wishlistRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := w.RemoveItem(listID, cmd.ProductID, cmd.VariantID); err != nil {
+		return err
+	}
+
+	// Check if the product is for sale and the variant exists
+	p, err := h.productRepo.FindByID(ctx, product.ID(cmd.ProductID))
+	if err != nil {
+		return err
+	}
+
+	if !p.IsAvailable(time.Now()) {
+		return product.ErrProductUnavailable
+	}
+
+	price, err := p.UnitPrice(product.VariantID(cmd.VariantID))
+	if err != nil {
+		return err
+	}
+
+	// Find the cart of the user, creating one when the user has none
+	userCart, err := h.cartRepo.FindByUserID(ctx, userID)
+	isNewCart := err != nil || userCart == nil
+	if isNewCart {
+		if userCart, err = cart.NewCart(cmd.UserID); err != nil {
+			return err
+		}
+	}
+
+	// Add the item to the cart
+	if err := userCart.AddItem(cmd.ProductID, cmd.VariantID, quantity, price); err != nil {
+		return err
+	}
+
+	// Save the cart, then the updated wishlist
+	if isNewCart {
+		err = h.cartRepo.Save(ctx, userCart)
+	} else {
+		err = h.cartRepo.Update(ctx, userCart)
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.wishlistRepo.Update(ctx, w)
+}
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/wishlist"
+)
+
+// RemoveListCommand represents the command to remove a list and its items from the wishlist of a user
+type RemoveListCommand struct {
+	UserID string
+	ListID string
+}
+
+// RemoveListHandler handles the RemoveListCommand
+type RemoveListHandler struct {
+	wishlistRepo wishlist.Repository
+}
+
+// NewRemoveListHandler creates a new RemoveListHandler
+func NewRemoveListHandler(wishlistRepo wishlist.Repository) *RemoveListHandler {
+	return &RemoveListHandler{
+		wishlistRepo: wishlistRepo,
+	}
+}
+
+// Handle processes the RemoveListCommand
+func (h *RemoveListHandler) Handle(ctx context.Context, cmd RemoveListCommand) error {
+	// Convert ID string to domain ID
+	listID, err := wishlist.NewListID(cmd.ListID)
+	if err != nil {
+		return err
+	}
+
+	// Find the wishlist of the user
+	w, err := findWishlist(ctx, h.wishlistRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the list
+	if err := w.RemoveList(listID); err != nil {
+		return err
+	}
+
+	// Save the updated wishlist
+	return h.wishlistRepo.Update(ctx, w)
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/wishlist"
+)
+
+// RemoveWishlistItemCommand represents the command to remove a product, or one of its variants,
+// from a list of the wishlist of a user
+type RemoveWishlistItemCommand struct {
+	UserID    string
+	ListID    string
+	ProductID string
+	VariantID string
+}
+
+// RemoveWishlistItemHandler handles the RemoveWishlistItemCommand
+type RemoveWishlistItemHandler struct {
+	wishlistRepo wishlist.Repository
+}
+
+// NewRemoveWishlistItemHandler creates a new RemoveWishlistItemHandler
+func NewRemoveWishlistItemHandler(wishlistRepo wishlist.Repository) *RemoveWishlistItemHandler {
+	return &RemoveWishlistItemHandler{
+		wishlistRepo: wishlistRepo,
+	}
+}
+
+// Handle processes the RemoveWishlistItemCommand
+func (h *RemoveWishlistItemHandler) Handle(ctx context.Context, cmd RemoveWishlistItemCommand) error {
+	// Convert ID string to domain ID
+	listID, err := wishlist.NewListID(cmd.ListID)
+	if err != nil {
+		return err
+	}
+
+	// Find the wishlist of the user
+	w, err := findWishlist(ctx, h.wishlistRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Remove the item
+	if err := w.RemoveItem(listID, cmd.ProductID, cmd.VariantID); err != nil {
+		return err
+	}
+
+	// Save the updated wishlist
+	return h.wishlistRepo.Update(ctx, w)
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/wishlist"
+)
+
+// RenameListCommand represents the command to rename a list of the wishlist of a user
+type RenameListCommand struct {
+	UserID string `json:"-"`
+	ListID string `json:"-"`
+	Name   string `json:"name"`
+}
+
+// RenameListHandler handles the RenameListCommand
+type RenameListHandler struct {
+	wishlistRepo wishlist.Repository
+}
+
+// NewRenameListHandler creates a new RenameListHandler
+func NewRenameListHandler(wishlistRepo wishlist.Repository) *RenameListHandler {
+	return &RenameListHandler{
+		wishlistRepo: wishlistRepo,
+	}
+}
+
+// Handle processes the RenameListCommand
+func (h *RenameListHandler) Handle(ctx context.Context, cmd RenameListCommand) error {
+	// Convert ID string to domain ID
+	listID, err := wishlist.NewListID(cmd.ListID)
+	if err != nil {
+		return err
+	}
+
+	// Find the wishlist of the user
+	w, err := findWishlist(ctx, h.wishlistRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Rename the list
+	if err := w.RenameList(listID, cmd.Name); err != nil {
+		return err
+	}
+
+	// Save the updated wishlist
+	return h.wishlistRepo.Update(ctx, w)
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"e-commerce/internal/domain/wishlist"
+)
+
+// SaveForLaterCommand represents the command to move an item out of the cart of a user to a list
+// of the wishlist of the user, the "Saved for later" list unless a list is given
+type SaveForLaterCommand struct {
+	UserID    string `json:"-"`
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id"`
+	ListID    string `json:"list_id"`
+}
+
+// SaveForLaterHandler handles the SaveForLaterCommand
+type SaveForLaterHandler struct {
+	wishlistRepo wishlist.Repository
+	cartRepo     cart.Repository
+	productRepo  product.Repository
+	userRepo     user.Repository
+}
+
+// NewSaveForLaterHandler creates a new SaveForLaterHandler
+func NewSaveForLaterHandler(
+	wishlistRepo wishlist.Repository,
+	cartRepo cart.Repository,
+	productRepo product.Repository,
+	userRepo user.Repository,
+) *SaveForLaterHandler {
+	return &SaveForLaterHandler{
+		wishlistRepo: wishlistRepo,
+		cartRepo:     cartRepo,
+		productRepo:  productRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Handle processes the SaveForLaterCommand and returns the ID of the list the item was saved to
+func (h *SaveForLaterHandler) Handle(ctx context.Context, cmd SaveForLaterCommand) (string, error) {
+	// Convert ID strings to domain IDs
+	userID, err := user.NewID(cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	productID, err := product.NewID(cmd.ProductID)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the cart of the user and remove the item from it
+	userCart, err := h.cartRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := userCart.RemoveItem(cmd.ProductID, cmd.VariantID); err != nil {
+		return "", err
+	}
+
+	// Find the product and the price of the variant
+	p, err := h.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return "", err
+	}
+
+	price, err := p.UnitPrice(product.VariantID(cmd.VariantID))
+	if err != nil {
+		return "", err
+	}
+
+	// Find the wishlist of the user and the list to save to
+	w, err := findOrCreateWishlist(ctx, h.wishlistRepo, h.userRepo, cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	var list *wishlist.List
+	if cmd.ListID == "" {
+		list = w.SavedForLater()
+	} else if list, err = w.FindList(wishlist.ListID(cmd.ListID)); err != nil {
+		return "", err
+	}
+
+	// Save the item
+	if err := w.AddItem(list.ID(), cmd.ProductID, cmd.VariantID, price); err != nil {
+		return "", err
+	}
+
+	// Save the updated wishlist, then the cart
+	if err := h.wishlistRepo.Update(ctx, w); err != nil {
+		return "", err
+	}
+
+	if err := h.cartRepo.Update(ctx, userCart); err != nil {
+		return "", err
+	}
+
+	return list.ID().String(), nil
+}
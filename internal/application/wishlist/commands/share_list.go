@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/wishlist"
+)
+
+// ShareListCommand represents the command to make a list of the wishlist of a user viewable through a public link
+type ShareListCommand struct {
+	UserID string
+	ListID string
+}
+
+// ShareListHandler handles the ShareListCommand
+type ShareListHandler struct {
+	wishlistRepo wishlist.Repository
+}
+
+// NewShareListHandler creates a new ShareListHandler
+func NewShareListHandler(wishlistRepo wishlist.Repository) *ShareListHandler {
+	return &ShareListHandler{
+		wishlistRepo: wishlistRepo,
+	}
+}
+
+// Handle processes the ShareListCommand and returns the token of the public link
+func (h *ShareListHandler) Handle(ctx context.Context, cmd ShareListCommand) (string, error) {
+	// Convert ID string to domain ID
+	listID, err := wishlist.NewListID(cmd.ListID)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the wishlist of the user
+	w, err := findWishlist(ctx, h.wishlistRepo, cmd.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	// Share the list
+	token, err := w.ShareList(listID)
+	if err != nil {
+		return "", err
+	}
+
+	// Save the updated wishlist
+	if err := h.wishlistRepo.Update(ctx, w); err != nil {
+		return "", err
+	}
+
+	return token.String(), nil
+}
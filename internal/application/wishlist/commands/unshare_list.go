@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"e-commerce/internal/domain/wishlist"
+)
+
+// UnshareListCommand represents the command to make a shared list of the wishlist of a user private again
+type UnshareListCommand struct {
+	UserID string
+	ListID string
+}
+
+// UnshareListHandler handles the UnshareListCommand
+type UnshareListHandler struct {
+	wishlistRepo wishlist.Repository
+}
+
+// NewUnshareListHandler creates a new UnshareListHandler
+func NewUnshareListHandler(wishlistRepo wishlist.Repository) *UnshareListHandler {
+	return &UnshareListHandler{
+		wishlistRepo: wishlistRepo,
+	}
+}
+
+// Handle processes the UnshareListCommand
+func (h *UnshareListHandler) Handle(ctx context.Context, cmd UnshareListCommand) error {
+	// Convert ID string to domain ID
+	listID, err := wishlist.NewListID(cmd.ListID)
+	if err != nil {
+		return err
+	}
+
+	// Find the wishlist of the user
+	w, err := findWishlist(ctx, h.wishlistRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Make the list private
+	if err := w.UnshareList(listID); err != nil {
+		return err
+	}
+
+	// Save the updated wishlist
+	return h.wishlistRepo.Update(ctx, w)
+}
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/wishlist"
+	"time"
+)
+
+// SharedListDTO represents the data transfer object for a list viewed through its public link
+type SharedListDTO struct {
+	Name      string             `json:"name"`
+	Items     []*WishlistItemDTO `json:"items"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// GetSharedListQuery represents the query to get a list by the token of its public link
+type GetSharedListQuery struct {
+	Token string
+}
+
+// GetSharedListHandler handles the GetSharedListQuery
+type GetSharedListHandler struct {
+	wishlistRepo wishlist.Repository
+	productRepo  product.Repository
+}
+
+// NewGetSharedListHandler creates a new GetSharedListHandler
+func NewGetSharedListHandler(wishlistRepo wishlist.Repository, productRepo product.Repository) *GetSharedListHandler {
+	return &GetSharedListHandler{
+		wishlistRepo: wishlistRepo,
+		productRepo:  productRepo,
+	}
+}
+
+// Handle processes the GetSharedListQuery
+func (h *GetSharedListHandler) Handle(ctx context.Context, query GetSharedListQuery) (*SharedListDTO, error) {
+	token := wishlist.ShareToken(query.Token)
+	if token == "" {
+		return nil, wishlist.ErrListNotFound
+	}
+
+	// Find the wishlist holding the shared list
+	w, err := h.wishlistRepo.FindByShareToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := w.FindSharedList(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the saved products
+	products, err := findProducts(ctx, h.productRepo, []*wishlist.List{l})
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain list to DTO, leaving out who owns it
+	return &SharedListDTO{
+		Name:      l.Name().String(),
+		Items:     toItemDTOs(l.Items(), products),
+		UpdatedAt: l.UpdatedAt(),
+	}, nil
+}
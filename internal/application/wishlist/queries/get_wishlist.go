@@ -0,0 +1,157 @@
+package queries
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"e-commerce/internal/domain/wishlist"
+	"time"
+)
+
+// WishlistItemDTO represents the data transfer object for a saved product with its current price and stock
+type WishlistItemDTO struct {
+	ProductID    string    `json:"product_id"`
+	VariantID    string    `json:"variant_id,omitempty"`
+	Name         string    `json:"name"`
+	Price        float64   `json:"price"`
+	AddedPrice   float64   `json:"added_price"`
+	PriceDropped bool      `json:"price_dropped"`
+	InStock      bool      `json:"in_stock"`
+	Available    bool      `json:"available"`
+	AddedAt      time.Time `json:"added_at"`
+}
+
+// WishlistListDTO represents the data transfer object for a named list
+type WishlistListDTO struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Shared     bool               `json:"shared"`
+	ShareToken string             `json:"share_token,omitempty"`
+	Items      []*WishlistItemDTO `json:"items"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+// WishlistDTO represents the data transfer object for the wishlist of a user
+type WishlistDTO struct {
+	ID        string             `json:"id"`
+	UserID    string             `json:"user_id"`
+	Lists     []*WishlistListDTO `json:"lists"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// GetWishlistQuery represents the query to get the wishlist of a user
+type GetWishlistQuery struct {
+	UserID string
+}
+
+// GetWishlistHandler handles the GetWishlistQuery
+type GetWishlistHandler struct {
+	wishlistRepo wishlist.Repository
+	productRepo  product.Repository
+}
+
+// NewGetWishlistHandler creates a new GetWishlistHandler
+func NewGetWishlistHandler(wishlistRepo wishlist.Repository, productRepo product.Repository) *GetWishlistHandler {
+	return &GetWishlistHandler{
+		wishlistRepo: wishlistRepo,
+		productRepo:  productRepo,
+	}
+}
+
+// Handle processes the GetWishlistQuery
+func (h *GetWishlistHandler) Handle(ctx context.Context, query GetWishlistQuery) (*WishlistDTO, error) {
+	// Convert ID string to domain ID
+	userID, err := user.NewID(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the wishlist
+	w, err := h.wishlistRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the saved products
+	products, err := findProducts(ctx, h.productRepo, w.Lists())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map domain wishlist to DTO
+	lists := make([]*WishlistListDTO, len(w.Lists()))
+	for i, l := range w.Lists() {
+		lists[i] = &WishlistListDTO{
+			ID:         l.ID().String(),
+			Name:       l.Name().String(),
+			Shared:     l.IsShared(),
+			ShareToken: l.ShareToken().String(),
+			Items:      toItemDTOs(l.Items(), products),
+			CreatedAt:  l.CreatedAt(),
+			UpdatedAt:  l.UpdatedAt(),
+		}
+	}
+
+	return &WishlistDTO{
+		ID:        w.ID().String(),
+		UserID:    w.UserID().String(),
+		Lists:     lists,
+		CreatedAt: w.CreatedAt(),
+		UpdatedAt: w.UpdatedAt(),
+	}, nil
+}
+
+// findProducts retrieves the products saved to the given lists, keyed by ID
+func findProducts(ctx context.Context, productRepo product.Repository, lists []*wishlist.List) (map[product.ID]*product.Product, error) {
+	ids := []product.ID{}
+	for _, l := range lists {
+		for _, item := range l.Items() {
+			ids = append(ids, item.ProductID())
+		}
+	}
+
+	found, err := productRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make(map[product.ID]*product.Product, len(found))
+	for _, p := range found {
+		products[p.ID()] = p
+	}
+	return products, nil
+}
+
+// toItemDTOs maps the items of a list to DTOs with the current price and stock of the products,
+// skipping products that no longer exist
+func toItemDTOs(items []*wishlist.Item, products map[product.ID]*product.Product) []*WishlistItemDTO {
+	now := time.Now()
+
+	dtos := []*WishlistItemDTO{}
+	for _, item := range items {
+		p, ok := products[item.ProductID()]
+		if !ok {
+			continue
+		}
+
+		price, err := p.UnitPrice(item.VariantID())
+		if err != nil {
+			price = item.AddedPrice()
+		}
+
+		dtos = append(dtos, &WishlistItemDTO{
+			ProductID:    item.ProductID().String(),
+			VariantID:    item.VariantID().String(),
+			Name:         p.Name().String(),
+			Price:        price.Value(),
+			AddedPrice:   item.AddedPrice().Value(),
+			PriceDropped: price < item.AddedPrice(),
+			InStock:      p.Shortfall(item.VariantID(), 1) == 0,
+			Available:    err == nil && p.IsAvailable(now),
+			AddedAt:      item.AddedAt(),
+		})
+	}
+	return dtos
+}
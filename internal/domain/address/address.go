@@ -0,0 +1,167 @@
+package address
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Address errors
+var (
+	ErrInvalidName       = errors.New("address recipient name cannot be empty")
+	ErrInvalidLine       = errors.New("address line cannot be empty")
+	ErrInvalidCity       = errors.New("address city cannot be empty")
+	ErrInvalidPostalCode = errors.New("invalid postal code for country")
+	ErrInvalidRegion     = errors.New("invalid region for country")
+	ErrInvalidCountry    = errors.New("country must be an ISO 3166-1 alpha-2 code")
+)
+
+// countryRule describes how addresses are validated in a country
+type countryRule struct {
+	postalCode     *regexp.Regexp
+	regionRequired bool
+}
+
+// countryRules holds the per-country validation rules; other countries only
+// get the generic checks and an optional postal code
+var countryRules = map[string]countryRule{
+	"US": {postalCode: regexp.MustCompile(`^\d{5}(-\d{4})?$`), regionRequired: true},
+	"CA": {postalCode: regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`), regionRequired: true},
+	"AU": {postalCode: regexp.MustCompile(`^\d{4}$`), regionRequired: true},
+	"BR": {postalCode: regexp.MustCompile(`^\d{5}-?\d{3}$`), regionRequired: true},
+	"IN": {postalCode: regexp.MustCompile(`^\d{6}$`), regionRequired: true},
+	"GB": {postalCode: regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`)},
+	"DE": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"FR": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"IT": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"ES": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"TR": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"NL": {postalCode: regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`)},
+	"JP": {postalCode: regexp.MustCompile(`^\d{3}-?\d{4}$`)},
+}
+
+var countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Address represents a postal address value object
+type Address struct {
+	name       string
+	line1      string
+	line2      string
+	city       string
+	postalCode string
+	region     string
+	country    string
+}
+
+// New creates a new Address validated against the rules of its country
+func New(name, line1, line2, city, postalCode, region, country string) (Address, error) {
+	a := Address{
+		name:       strings.TrimSpace(name),
+		line1:      strings.TrimSpace(line1),
+		line2:      strings.TrimSpace(line2),
+		city:       strings.TrimSpace(city),
+		postalCode: strings.ToUpper(strings.TrimSpace(postalCode)),
+		region:     strings.ToUpper(strings.TrimSpace(region)),
+		country:    strings.ToUpper(strings.TrimSpace(country)),
+	}
+
+	if a.name == "" {
+		return Address{}, ErrInvalidName
+	}
+
+	if a.line1 == "" {
+		return Address{}, ErrInvalidLine
+	}
+
+	if a.city == "" {
+		return Address{}, ErrInvalidCity
+	}
+
+	if !countryPattern.MatchString(a.country) {
+		return Address{}, ErrInvalidCountry
+	}
+
+	rule, ok := countryRules[a.country]
+	if !ok {
+		if len(a.postalCode) > 20 {
+			return Address{}, ErrInvalidPostalCode
+		}
+		return a, nil
+	}
+
+	if !rule.postalCode.MatchString(a.postalCode) {
+		return Address{}, ErrInvalidPostalCode
+	}
+
+	if rule.regionRequired && a.region == "" {
+		return Address{}, ErrInvalidRegion
+	}
+
+	return a, nil
+}
+
+// Reconstruct rebuilds an address from persisted state without validation
+func Reconstruct(name, line1, line2, city, postalCode, region, country string) Address {
+	return Address{
+		name:       name,
+		line1:      line1,
+		line2:      line2,
+		city:       city,
+		postalCode: postalCode,
+		region:     region,
+		country:    country,
+	}
+}
+
+// Name returns the recipient name
+func (a Address) Name() string {
+	return a.name
+}
+
+// Line1 returns the first address line
+func (a Address) Line1() string {
+	return a.line1
+}
+
+// Line2 returns the optional second address line
+func (a Address) Line2() string {
+	return a.line2
+}
+
+// City returns the city
+func (a Address) City() string {
+	return a.city
+}
+
+// PostalCode returns the postal code
+func (a Address) PostalCode() string {
+	return a.postalCode
+}
+
+// Region returns the region, state or province code
+func (a Address) Region() string {
+	return a.region
+}
+
+// Country returns the ISO 3166-1 alpha-2 country code
+func (a Address) Country() string {
+	return a.country
+}
+
+// IsZero reports whether the address is empty
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// String returns the address formatted on a single line
+func (a Address) String() string {
+	parts := []string{a.name, a.line1, a.line2, strings.TrimSpace(a.postalCode + " " + a.city), a.region, a.country}
+
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, ", ")
+}
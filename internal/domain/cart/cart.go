@@ -15,20 +15,28 @@ var (
 	ErrInvalidUserID    = errors.New("invalid user ID")
 	ErrInvalidProductID = errors.New("invalid product ID")
 	ErrInvalidQuantity  = errors.New("invalid quantity")
+	ErrInvalidPrice     = errors.New("invalid price")
 	ErrProductNotFound  = errors.New("product not found in cart")
+	ErrEmptyCart        = errors.New("cart is empty")
+	ErrInvalidCoupon    = errors.New("invalid coupon code")
+	ErrNotGuestCart     = errors.New("cart is not a guest cart")
+	ErrCartNotFound     = errors.New("cart not found")
 )
 
 // CartItem represents an item in a cart
 type CartItem struct {
 	id        ID
 	productID product.ID
+	variantID product.VariantID
 	quantity  int
+	price     product.Price
 	createdAt time.Time
 	updatedAt time.Time
 }
 
-// NewCartItem creates a new cart item
-func NewCartItem(productID string, quantity int) (*CartItem, error) {
+// NewCartItem creates a new cart item for a product, or for one of its variants when variantID is not empty,
+// at the unit price the customer was shown
+func NewCartItem(productID, variantID string, quantity int, price product.Price) (*CartItem, error) {
 	id, err := NewID(uuid.New().String())
 	if err != nil {
 		return nil, err
@@ -43,17 +51,43 @@ func NewCartItem(productID string, quantity int) (*CartItem, error) {
 		return nil, ErrInvalidQuantity
 	}
 
+	if price < 0 {
+		return nil, ErrInvalidPrice
+	}
+
 	now := time.Now()
 
 	return &CartItem{
 		id:        id,
 		productID: productIDVO,
+		variantID: product.VariantID(variantID),
 		quantity:  quantity,
+		price:     price,
 		createdAt: now,
 		updatedAt: now,
 	}, nil
 }
 
+// ReconstructCartItem rebuilds a cart item from persisted state
+func ReconstructCartItem(
+	id ID,
+	productID product.ID,
+	variantID product.VariantID,
+	quantity int,
+	price product.Price,
+	createdAt, updatedAt time.Time,
+) *CartItem {
+	return &CartItem{
+		id:        id,
+		productID: productID,
+		variantID: variantID,
+		quantity:  quantity,
+		price:     price,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
 // ID returns the cart item ID
 func (ci *CartItem) ID() ID {
 	return ci.id
@@ -64,11 +98,21 @@ func (ci *CartItem) ProductID() product.ID {
 	return ci.productID
 }
 
+// VariantID returns the product variant ID, empty for products without variants
+func (ci *CartItem) VariantID() product.VariantID {
+	return ci.variantID
+}
+
 // Quantity returns the quantity
 func (ci *CartItem) Quantity() int {
 	return ci.quantity
 }
 
+// Price returns the unit price the customer was last shown for the item, zero when unknown
+func (ci *CartItem) Price() product.Price {
+	return ci.price
+}
+
 // CreatedAt returns the cart item creation time
 func (ci *CartItem) CreatedAt() time.Time {
 	return ci.createdAt
@@ -79,6 +123,11 @@ func (ci *CartItem) UpdatedAt() time.Time {
 	return ci.updatedAt
 }
 
+// matches checks if the item holds the given product variant
+func (ci *CartItem) matches(productID, variantID string) bool {
+	return ci.productID.String() == productID && ci.variantID.String() == variantID
+}
+
 // UpdateQuantity updates the cart item quantity
 func (ci *CartItem) UpdateQuantity(quantity int) error {
 	if quantity <= 0 {
@@ -101,13 +150,20 @@ func (ci *CartItem) IncreaseQuantity(amount int) error {
 	return nil
 }
 
-// Cart represents the cart aggregate root
+// Cart represents the cart aggregate root. A cart belongs either to a user or,
+// for anonymous visitors, to the session holding its token.
 type Cart struct {
-	id        ID
-	userID    user.ID
-	items     []*CartItem
-	createdAt time.Time
-	updatedAt time.Time
+	id           ID
+	userID       user.ID
+	sessionToken SessionToken
+	items        []*CartItem
+	couponCode   string
+	createdAt    time.Time
+	updatedAt    time.Time
+
+	remindersSent  int
+	lastRemindedAt *time.Time
+	recoveryToken  RecoveryToken
 }
 
 // NewCart creates a new cart
@@ -133,21 +189,85 @@ func NewCart(userID string) (*Cart, error) {
 	}, nil
 }
 
+// NewGuestCart creates a new cart for an anonymous visitor, identified by a new session token
+func NewGuestCart() (*Cart, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := NewSessionToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Cart{
+		id:           id,
+		sessionToken: token,
+		items:        []*CartItem{},
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+// Reconstruct rebuilds a cart from persisted state
+func Reconstruct(
+	id ID,
+	userID user.ID,
+	sessionToken SessionToken,
+	items []*CartItem,
+	couponCode string,
+	createdAt, updatedAt time.Time,
+	remindersSent int,
+	lastRemindedAt *time.Time,
+	recoveryToken RecoveryToken,
+) *Cart {
+	return &Cart{
+		id:             id,
+		userID:         userID,
+		sessionToken:   sessionToken,
+		items:          items,
+		couponCode:     couponCode,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		remindersSent:  remindersSent,
+		lastRemindedAt: lastRemindedAt,
+		recoveryToken:  recoveryToken,
+	}
+}
+
 // ID returns the cart ID
 func (c *Cart) ID() ID {
 	return c.id
 }
 
-// UserID returns the user ID
+// UserID returns the user ID, empty for guest carts
 func (c *Cart) UserID() user.ID {
 	return c.userID
 }
 
+// SessionToken returns the session token of a guest cart, empty for user carts
+func (c *Cart) SessionToken() SessionToken {
+	return c.sessionToken
+}
+
+// IsGuest checks if the cart belongs to an anonymous visitor
+func (c *Cart) IsGuest() bool {
+	return c.userID == ""
+}
+
 // Items returns the cart items
 func (c *Cart) Items() []*CartItem {
 	return c.items
 }
 
+// CouponCode returns the code of the coupon applied to the cart, empty if none
+func (c *Cart) CouponCode() string {
+	return c.couponCode
+}
+
 // CreatedAt returns the cart creation time
 func (c *Cart) CreatedAt() time.Time {
 	return c.createdAt
@@ -158,22 +278,27 @@ func (c *Cart) UpdatedAt() time.Time {
 	return c.updatedAt
 }
 
-// AddItem adds an item to the cart
-func (c *Cart) AddItem(productID string, quantity int) error {
+// AddItem adds a product, or one of its variants, to the cart at the unit price the customer was shown
+func (c *Cart) AddItem(productID, variantID string, quantity int, price product.Price) error {
 	if quantity <= 0 {
 		return ErrInvalidQuantity
 	}
 
-	// Check if product already exists in cart
+	if price < 0 {
+		return ErrInvalidPrice
+	}
+
+	// Check if the product variant already exists in cart
 	for _, item := range c.items {
-		if item.productID.String() == productID {
-			// Update quantity
+		if item.matches(productID, variantID) {
+			// Update quantity and the price shown
+			item.price = price
 			return item.IncreaseQuantity(quantity)
 		}
 	}
 
 	// Add new item to cart
-	item, err := NewCartItem(productID, quantity)
+	item, err := NewCartItem(productID, variantID, quantity, price)
 	if err != nil {
 		return err
 	}
@@ -184,9 +309,9 @@ func (c *Cart) AddItem(productID string, quantity int) error {
 }
 
 // RemoveItem removes an item from the cart
-func (c *Cart) RemoveItem(productID string) error {
+func (c *Cart) RemoveItem(productID, variantID string) error {
 	for i, item := range c.items {
-		if item.productID.String() == productID {
+		if item.matches(productID, variantID) {
 			// Remove item from cart
 			c.items = append(c.items[:i], c.items[i+1:]...)
 			c.updatedAt = time.Now()
@@ -197,25 +322,66 @@ func (c *Cart) RemoveItem(productID string) error {
 }
 
 // UpdateItemQuantity updates the quantity of an item in the cart
-func (c *Cart) UpdateItemQuantity(productID string, quantity int) error {
+func (c *Cart) UpdateItemQuantity(productID, variantID string, quantity int) error {
 	if quantity <= 0 {
 		return ErrInvalidQuantity
 	}
 
 	for _, item := range c.items {
-		if item.productID.String() == productID {
+		if item.matches(productID, variantID) {
 			return item.UpdateQuantity(quantity)
 		}
 	}
 	return ErrProductNotFound
 }
 
-// Clear removes all items from the cart
+// RepriceItem records the current unit price of an item once the customer was shown it
+func (c *Cart) RepriceItem(productID, variantID string, price product.Price) error {
+	if price < 0 {
+		return ErrInvalidPrice
+	}
+
+	item, err := c.GetItem(productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	item.price = price
+	item.updatedAt = time.Now()
+	c.updatedAt = item.updatedAt
+	return nil
+}
+
+// ApplyCoupon applies a coupon code to the cart, replacing any previous one
+func (c *Cart) ApplyCoupon(code string) error {
+	if code == "" {
+		return ErrInvalidCoupon
+	}
+
+	c.couponCode = code
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveCoupon removes the coupon applied to the cart
+func (c *Cart) RemoveCoupon() {
+	c.couponCode = ""
+	c.updatedAt = time.Now()
+}
+
+// Clear removes all items and the applied coupon from the cart, and forgets the reminders sent about it
 func (c *Cart) Clear() {
 	c.items = []*CartItem{}
+	c.couponCode = ""
+	c.resetReminders()
 	c.updatedAt = time.Now()
 }
 
+// IsEmpty checks if the cart has no items
+func (c *Cart) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
 // ItemCount returns the number of items in the cart
 func (c *Cart) ItemCount() int {
 	return len(c.items)
@@ -230,22 +396,63 @@ func (c *Cart) TotalItems() int {
 	return total
 }
 
-// HasItem checks if the cart has a specific product
-func (c *Cart) HasItem(productID string) bool {
+// HasItem checks if the cart has a specific product variant
+func (c *Cart) HasItem(productID, variantID string) bool {
 	for _, item := range c.items {
-		if item.productID.String() == productID {
+		if item.matches(productID, variantID) {
 			return true
 		}
 	}
 	return false
 }
 
-// GetItem returns a cart item by product ID
-func (c *Cart) GetItem(productID string) (*CartItem, error) {
+// GetItem returns a cart item by product and variant ID
+func (c *Cart) GetItem(productID, variantID string) (*CartItem, error) {
 	for _, item := range c.items {
-		if item.productID.String() == productID {
+		if item.matches(productID, variantID) {
 			return item, nil
 		}
 	}
 	return nil, ErrProductNotFound
 }
+
+// Claim hands a guest cart over to a user, typically one who just registered;
+// the cart is no longer reachable through its session token
+func (c *Cart) Claim(userID string) error {
+	if !c.IsGuest() {
+		return ErrNotGuestCart
+	}
+
+	userIDVO, err := user.NewID(userID)
+	if err != nil {
+		return ErrInvalidUserID
+	}
+
+	c.userID = userIDVO
+	c.sessionToken = ""
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// Merge combines a guest cart into this cart when its visitor logs in: the quantities of
+// products in both carts are added up, and the guest coupon is kept when this cart has none.
+// The guest cart is emptied.
+func (c *Cart) Merge(guest *Cart) error {
+	if !guest.IsGuest() {
+		return ErrNotGuestCart
+	}
+
+	for _, item := range guest.items {
+		if err := c.AddItem(item.productID.String(), item.variantID.String(), item.quantity, item.price); err != nil {
+			return err
+		}
+	}
+
+	if c.couponCode == "" && guest.couponCode != "" {
+		c.couponCode = guest.couponCode
+	}
+
+	c.updatedAt = time.Now()
+	guest.Clear()
+	return nil
+}
@@ -0,0 +1,134 @@
+package cart
+
+import (
+	"math"
+	"time"
+)
+
+// RemindersSent returns the number of reminders sent about the cart since it was abandoned
+func (c *Cart) RemindersSent() int {
+	return c.remindersSent
+}
+
+// LastRemindedAt returns when the last reminder about the cart was sent, nil when none was
+func (c *Cart) LastRemindedAt() *time.Time {
+	return c.lastRemindedAt
+}
+
+// RecoveryToken returns the token of the link restoring the cart from a reminder, empty before the first reminder
+func (c *Cart) RecoveryToken() RecoveryToken {
+	return c.recoveryToken
+}
+
+// ReminderDue checks if a reminder about the cart is due: it has items and was left untouched for
+// the window, and it was not reminded within the window nor maxReminders times already. Changing the
+// cart after a reminder starts over, so that abandoning it again is reminded again.
+func (c *Cart) ReminderDue(now time.Time, window time.Duration, maxReminders int) bool {
+	if c.IsEmpty() || c.updatedAt.After(now.Add(-window)) {
+		return false
+	}
+
+	if !c.remindedSinceUpdate() {
+		return maxReminders > 0
+	}
+
+	return c.remindersSent < maxReminders && !c.lastRemindedAt.After(now.Add(-window))
+}
+
+// RecordReminder records that a reminder about the cart was sent, returning the token of the
+// link restoring the cart. Reminders do not count as activity on the cart.
+func (c *Cart) RecordReminder(now time.Time) (RecoveryToken, error) {
+	if c.IsEmpty() {
+		return "", ErrEmptyCart
+	}
+
+	if c.recoveryToken == "" {
+		token, err := NewRecoveryToken()
+		if err != nil {
+			return "", err
+		}
+		c.recoveryToken = token
+	}
+
+	if !c.remindedSinceUpdate() {
+		c.remindersSent = 0
+	}
+
+	c.remindersSent++
+	c.lastRemindedAt = &now
+	return c.recoveryToken, nil
+}
+
+// IsExpired checks if the cart was left untouched for longer than the given age
+func (c *Cart) IsExpired(now time.Time, age time.Duration) bool {
+	return c.updatedAt.Before(now.Add(-age))
+}
+
+// remindedSinceUpdate checks if a reminder was sent since the cart last changed
+func (c *Cart) remindedSinceUpdate() bool {
+	return c.lastRemindedAt != nil && c.lastRemindedAt.After(c.updatedAt)
+}
+
+// resetReminders forgets the reminders sent about the cart
+func (c *Cart) resetReminders() {
+	c.remindersSent = 0
+	c.lastRemindedAt = nil
+	c.recoveryToken = ""
+}
+
+// RecoveryStats represents how many abandoned carts were reminded, restored from a reminder
+// and checked out after a reminder over a period
+type RecoveryStats struct {
+	remindersSent int
+	reminded      int
+	restored      int
+	recovered     int
+}
+
+// ReconstructRecoveryStats rebuilds recovery stats from persisted state
+func ReconstructRecoveryStats(remindersSent, reminded, restored, recovered int) RecoveryStats {
+	return RecoveryStats{
+		remindersSent: remindersSent,
+		reminded:      reminded,
+		restored:      restored,
+		recovered:     recovered,
+	}
+}
+
+// RemindersSent returns the number of reminders sent
+func (s RecoveryStats) RemindersSent() int {
+	return s.remindersSent
+}
+
+// Reminded returns the number of carts reminded
+func (s RecoveryStats) Reminded() int {
+	return s.reminded
+}
+
+// Restored returns the number of reminded carts restored through the link of a reminder
+func (s RecoveryStats) Restored() int {
+	return s.restored
+}
+
+// Recovered returns the number of reminded carts checked out afterwards
+func (s RecoveryStats) Recovered() int {
+	return s.recovered
+}
+
+// RestoreRate returns the share of reminded carts restored through a reminder, zero when none was reminded
+func (s RecoveryStats) RestoreRate() float64 {
+	return rate(s.restored, s.reminded)
+}
+
+// RecoveryRate returns the share of reminded carts checked out afterwards, zero when none was reminded
+func (s RecoveryStats) RecoveryRate() float64 {
+	return rate(s.recovered, s.reminded)
+}
+
+// rate returns a share as a fraction rounded to four decimals
+func rate(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(total)*10000) / 10000
+}
@@ -3,6 +3,7 @@ package cart
 import (
 	"context"
 	"e-commerce/internal/domain/user"
+	"time"
 )
 
 // Repository defines the interface for cart persistence operations
@@ -16,9 +17,35 @@ type Repository interface {
 	// FindByUserID retrieves a cart by user ID
 	FindByUserID(ctx context.Context, userID user.ID) (*Cart, error)
 
+	// FindBySessionToken retrieves the guest cart identified by a session token
+	FindBySessionToken(ctx context.Context, token SessionToken) (*Cart, error)
+
 	// Update updates an existing cart
 	Update(ctx context.Context, cart *Cart) error
 
 	// Delete removes a cart from the repository
 	Delete(ctx context.Context, id ID) error
+
+	// FindByRecoveryToken retrieves the cart restored by the link of a reminder
+	FindByRecoveryToken(ctx context.Context, token RecoveryToken) (*Cart, error)
+
+	// FindAbandoned retrieves the user carts with items left untouched since a time, that were not reminded
+	// since then nor maxReminders times already
+	FindAbandoned(ctx context.Context, inactiveSince time.Time, maxReminders int) ([]*Cart, error)
+
+	// DeleteInactive removes the carts left untouched since a time, returning how many were removed
+	DeleteInactive(ctx context.Context, inactiveSince time.Time) (int, error)
+
+	// RecordReminder records that a reminder about a cart was sent to a recipient, saving the reminder
+	// state of the cart without touching its items
+	RecordReminder(ctx context.Context, c *Cart, recipient string, sentAt time.Time) error
+
+	// RecordRestore records that a cart was restored through the link of a reminder
+	RecordRestore(ctx context.Context, id ID, restoredAt time.Time) error
+
+	// RecordRecovery records that a reminded cart was checked out as an order
+	RecordRecovery(ctx context.Context, id ID, orderID string, recoveredAt time.Time) error
+
+	// RecoveryStats counts the carts reminded since a time, and how many of them were restored and recovered
+	RecoveryStats(ctx context.Context, since time.Time) (RecoveryStats, error)
 }
@@ -0,0 +1,131 @@
+package cart
+
+import (
+	"e-commerce/internal/domain/product"
+	"time"
+)
+
+// Warning flags a cart item that no longer matches the live catalog
+type Warning string
+
+const (
+	// WarningPriceChanged flags an item whose unit price differs from the one the customer was shown
+	WarningPriceChanged Warning = "price_changed"
+	// WarningStockReduced flags an item of which only part of the quantity can be ordered
+	WarningStockReduced Warning = "stock_reduced"
+	// WarningUnavailable flags an item that cannot be ordered at all: its product was deleted
+	// or is not for sale, its variant no longer exists, or it is out of stock
+	WarningUnavailable Warning = "unavailable"
+)
+
+// ItemCheck represents the outcome of checking a cart item against the live catalog
+type ItemCheck struct {
+	item      *CartItem
+	product   *product.Product
+	unitPrice product.Price
+	available int
+	warnings  []Warning
+}
+
+// CheckItem checks a cart item against its product, nil when the product was deleted. The product
+// must be for sale and the variant must exist, the quantity must be in stock unless the shortfall
+// can be backordered, and the unit price must match the one the customer was shown.
+func CheckItem(item *CartItem, p *product.Product, now time.Time) *ItemCheck {
+	check := &ItemCheck{item: item, product: p, warnings: []Warning{}}
+
+	if p == nil || !p.IsAvailable(now) {
+		check.warnings = append(check.warnings, WarningUnavailable)
+		return check
+	}
+
+	unitPrice, err := p.UnitPrice(item.variantID)
+	if err != nil {
+		check.warnings = append(check.warnings, WarningUnavailable)
+		return check
+	}
+	check.unitPrice = unitPrice
+
+	shortfall := p.Shortfall(item.variantID, item.quantity)
+	check.available = item.quantity
+	if shortfall > 0 && !p.CanBackorder(item.variantID, shortfall) {
+		check.available = item.quantity - shortfall
+	}
+
+	switch {
+	case check.available == 0:
+		check.warnings = append(check.warnings, WarningUnavailable)
+		return check
+	case check.available < item.quantity:
+		check.warnings = append(check.warnings, WarningStockReduced)
+	}
+
+	if item.price > 0 && item.price != unitPrice {
+		check.warnings = append(check.warnings, WarningPriceChanged)
+	}
+
+	return check
+}
+
+// Item returns the checked item
+func (ic *ItemCheck) Item() *CartItem {
+	return ic.item
+}
+
+// Product returns the product of the item, nil when it was deleted
+func (ic *ItemCheck) Product() *product.Product {
+	return ic.product
+}
+
+// UnitPrice returns the current unit price of the item, zero when it is unavailable
+func (ic *ItemCheck) UnitPrice() product.Price {
+	return ic.unitPrice
+}
+
+// Available returns the quantity of the item that can be ordered
+func (ic *ItemCheck) Available() int {
+	return ic.available
+}
+
+// Warnings returns the warnings raised for the item, empty when it can be ordered as it is
+func (ic *ItemCheck) Warnings() []Warning {
+	return ic.warnings
+}
+
+// IsOrderable checks if at least part of the item can be ordered
+func (ic *ItemCheck) IsOrderable() bool {
+	return ic.available > 0
+}
+
+// Adjust brings the cart in line with the checks of its items: unavailable items are removed,
+// quantities are reduced to what can be ordered and current prices are accepted.
+// It returns whether the cart changed.
+func (c *Cart) Adjust(checks []*ItemCheck) bool {
+	changed := false
+	for _, check := range checks {
+		if len(check.warnings) == 0 {
+			continue
+		}
+
+		productID, variantID := check.item.productID.String(), check.item.variantID.String()
+		if !check.IsOrderable() {
+			if err := c.RemoveItem(productID, variantID); err == nil {
+				changed = true
+			}
+			continue
+		}
+
+		if err := c.UpdateItemQuantity(productID, variantID, check.available); err != nil {
+			continue
+		}
+
+		if err := c.RepriceItem(productID, variantID, check.unitPrice); err != nil {
+			continue
+		}
+		changed = true
+	}
+
+	if changed {
+		c.updatedAt = time.Now()
+	}
+	return changed
+}
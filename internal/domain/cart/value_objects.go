@@ -1,6 +1,8 @@
 package cart
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"strings"
 )
@@ -20,3 +22,37 @@ func NewID(id string) (ID, error) {
 func (id ID) String() string {
 	return string(id)
 }
+
+// SessionToken represents the unguessable token identifying the cart of an anonymous visitor
+type SessionToken string
+
+// NewSessionToken generates a new random SessionToken
+func NewSessionToken() (SessionToken, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return SessionToken(hex.EncodeToString(b)), nil
+}
+
+// String returns the string representation of the SessionToken
+func (t SessionToken) String() string {
+	return string(t)
+}
+
+// RecoveryToken represents the unguessable token in the link restoring an abandoned cart from a reminder
+type RecoveryToken string
+
+// NewRecoveryToken generates a new random RecoveryToken
+func NewRecoveryToken() (RecoveryToken, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return RecoveryToken(hex.EncodeToString(b)), nil
+}
+
+// String returns the string representation of the RecoveryToken
+func (t RecoveryToken) String() string {
+	return string(t)
+}
@@ -0,0 +1,168 @@
+package category
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category errors
+var (
+	ErrInvalidName      = errors.New("category name cannot be empty")
+	ErrInvalidSlug      = errors.New("category slug must contain only lowercase letters, digits and dashes")
+	ErrInvalidParent    = errors.New("category cannot be moved under itself or one of its descendants")
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrHasChildren      = errors.New("category has subcategories")
+	ErrSlugTaken        = errors.New("category slug already in use")
+)
+
+// Category represents a node of the product taxonomy
+type Category struct {
+	id          ID
+	name        string
+	slug        Slug
+	description string
+	parentID    ID
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewCategory creates a new category under a parent, or a root category when
+// parentID is empty. The slug is derived from the name when empty.
+func NewCategory(name, slug, description string, parentID ID) (*Category, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+
+	if slug == "" {
+		slug = Slugify(name)
+	}
+
+	slugVO, err := NewSlug(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Category{
+		id:          id,
+		name:        name,
+		slug:        slugVO,
+		description: description,
+		parentID:    parentID,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// Reconstruct rebuilds a category from persisted state
+func Reconstruct(id ID, name string, slug Slug, description string, parentID ID, createdAt, updatedAt time.Time) *Category {
+	return &Category{
+		id:          id,
+		name:        name,
+		slug:        slug,
+		description: description,
+		parentID:    parentID,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// ID returns the category ID
+func (c *Category) ID() ID {
+	return c.id
+}
+
+// Name returns the category name
+func (c *Category) Name() string {
+	return c.name
+}
+
+// Slug returns the category slug
+func (c *Category) Slug() Slug {
+	return c.slug
+}
+
+// Description returns the category description
+func (c *Category) Description() string {
+	return c.description
+}
+
+// ParentID returns the parent category ID, empty for a root category
+func (c *Category) ParentID() ID {
+	return c.parentID
+}
+
+// IsRoot returns whether the category has no parent
+func (c *Category) IsRoot() bool {
+	return c.parentID == ""
+}
+
+// CreatedAt returns when the category was created
+func (c *Category) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns when the category was last updated
+func (c *Category) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// ChangeName changes the category name
+func (c *Category) ChangeName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidName
+	}
+
+	c.name = name
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeSlug changes the category slug
+func (c *Category) ChangeSlug(slug string) error {
+	slugVO, err := NewSlug(slug)
+	if err != nil {
+		return err
+	}
+
+	c.slug = slugVO
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeDescription changes the category description
+func (c *Category) ChangeDescription(description string) {
+	c.description = description
+	c.updatedAt = time.Now()
+}
+
+// MoveTo moves the category under another parent, or to the root when parentID is empty.
+// The tree is used to reject moves that would create a cycle.
+func (c *Category) MoveTo(parentID ID, tree *Tree) error {
+	if parentID != "" {
+		if _, ok := tree.Find(parentID); !ok {
+			return ErrCategoryNotFound
+		}
+
+		for _, descendantID := range tree.DescendantIDs(c.id) {
+			if descendantID == parentID {
+				return ErrInvalidParent
+			}
+		}
+	}
+
+	c.parentID = parentID
+	c.updatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,26 @@
+package category
+
+import (
+	"context"
+)
+
+// Repository defines the interface for category persistence operations
+type Repository interface {
+	// Save persists a category to the repository
+	Save(ctx context.Context, category *Category) error
+
+	// FindByID retrieves a category by ID
+	FindByID(ctx context.Context, id ID) (*Category, error)
+
+	// FindBySlug retrieves a category by slug
+	FindBySlug(ctx context.Context, slug Slug) (*Category, error)
+
+	// Update updates an existing category
+	Update(ctx context.Context, category *Category) error
+
+	// Delete removes a category from the repository
+	Delete(ctx context.Context, id ID) error
+
+	// List retrieves every category of the taxonomy
+	List(ctx context.Context) ([]*Category, error)
+}
@@ -0,0 +1,90 @@
+package category
+
+import "sort"
+
+// Tree indexes a set of categories by parent to answer hierarchy questions
+type Tree struct {
+	byID     map[ID]*Category
+	children map[ID][]*Category
+}
+
+// NewTree builds a tree from all categories of the taxonomy
+func NewTree(categories []*Category) *Tree {
+	tree := &Tree{
+		byID:     make(map[ID]*Category, len(categories)),
+		children: make(map[ID][]*Category),
+	}
+
+	for _, c := range categories {
+		tree.byID[c.id] = c
+	}
+
+	for _, c := range categories {
+		parentID := c.parentID
+		if _, ok := tree.byID[parentID]; !ok {
+			parentID = ""
+		}
+		tree.children[parentID] = append(tree.children[parentID], c)
+	}
+
+	for _, siblings := range tree.children {
+		sort.Slice(siblings, func(i, j int) bool {
+			return siblings[i].name < siblings[j].name
+		})
+	}
+
+	return tree
+}
+
+// Find returns a category by ID
+func (t *Tree) Find(id ID) (*Category, bool) {
+	c, ok := t.byID[id]
+	return c, ok
+}
+
+// Roots returns the top-level categories
+func (t *Tree) Roots() []*Category {
+	return t.children[""]
+}
+
+// Children returns the direct subcategories of a category
+func (t *Tree) Children(id ID) []*Category {
+	return t.children[id]
+}
+
+// Path returns the breadcrumb of a category, from its root down to the category itself
+func (t *Tree) Path(id ID) []*Category {
+	var path []*Category
+	seen := make(map[ID]bool)
+	for c, ok := t.byID[id]; ok && !seen[c.id]; c, ok = t.byID[c.parentID] {
+		seen[c.id] = true
+		path = append([]*Category{c}, path...)
+	}
+	return path
+}
+
+// DescendantIDs returns the ID of a category and of every category below it
+func (t *Tree) DescendantIDs(id ID) []ID {
+	ids := []ID{id}
+	for i := 0; i < len(ids); i++ {
+		for _, child := range t.children[ids[i]] {
+			ids = append(ids, child.id)
+		}
+	}
+	return ids
+}
+
+// AncestorIDs returns the IDs of the given categories and of all their ancestors, without duplicates
+func (t *Tree) AncestorIDs(ids []ID) []ID {
+	seen := make(map[ID]bool)
+	var result []ID
+	for _, id := range ids {
+		for _, c := range t.Path(id) {
+			if !seen[c.id] {
+				seen[c.id] = true
+				result = append(result, c.id)
+			}
+		}
+	}
+	return result
+}
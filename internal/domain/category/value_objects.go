@@ -0,0 +1,58 @@
+package category
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// ID represents a category ID value object
+type ID string
+
+// NewID creates a new category ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", errors.New("category ID cannot be empty")
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the category ID
+func (id ID) String() string {
+	return string(id)
+}
+
+// Slug represents the URL-friendly identifier of a category
+type Slug string
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// NewSlug creates a new Slug
+func NewSlug(slug string) (Slug, error) {
+	if len(slug) > 100 || !slugPattern.MatchString(slug) {
+		return "", ErrInvalidSlug
+	}
+	return Slug(slug), nil
+}
+
+// Slugify derives a slug from a category name
+func Slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			dash = false
+		case !dash && b.Len() > 0:
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// String returns the string representation of the Slug
+func (s Slug) String() string {
+	return string(s)
+}
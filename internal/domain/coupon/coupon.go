@@ -0,0 +1,428 @@
+package coupon
+
+import (
+	"e-commerce/internal/domain/product"
+	"e-commerce/pkg/money"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Coupon errors
+var (
+	ErrInvalidCode        = errors.New("invalid coupon code")
+	ErrInvalidType        = errors.New("invalid coupon type")
+	ErrInvalidValue       = errors.New("invalid coupon value")
+	ErrInvalidValidity    = errors.New("invalid coupon validity window")
+	ErrInvalidLimit       = errors.New("invalid coupon usage limit")
+	ErrCouponInactive     = errors.New("coupon is not active")
+	ErrCouponNotStarted   = errors.New("coupon is not valid yet")
+	ErrCouponExpired      = errors.New("coupon has expired")
+	ErrUsageLimitReached  = errors.New("coupon usage limit reached")
+	ErrUserLimitReached   = errors.New("coupon already used the maximum number of times by this user")
+	ErrMinimumNotMet      = errors.New("order does not reach the coupon minimum value")
+	ErrNoEligibleProducts = errors.New("no products in the order are eligible for the coupon")
+)
+
+// Coupon represents the coupon aggregate root
+type Coupon struct {
+	id            ID
+	code          Code
+	couponType    Type
+	value         float64
+	buyQuantity   int
+	getQuantity   int
+	minOrderValue float64
+	validFrom     time.Time
+	validUntil    time.Time
+	usageLimit    int
+	perUserLimit  int
+	timesUsed     int
+	productIDs    []product.ID
+	categoryIDs   []string
+	active        bool
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewCoupon creates a new active coupon.
+// value is a percentage (0-100] for percentage coupons and an amount for
+// fixed-amount coupons; buy-X-get-Y coupons use buyQuantity and getQuantity.
+func NewCoupon(code, couponType string, value float64, buyQuantity, getQuantity int) (*Coupon, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	codeVO, err := NewCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	typeVO, err := NewType(couponType)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typeVO {
+	case TypePercentage:
+		if value <= 0 || value > 100 {
+			return nil, ErrInvalidValue
+		}
+	case TypeFixedAmount:
+		if value <= 0 {
+			return nil, ErrInvalidValue
+		}
+	case TypeBuyXGetY:
+		if buyQuantity <= 0 || getQuantity <= 0 {
+			return nil, ErrInvalidValue
+		}
+	}
+
+	now := time.Now()
+
+	return &Coupon{
+		id:          id,
+		code:        codeVO,
+		couponType:  typeVO,
+		value:       value,
+		buyQuantity: buyQuantity,
+		getQuantity: getQuantity,
+		productIDs:  []product.ID{},
+		categoryIDs: []string{},
+		active:      true,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// Reconstruct rebuilds a coupon from persisted state
+func Reconstruct(
+	id ID,
+	code Code,
+	couponType Type,
+	value float64,
+	buyQuantity int,
+	getQuantity int,
+	minOrderValue float64,
+	validFrom time.Time,
+	validUntil time.Time,
+	usageLimit int,
+	perUserLimit int,
+	timesUsed int,
+	productIDs []product.ID,
+	categoryIDs []string,
+	active bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Coupon {
+	return &Coupon{
+		id:            id,
+		code:          code,
+		couponType:    couponType,
+		value:         value,
+		buyQuantity:   buyQuantity,
+		getQuantity:   getQuantity,
+		minOrderValue: minOrderValue,
+		validFrom:     validFrom,
+		validUntil:    validUntil,
+		usageLimit:    usageLimit,
+		perUserLimit:  perUserLimit,
+		timesUsed:     timesUsed,
+		productIDs:    productIDs,
+		categoryIDs:   categoryIDs,
+		active:        active,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// ID returns the coupon ID
+func (c *Coupon) ID() ID {
+	return c.id
+}
+
+// Code returns the coupon code
+func (c *Coupon) Code() Code {
+	return c.code
+}
+
+// Type returns the coupon type
+func (c *Coupon) Type() Type {
+	return c.couponType
+}
+
+// Value returns the percentage or amount of the coupon
+func (c *Coupon) Value() float64 {
+	return c.value
+}
+
+// BuyQuantity returns the quantity to buy for buy-X-get-Y coupons
+func (c *Coupon) BuyQuantity() int {
+	return c.buyQuantity
+}
+
+// GetQuantity returns the quantity received free for buy-X-get-Y coupons
+func (c *Coupon) GetQuantity() int {
+	return c.getQuantity
+}
+
+// MinOrderValue returns the minimum subtotal required to use the coupon
+func (c *Coupon) MinOrderValue() float64 {
+	return c.minOrderValue
+}
+
+// ValidFrom returns the start of the validity window, zero when open
+func (c *Coupon) ValidFrom() time.Time {
+	return c.validFrom
+}
+
+// ValidUntil returns the end of the validity window, zero when open
+func (c *Coupon) ValidUntil() time.Time {
+	return c.validUntil
+}
+
+// UsageLimit returns the maximum number of redemptions, zero for unlimited
+func (c *Coupon) UsageLimit() int {
+	return c.usageLimit
+}
+
+// PerUserLimit returns the maximum number of redemptions per user, zero for unlimited
+func (c *Coupon) PerUserLimit() int {
+	return c.perUserLimit
+}
+
+// TimesUsed returns the number of redemptions so far
+func (c *Coupon) TimesUsed() int {
+	return c.timesUsed
+}
+
+// ProductIDs returns the eligible products, empty when every product is eligible
+func (c *Coupon) ProductIDs() []product.ID {
+	return c.productIDs
+}
+
+// CategoryIDs returns the eligible categories, empty when every category is eligible
+func (c *Coupon) CategoryIDs() []string {
+	return c.categoryIDs
+}
+
+// IsActive checks if the coupon is active
+func (c *Coupon) IsActive() bool {
+	return c.active
+}
+
+// CreatedAt returns the coupon creation time
+func (c *Coupon) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns the coupon last update time
+func (c *Coupon) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// ChangeValidity changes the validity window; zero times leave that side open
+func (c *Coupon) ChangeValidity(validFrom, validUntil time.Time) error {
+	if !validFrom.IsZero() && !validUntil.IsZero() && !validUntil.After(validFrom) {
+		return ErrInvalidValidity
+	}
+
+	c.validFrom = validFrom
+	c.validUntil = validUntil
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeUsageLimits changes the global and per-user redemption limits; zero means unlimited
+func (c *Coupon) ChangeUsageLimits(usageLimit, perUserLimit int) error {
+	if usageLimit < 0 || perUserLimit < 0 {
+		return ErrInvalidLimit
+	}
+
+	c.usageLimit = usageLimit
+	c.perUserLimit = perUserLimit
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeMinOrderValue changes the minimum subtotal required to use the coupon
+func (c *Coupon) ChangeMinOrderValue(minOrderValue float64) error {
+	if minOrderValue < 0 {
+		return ErrInvalidValue
+	}
+
+	c.minOrderValue = minOrderValue
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeEligibility restricts the coupon to products and categories.
+// A line is eligible when it matches either list; empty lists make every line eligible.
+func (c *Coupon) ChangeEligibility(productIDs, categoryIDs []string) error {
+	products := make([]product.ID, 0, len(productIDs))
+	for _, productID := range productIDs {
+		id, err := product.NewID(productID)
+		if err != nil {
+			return err
+		}
+		products = append(products, id)
+	}
+
+	categories := make([]string, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		if categoryID == "" {
+			return ErrInvalidValue
+		}
+		categories = append(categories, categoryID)
+	}
+
+	c.productIDs = products
+	c.categoryIDs = categories
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// Activate makes the coupon redeemable
+func (c *Coupon) Activate() {
+	c.active = true
+	c.updatedAt = time.Now()
+}
+
+// Deactivate stops the coupon from being redeemed
+func (c *Coupon) Deactivate() {
+	c.active = false
+	c.updatedAt = time.Now()
+}
+
+// CheckUsable checks that the coupon is active, within its validity window
+// and below its usage limits for a user with the given number of redemptions
+func (c *Coupon) CheckUsable(userRedemptions int, now time.Time) error {
+	if !c.active {
+		return ErrCouponInactive
+	}
+
+	if !c.validFrom.IsZero() && now.Before(c.validFrom) {
+		return ErrCouponNotStarted
+	}
+
+	if !c.validUntil.IsZero() && !now.Before(c.validUntil) {
+		return ErrCouponExpired
+	}
+
+	if c.usageLimit > 0 && c.timesUsed >= c.usageLimit {
+		return ErrUsageLimitReached
+	}
+
+	if c.perUserLimit > 0 && userRedemptions >= c.perUserLimit {
+		return ErrUserLimitReached
+	}
+
+	return nil
+}
+
+// IsEligible checks if a line can be discounted by the coupon
+func (c *Coupon) IsEligible(line Line) bool {
+	if len(c.productIDs) == 0 && len(c.categoryIDs) == 0 {
+		return true
+	}
+
+	for _, id := range c.productIDs {
+		if id == line.ProductID {
+			return true
+		}
+	}
+
+	for _, categoryID := range c.categoryIDs {
+		for _, lineCategoryID := range line.CategoryIDs {
+			if categoryID == lineCategoryID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Evaluate returns the discount the coupon grants on lines and a shipping cost
+func (c *Coupon) Evaluate(lines []Line, shippingCost float64, userRedemptions int, now time.Time) (*Result, error) {
+	if err := c.CheckUsable(userRedemptions, now); err != nil {
+		return nil, err
+	}
+
+	subtotal := 0.0
+	eligibleTotal := 0.0
+	eligible := make([]bool, len(lines))
+	for i, line := range lines {
+		subtotal += line.Total()
+		if c.IsEligible(line) {
+			eligible[i] = true
+			eligibleTotal += line.Total()
+		}
+	}
+
+	if subtotal < c.minOrderValue {
+		return nil, ErrMinimumNotMet
+	}
+
+	if eligibleTotal == 0 {
+		return nil, ErrNoEligibleProducts
+	}
+
+	result := &Result{LineDiscounts: make([]float64, len(lines))}
+
+	switch c.couponType {
+	case TypePercentage:
+		for i, line := range lines {
+			if eligible[i] {
+				result.LineDiscounts[i] = money.Round(line.Total() * c.value / 100)
+			}
+		}
+	case TypeFixedAmount:
+		// Spread the amount over eligible lines in proportion to their totals
+		amount := math.Min(c.value, eligibleTotal)
+		remaining := amount
+		last := -1
+		for i, line := range lines {
+			if eligible[i] {
+				result.LineDiscounts[i] = money.Round(amount * line.Total() / eligibleTotal)
+				remaining -= result.LineDiscounts[i]
+				last = i
+			}
+		}
+		result.LineDiscounts[last] = money.Round(result.LineDiscounts[last] + remaining)
+	case TypeFreeShipping:
+		result.ShippingDiscount = shippingCost
+	case TypeBuyXGetY:
+		// Every group of buy+get units of the same product gets the last units free
+		groupSize := c.buyQuantity + c.getQuantity
+		discounted := false
+		for i, line := range lines {
+			if eligible[i] {
+				freeUnits := (line.Quantity / groupSize) * c.getQuantity
+				if freeUnits > 0 {
+					result.LineDiscounts[i] = money.Round(float64(freeUnits) * line.UnitPrice)
+					discounted = true
+				}
+			}
+		}
+		if !discounted {
+			return nil, ErrNoEligibleProducts
+		}
+	}
+
+	return result, nil
+}
+
+// Redeem records one use of the coupon
+func (c *Coupon) Redeem(userRedemptions int, now time.Time) error {
+	if err := c.CheckUsable(userRedemptions, now); err != nil {
+		return err
+	}
+
+	c.timesUsed++
+	c.updatedAt = now
+	return nil
+}
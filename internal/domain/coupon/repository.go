@@ -0,0 +1,34 @@
+package coupon
+
+import (
+	"context"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/user"
+)
+
+// Repository defines the interface for coupon persistence operations
+type Repository interface {
+	// Save persists a coupon to the repository
+	Save(ctx context.Context, coupon *Coupon) error
+
+	// FindByID retrieves a coupon by ID
+	FindByID(ctx context.Context, id ID) (*Coupon, error)
+
+	// FindByCode retrieves a coupon by code
+	FindByCode(ctx context.Context, code Code) (*Coupon, error)
+
+	// Update updates an existing coupon
+	Update(ctx context.Context, coupon *Coupon) error
+
+	// Delete removes a coupon from the repository
+	Delete(ctx context.Context, id ID) error
+
+	// List retrieves all coupons with pagination
+	List(ctx context.Context, limit, offset int) ([]*Coupon, error)
+
+	// CountRedemptionsByUser counts how many times a user redeemed a coupon
+	CountRedemptionsByUser(ctx context.Context, id ID, userID user.ID) (int, error)
+
+	// RecordRedemption records that a user, or a guest when userID is empty, redeemed a coupon on an order
+	RecordRedemption(ctx context.Context, id ID, userID user.ID, orderID order.ID) error
+}
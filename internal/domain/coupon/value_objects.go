@@ -0,0 +1,93 @@
+package coupon
+
+import (
+	"e-commerce/internal/domain/product"
+	"errors"
+	"strings"
+)
+
+// ID represents a coupon ID value object
+type ID string
+
+// NewID creates a new coupon ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", errors.New("coupon ID cannot be empty")
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the coupon ID
+func (id ID) String() string {
+	return string(id)
+}
+
+// Code represents the code customers enter to redeem a coupon
+type Code string
+
+// NewCode creates a new Code
+func NewCode(code string) (Code, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if len(normalized) < 3 || len(normalized) > 50 {
+		return "", ErrInvalidCode
+	}
+	return Code(normalized), nil
+}
+
+// String returns the string representation of the Code
+func (c Code) String() string {
+	return string(c)
+}
+
+// Type represents how a coupon discounts an order
+type Type string
+
+const (
+	TypePercentage   Type = "percentage"
+	TypeFixedAmount  Type = "fixed_amount"
+	TypeFreeShipping Type = "free_shipping"
+	TypeBuyXGetY     Type = "buy_x_get_y"
+)
+
+// NewType creates a new Type
+func NewType(couponType string) (Type, error) {
+	switch Type(couponType) {
+	case TypePercentage, TypeFixedAmount, TypeFreeShipping, TypeBuyXGetY:
+		return Type(couponType), nil
+	}
+	return "", ErrInvalidType
+}
+
+// String returns the string representation of the Type
+func (t Type) String() string {
+	return string(t)
+}
+
+// Line represents a priced cart line a coupon is evaluated against
+type Line struct {
+	ProductID   product.ID
+	CategoryIDs []string
+	UnitPrice   float64
+	Quantity    int
+}
+
+// Total returns the line total before discounts
+func (l Line) Total() float64 {
+	return l.UnitPrice * float64(l.Quantity)
+}
+
+// Result represents the discount a coupon grants on a set of lines
+type Result struct {
+	// LineDiscounts holds the discount of each line, in the order of the evaluated lines
+	LineDiscounts    []float64
+	ShippingDiscount float64
+}
+
+// Total returns the total discount including shipping
+func (r *Result) Total() float64 {
+	total := r.ShippingDiscount
+	for _, discount := range r.LineDiscounts {
+		total += discount
+	}
+	return total
+}
@@ -0,0 +1,234 @@
+package importjob
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Import job errors
+var (
+	ErrInvalidID         = errors.New("invalid import job ID")
+	ErrInvalidFormat     = errors.New("invalid import format, expected csv or ndjson")
+	ErrInvalidTransition = errors.New("invalid import job status transition")
+	ErrJobNotFound       = errors.New("import job not found")
+	ErrNoPendingJob      = errors.New("no pending import job")
+)
+
+// MaxRowErrors is the number of row errors kept on a job; further failed rows are only counted
+const MaxRowErrors = 1000
+
+// Job represents the import of a catalog file, processed in the background
+type Job struct {
+	id         ID
+	format     Format
+	fileName   string
+	status     Status
+	created    int
+	updated    int
+	failed     int
+	rowErrors  []RowError
+	failure    string
+	createdAt  time.Time
+	startedAt  *time.Time
+	finishedAt *time.Time
+	updatedAt  time.Time
+}
+
+// NewJob creates a new pending import job for an uploaded file
+func NewJob(format, fileName string) (*Job, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	formatVO, err := NewFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Job{
+		id:        id,
+		format:    formatVO,
+		fileName:  fileName,
+		status:    StatusPending,
+		rowErrors: []RowError{},
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// Reconstruct rebuilds an import job from persisted state
+func Reconstruct(
+	id ID,
+	format Format,
+	fileName string,
+	status Status,
+	created int,
+	updated int,
+	failed int,
+	rowErrors []RowError,
+	failure string,
+	createdAt time.Time,
+	startedAt *time.Time,
+	finishedAt *time.Time,
+	updatedAt time.Time,
+) *Job {
+	return &Job{
+		id:         id,
+		format:     format,
+		fileName:   fileName,
+		status:     status,
+		created:    created,
+		updated:    updated,
+		failed:     failed,
+		rowErrors:  rowErrors,
+		failure:    failure,
+		createdAt:  createdAt,
+		startedAt:  startedAt,
+		finishedAt: finishedAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// ID returns the job ID
+func (j *Job) ID() ID {
+	return j.id
+}
+
+// Format returns the format of the imported file
+func (j *Job) Format() Format {
+	return j.format
+}
+
+// FileName returns the name of the uploaded file
+func (j *Job) FileName() string {
+	return j.fileName
+}
+
+// FileKey returns the blob key the uploaded file is stored under
+func (j *Job) FileKey() string {
+	return fmt.Sprintf("imports/%s.%s", j.id, j.format)
+}
+
+// Status returns the job status
+func (j *Job) Status() Status {
+	return j.status
+}
+
+// Processed returns the number of rows processed so far
+func (j *Job) Processed() int {
+	return j.created + j.updated + j.failed
+}
+
+// Created returns the number of products created
+func (j *Job) Created() int {
+	return j.created
+}
+
+// Updated returns the number of products and variants updated
+func (j *Job) Updated() int {
+	return j.updated
+}
+
+// Failed returns the number of rows that could not be applied
+func (j *Job) Failed() int {
+	return j.failed
+}
+
+// RowErrors returns the errors of the failed rows, up to MaxRowErrors
+func (j *Job) RowErrors() []RowError {
+	return j.rowErrors
+}
+
+// Failure returns why the whole job failed, such as an unreadable file
+func (j *Job) Failure() string {
+	return j.failure
+}
+
+// CreatedAt returns when the file was uploaded
+func (j *Job) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+// StartedAt returns when processing started, or nil while pending
+func (j *Job) StartedAt() *time.Time {
+	return j.startedAt
+}
+
+// FinishedAt returns when processing finished, or nil until then
+func (j *Job) FinishedAt() *time.Time {
+	return j.finishedAt
+}
+
+// UpdatedAt returns when the job progress was last recorded
+func (j *Job) UpdatedAt() time.Time {
+	return j.updatedAt
+}
+
+// Start starts processing the job. A running job whose worker stopped is started over;
+// rows are upserted by SKU, so applying them again is harmless.
+func (j *Job) Start(now time.Time) error {
+	if j.status != StatusPending && j.status != StatusRunning {
+		return ErrInvalidTransition
+	}
+
+	j.status = StatusRunning
+	j.created = 0
+	j.updated = 0
+	j.failed = 0
+	j.rowErrors = []RowError{}
+	j.startedAt = &now
+	j.updatedAt = now
+	return nil
+}
+
+// RecordCreated counts a row that created a product
+func (j *Job) RecordCreated() {
+	j.created++
+	j.updatedAt = time.Now()
+}
+
+// RecordUpdated counts a row that updated a product or variant
+func (j *Job) RecordUpdated() {
+	j.updated++
+	j.updatedAt = time.Now()
+}
+
+// RecordRowError counts a row that could not be applied, keeping its error up to MaxRowErrors
+func (j *Job) RecordRowError(row int, sku, message string) {
+	j.failed++
+	if len(j.rowErrors) < MaxRowErrors {
+		j.rowErrors = append(j.rowErrors, RowError{row: row, sku: sku, message: message})
+	}
+	j.updatedAt = time.Now()
+}
+
+// Complete marks the job as completed once every row was processed
+func (j *Job) Complete(now time.Time) error {
+	if j.status != StatusRunning {
+		return ErrInvalidTransition
+	}
+
+	j.status = StatusCompleted
+	j.finishedAt = &now
+	j.updatedAt = now
+	return nil
+}
+
+// Fail marks the job as failed when the file could not be processed
+func (j *Job) Fail(now time.Time, reason string) error {
+	if j.status != StatusRunning {
+		return ErrInvalidTransition
+	}
+
+	j.status = StatusFailed
+	j.failure = reason
+	j.finishedAt = &now
+	j.updatedAt = now
+	return nil
+}
@@ -0,0 +1,23 @@
+package importjob
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for import job persistence operations
+type Repository interface {
+	// Save persists an import job to the repository
+	Save(ctx context.Context, job *Job) error
+
+	// FindByID retrieves an import job by ID
+	FindByID(ctx context.Context, id ID) (*Job, error)
+
+	// Update updates an existing import job
+	Update(ctx context.Context, job *Job) error
+
+	// ClaimNext marks the oldest pending job as running and returns it, so no other worker
+	// picks it up. Running jobs not updated since staleBefore, whose worker stopped,
+	// are claimed again. It returns ErrNoPendingJob when there is nothing to run.
+	ClaimNext(ctx context.Context, now, staleBefore time.Time) (*Job, error)
+}
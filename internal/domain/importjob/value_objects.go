@@ -0,0 +1,87 @@
+package importjob
+
+import (
+	"strings"
+)
+
+// ID represents an import job ID value object
+type ID string
+
+// NewID creates a new import job ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", ErrInvalidID
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the import job ID
+func (id ID) String() string {
+	return string(id)
+}
+
+// Format represents the file format of an import
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+// NewFormat creates a new Format; "json" and "jsonl" are accepted for NDJSON
+func NewFormat(format string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "csv":
+		return FormatCSV, nil
+	case "ndjson", "jsonl", "json":
+		return FormatNDJSON, nil
+	}
+	return "", ErrInvalidFormat
+}
+
+// String returns the string representation of the Format
+func (f Format) String() string {
+	return string(f)
+}
+
+// Status represents the progress of an import job
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+// String returns the string representation of the Status
+func (s Status) String() string {
+	return string(s)
+}
+
+// RowError represents a row of an import that could not be applied
+type RowError struct {
+	row     int
+	sku     string
+	message string
+}
+
+// ReconstructRowError rebuilds a row error from persisted state
+func ReconstructRowError(row int, sku, message string) RowError {
+	return RowError{row: row, sku: sku, message: message}
+}
+
+// Row returns the 1-based number of the data row in the file
+func (e RowError) Row() int {
+	return e.row
+}
+
+// SKU returns the SKU of the row, empty when it could not be read
+func (e RowError) SKU() string {
+	return e.sku
+}
+
+// Message returns why the row could not be applied
+func (e RowError) Message() string {
+	return e.message
+}
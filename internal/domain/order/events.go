@@ -0,0 +1,50 @@
+package order
+
+import "time"
+
+// Event names
+const (
+	EventPlaced        = "order.placed"
+	EventStatusChanged = "order.status_changed"
+)
+
+// Event represents a change to an order
+type Event struct {
+	name           string
+	orderID        ID
+	status         Status
+	previousStatus Status
+	occurredAt     time.Time
+}
+
+// Name returns the event name
+func (e Event) Name() string {
+	return e.name
+}
+
+// OrderID returns the ID of the changed order
+func (e Event) OrderID() ID {
+	return e.orderID
+}
+
+// Status returns the status of the order after the change
+func (e Event) Status() Status {
+	return e.status
+}
+
+// PreviousStatus returns the status the order changed from, empty when it was placed
+func (e Event) PreviousStatus() Status {
+	return e.previousStatus
+}
+
+// OccurredAt returns when the change happened
+func (e Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// PullEvents returns the events recorded since the order was loaded and clears them
+func (o *Order) PullEvents() []Event {
+	events := o.events
+	o.events = nil
+	return events
+}
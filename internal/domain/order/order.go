@@ -1,6 +1,7 @@
 package order
 
 import (
+	"e-commerce/internal/domain/address"
 	"e-commerce/internal/domain/product"
 	"e-commerce/internal/domain/user"
 	"errors"
@@ -22,6 +23,14 @@ var (
 	ErrInvalidQuantity        = errors.New("invalid quantity")
 	ErrInvalidPrice           = errors.New("invalid price")
 	ErrItemNotFound           = errors.New("item not found in order")
+	ErrInvalidShippingMethod  = errors.New("invalid shipping method")
+	ErrInvalidShippingCost    = errors.New("invalid shipping cost")
+	ErrInvalidTaxLine         = errors.New("invalid tax line")
+	ErrInvalidDiscount        = errors.New("invalid discount")
+	ErrInvalidAllocation      = errors.New("invalid stock allocation")
+	ErrInvalidBackorder       = errors.New("invalid backordered quantity")
+	ErrInvalidGuestEmail      = errors.New("invalid guest email")
+	ErrInvalidTransition      = errors.New("order cannot change to this status")
 )
 
 // Status represents the status of an order
@@ -37,16 +46,23 @@ const (
 
 // OrderItem represents an item in an order
 type OrderItem struct {
-	id        ID
-	productID product.ID
-	quantity  int
-	price     float64
-	createdAt time.Time
-	updatedAt time.Time
-}
-
-// NewOrderItem creates a new order item
-func NewOrderItem(productID string, quantity int, price float64) (*OrderItem, error) {
+	id          ID
+	productID   product.ID
+	variantID   product.VariantID
+	sku         string
+	quantity    int
+	price       float64
+	discount    float64
+	taxLines    []TaxLine
+	allocations []Allocation
+	backordered int
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewOrderItem creates a new order item with its discount and the taxes charged on it.
+// variantID and sku identify the purchased variant and are empty for products without variants.
+func NewOrderItem(productID, variantID, sku string, quantity int, price, discount float64, taxLines ...TaxLine) (*OrderItem, error) {
 	id, err := NewID(uuid.New().String())
 	if err != nil {
 		return nil, err
@@ -65,18 +81,58 @@ func NewOrderItem(productID string, quantity int, price float64) (*OrderItem, er
 		return nil, ErrInvalidPrice
 	}
 
+	if discount < 0 || discount > price*float64(quantity) {
+		return nil, ErrInvalidDiscount
+	}
+
 	now := time.Now()
 
 	return &OrderItem{
-		id:        id,
-		productID: productIDVO,
-		quantity:  quantity,
-		price:     price,
-		createdAt: now,
-		updatedAt: now,
+		id:          id,
+		productID:   productIDVO,
+		variantID:   product.VariantID(variantID),
+		sku:         sku,
+		quantity:    quantity,
+		price:       price,
+		discount:    discount,
+		taxLines:    taxLines,
+		allocations: []Allocation{},
+		createdAt:   now,
+		updatedAt:   now,
 	}, nil
 }
 
+// ReconstructOrderItem rebuilds an order item from persisted state
+func ReconstructOrderItem(
+	id ID,
+	productID product.ID,
+	variantID product.VariantID,
+	sku string,
+	quantity int,
+	price float64,
+	discount float64,
+	taxLines []TaxLine,
+	allocations []Allocation,
+	backordered int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *OrderItem {
+	return &OrderItem{
+		id:          id,
+		productID:   productID,
+		variantID:   variantID,
+		sku:         sku,
+		quantity:    quantity,
+		price:       price,
+		discount:    discount,
+		taxLines:    taxLines,
+		allocations: allocations,
+		backordered: backordered,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
 // ID returns the order item ID
 func (oi *OrderItem) ID() ID {
 	return oi.id
@@ -87,6 +143,16 @@ func (oi *OrderItem) ProductID() product.ID {
 	return oi.productID
 }
 
+// VariantID returns the purchased variant ID, empty for products without variants
+func (oi *OrderItem) VariantID() product.VariantID {
+	return oi.variantID
+}
+
+// SKU returns the SKU of the purchased variant at the time of the order
+func (oi *OrderItem) SKU() string {
+	return oi.sku
+}
+
 // Quantity returns the quantity
 func (oi *OrderItem) Quantity() int {
 	return oi.quantity
@@ -102,6 +168,51 @@ func (oi *OrderItem) Subtotal() float64 {
 	return float64(oi.quantity) * oi.price
 }
 
+// Discount returns the discount granted on the item
+func (oi *OrderItem) Discount() float64 {
+	return oi.discount
+}
+
+// TaxLines returns the taxes charged on the item
+func (oi *OrderItem) TaxLines() []TaxLine {
+	return oi.taxLines
+}
+
+// TaxAmount returns the total tax charged on the item
+func (oi *OrderItem) TaxAmount() float64 {
+	total := 0.0
+	for _, line := range oi.taxLines {
+		total += line.amount
+	}
+	return total
+}
+
+// ExclusiveTaxAmount returns the tax charged on top of the item price
+func (oi *OrderItem) ExclusiveTaxAmount() float64 {
+	total := 0.0
+	for _, line := range oi.taxLines {
+		if !line.inclusive {
+			total += line.amount
+		}
+	}
+	return total
+}
+
+// Allocations returns the warehouses the item is shipped from
+func (oi *OrderItem) Allocations() []Allocation {
+	return oi.allocations
+}
+
+// Backordered returns the quantity of the item waiting for stock
+func (oi *OrderItem) Backordered() int {
+	return oi.backordered
+}
+
+// IsBackordered checks if some of the item is waiting for stock
+func (oi *OrderItem) IsBackordered() bool {
+	return oi.backordered > 0
+}
+
 // CreatedAt returns the order item creation time
 func (oi *OrderItem) CreatedAt() time.Time {
 	return oi.createdAt
@@ -112,37 +223,70 @@ func (oi *OrderItem) UpdatedAt() time.Time {
 	return oi.updatedAt
 }
 
-// Order represents the order aggregate root
+// Order represents the order aggregate root. An order is placed by a user or,
+// at guest checkout, by a visitor known only by email.
 type Order struct {
 	id              ID
 	userID          user.ID
+	guestEmail      user.Email
 	status          Status
 	totalAmount     float64
-	shippingAddress string
-	billingAddress  string
+	shippingAddress address.Address
+	billingAddress  address.Address
 	paymentMethod   string
+	shippingMethod  string
+	shippingCost    float64
+	discounts       []Discount
 	items           []*OrderItem
+	events          []Event
 	createdAt       time.Time
 	updatedAt       time.Time
 }
 
-// NewOrder creates a new order
-func NewOrder(userID string, shippingAddress, billingAddress, paymentMethod string) (*Order, error) {
-	id, err := NewID(uuid.New().String())
+// NewOrder creates a new order with snapshots of its shipping and billing addresses
+func NewOrder(userID string, shippingAddress, billingAddress address.Address, paymentMethod string) (*Order, error) {
+	userIDVO, err := user.NewID(userID)
+	if err != nil {
+		return nil, ErrInvalidUserID
+	}
+
+	o, err := newOrder(shippingAddress, billingAddress, paymentMethod)
 	if err != nil {
 		return nil, err
 	}
 
-	userIDVO, err := user.NewID(userID)
+	o.userID = userIDVO
+	return o, nil
+}
+
+// NewGuestOrder creates a new order placed at guest checkout by a visitor with just an email address
+func NewGuestOrder(email string, shippingAddress, billingAddress address.Address, paymentMethod string) (*Order, error) {
+	emailVO, err := user.NewEmail(email)
 	if err != nil {
-		return nil, ErrInvalidUserID
+		return nil, ErrInvalidGuestEmail
+	}
+
+	o, err := newOrder(shippingAddress, billingAddress, paymentMethod)
+	if err != nil {
+		return nil, err
 	}
 
-	if shippingAddress == "" {
+	o.guestEmail = emailVO
+	return o, nil
+}
+
+// newOrder creates a new pending order without a customer
+func newOrder(shippingAddress, billingAddress address.Address, paymentMethod string) (*Order, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	if shippingAddress.IsZero() {
 		return nil, ErrInvalidShippingAddress
 	}
 
-	if billingAddress == "" {
+	if billingAddress.IsZero() {
 		return nil, ErrInvalidBillingAddress
 	}
 
@@ -154,28 +298,74 @@ func NewOrder(userID string, shippingAddress, billingAddress, paymentMethod stri
 
 	return &Order{
 		id:              id,
-		userID:          userIDVO,
 		status:          StatusPending,
 		totalAmount:     0,
 		shippingAddress: shippingAddress,
 		billingAddress:  billingAddress,
 		paymentMethod:   paymentMethod,
+		discounts:       []Discount{},
 		items:           []*OrderItem{},
+		events:          []Event{{name: EventPlaced, orderID: id, status: StatusPending, occurredAt: now}},
 		createdAt:       now,
 		updatedAt:       now,
 	}, nil
 }
 
+// Reconstruct rebuilds an order from persisted state
+func Reconstruct(
+	id ID,
+	userID user.ID,
+	guestEmail user.Email,
+	status Status,
+	totalAmount float64,
+	shippingAddress address.Address,
+	billingAddress address.Address,
+	paymentMethod string,
+	shippingMethod string,
+	shippingCost float64,
+	discounts []Discount,
+	items []*OrderItem,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Order {
+	return &Order{
+		id:              id,
+		userID:          userID,
+		guestEmail:      guestEmail,
+		status:          status,
+		totalAmount:     totalAmount,
+		shippingAddress: shippingAddress,
+		billingAddress:  billingAddress,
+		paymentMethod:   paymentMethod,
+		shippingMethod:  shippingMethod,
+		shippingCost:    shippingCost,
+		discounts:       discounts,
+		items:           items,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
+
 // ID returns the order ID
 func (o *Order) ID() ID {
 	return o.id
 }
 
-// UserID returns the user ID
+// UserID returns the user ID, empty for guest orders
 func (o *Order) UserID() user.ID {
 	return o.userID
 }
 
+// GuestEmail returns the email address of the visitor who placed a guest order, empty for user orders
+func (o *Order) GuestEmail() user.Email {
+	return o.guestEmail
+}
+
+// IsGuest checks if the order was placed at guest checkout
+func (o *Order) IsGuest() bool {
+	return o.userID == ""
+}
+
 // Status returns the order status
 func (o *Order) Status() Status {
 	return o.status
@@ -187,12 +377,12 @@ func (o *Order) TotalAmount() float64 {
 }
 
 // ShippingAddress returns the shipping address
-func (o *Order) ShippingAddress() string {
+func (o *Order) ShippingAddress() address.Address {
 	return o.shippingAddress
 }
 
 // BillingAddress returns the billing address
-func (o *Order) BillingAddress() string {
+func (o *Order) BillingAddress() address.Address {
 	return o.billingAddress
 }
 
@@ -201,6 +391,21 @@ func (o *Order) PaymentMethod() string {
 	return o.paymentMethod
 }
 
+// ShippingMethod returns the chosen shipping method
+func (o *Order) ShippingMethod() string {
+	return o.shippingMethod
+}
+
+// ShippingCost returns the shipping cost
+func (o *Order) ShippingCost() float64 {
+	return o.shippingCost
+}
+
+// Discounts returns the discounts granted on the order
+func (o *Order) Discounts() []Discount {
+	return o.discounts
+}
+
 // Items returns the order items
 func (o *Order) Items() []*OrderItem {
 	return o.items
@@ -216,13 +421,13 @@ func (o *Order) UpdatedAt() time.Time {
 	return o.updatedAt
 }
 
-// AddItem adds an item to the order
-func (o *Order) AddItem(productID string, quantity int, price float64) error {
+// AddItem adds an item to the order with its discount and the taxes charged on it
+func (o *Order) AddItem(productID, variantID, sku string, quantity int, price, discount float64, taxLines ...TaxLine) error {
 	if o.status != StatusPending {
 		return errors.New("cannot modify a non-pending order")
 	}
 
-	item, err := NewOrderItem(productID, quantity, price)
+	item, err := NewOrderItem(productID, variantID, sku, quantity, price, discount, taxLines...)
 	if err != nil {
 		return err
 	}
@@ -233,6 +438,118 @@ func (o *Order) AddItem(productID string, quantity int, price float64) error {
 	return nil
 }
 
+// AllocateItem records the warehouses an item is shipped from; the allocated quantities
+// must add up to the item quantity that is not backordered
+func (o *Order) AllocateItem(itemID ID, allocations ...Allocation) error {
+	for _, item := range o.items {
+		if item.id != itemID {
+			continue
+		}
+
+		allocated := 0
+		for _, allocation := range allocations {
+			allocated += allocation.quantity
+		}
+
+		if allocated != item.quantity-item.backordered {
+			return ErrInvalidAllocation
+		}
+
+		item.allocations = allocations
+		item.updatedAt = time.Now()
+		o.updatedAt = item.updatedAt
+		return nil
+	}
+	return ErrItemNotFound
+}
+
+// BackorderItem flags a quantity of an item as waiting for stock
+func (o *Order) BackorderItem(itemID ID, quantity int) error {
+	item, err := o.findItem(itemID)
+	if err != nil {
+		return err
+	}
+
+	if quantity <= 0 || quantity > item.quantity {
+		return ErrInvalidBackorder
+	}
+
+	item.backordered = quantity
+	item.updatedAt = time.Now()
+	o.updatedAt = item.updatedAt
+	return nil
+}
+
+// FillBackorder allocates stock that came in to a backordered item, no longer waiting for it
+func (o *Order) FillBackorder(itemID ID, allocation Allocation) error {
+	if o.status == StatusCancelled {
+		return errors.New("cannot fill a cancelled order")
+	}
+
+	item, err := o.findItem(itemID)
+	if err != nil {
+		return err
+	}
+
+	if allocation.quantity > item.backordered {
+		return ErrInvalidAllocation
+	}
+
+	filled := false
+	for i, existing := range item.allocations {
+		if existing.warehouseID == allocation.warehouseID {
+			item.allocations[i].quantity += allocation.quantity
+			filled = true
+			break
+		}
+	}
+	if !filled {
+		item.allocations = append(item.allocations, allocation)
+	}
+
+	item.backordered -= allocation.quantity
+	item.updatedAt = time.Now()
+	o.updatedAt = item.updatedAt
+	return nil
+}
+
+// ReleaseAllocations removes the stock allocations of the items of a cancelled order,
+// once the stock is returned to the warehouses it was allocated from
+func (o *Order) ReleaseAllocations() error {
+	if o.status != StatusCancelled {
+		return errors.New("cannot release the stock of an order that is not cancelled")
+	}
+
+	for _, item := range o.items {
+		if len(item.allocations) > 0 {
+			item.allocations = []Allocation{}
+			item.updatedAt = time.Now()
+			o.updatedAt = item.updatedAt
+		}
+	}
+	return nil
+}
+
+// HasBackorders checks if any item of the order is waiting for stock
+func (o *Order) HasBackorders() bool {
+	for _, item := range o.items {
+		if item.backordered > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// findItem returns the item with the given ID
+func (o *Order) findItem(itemID ID) (*OrderItem, error) {
+	for _, item := range o.items {
+		if item.id == itemID {
+			return item, nil
+		}
+	}
+	return nil, ErrItemNotFound
+}
+
 // RemoveItem removes an item from the order
 func (o *Order) RemoveItem(itemID string) error {
 	if o.status != StatusPending {
@@ -250,7 +567,8 @@ func (o *Order) RemoveItem(itemID string) error {
 	return ErrItemNotFound
 }
 
-// ChangeStatus changes the order status
+// ChangeStatus changes the order status. Only pending and paid orders can be cancelled, and cancelled
+// orders cannot change status.
 func (o *Order) ChangeStatus(status Status) error {
 	validStatuses := map[Status]bool{
 		StatusPending:   true,
@@ -264,29 +582,49 @@ func (o *Order) ChangeStatus(status Status) error {
 		return ErrInvalidStatus
 	}
 
+	if status == o.status {
+		return nil
+	}
+
+	if o.status == StatusCancelled {
+		return ErrInvalidTransition
+	}
+
+	if status == StatusCancelled && o.status != StatusPending && o.status != StatusPaid {
+		return ErrInvalidTransition
+	}
+
+	previous := o.status
 	o.status = status
 	o.updatedAt = time.Now()
+	o.events = append(o.events, Event{
+		name:           EventStatusChanged,
+		orderID:        o.id,
+		status:         status,
+		previousStatus: previous,
+		occurredAt:     o.updatedAt,
+	})
 	return nil
 }
 
 // ChangeShippingAddress changes the shipping address
-func (o *Order) ChangeShippingAddress(address string) error {
-	if address == "" {
+func (o *Order) ChangeShippingAddress(addr address.Address) error {
+	if addr.IsZero() {
 		return ErrInvalidShippingAddress
 	}
 
-	o.shippingAddress = address
+	o.shippingAddress = addr
 	o.updatedAt = time.Now()
 	return nil
 }
 
 // ChangeBillingAddress changes the billing address
-func (o *Order) ChangeBillingAddress(address string) error {
-	if address == "" {
+func (o *Order) ChangeBillingAddress(addr address.Address) error {
+	if addr.IsZero() {
 		return ErrInvalidBillingAddress
 	}
 
-	o.billingAddress = address
+	o.billingAddress = addr
 	o.updatedAt = time.Now()
 	return nil
 }
@@ -302,13 +640,79 @@ func (o *Order) ChangePaymentMethod(method string) error {
 	return nil
 }
 
-// recalculateTotalAmount recalculates the total amount of the order
-func (o *Order) recalculateTotalAmount() {
+// ChangeShipping sets the shipping method and its cost
+func (o *Order) ChangeShipping(method string, cost float64) error {
+	if o.status != StatusPending {
+		return errors.New("cannot modify a non-pending order")
+	}
+
+	if method == "" {
+		return ErrInvalidShippingMethod
+	}
+
+	if cost < 0 {
+		return ErrInvalidShippingCost
+	}
+
+	o.shippingMethod = method
+	o.shippingCost = cost
+	o.recalculateTotalAmount()
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// Subtotal returns the sum of the item subtotals
+func (o *Order) Subtotal() float64 {
 	total := 0.0
 	for _, item := range o.items {
 		total += item.Subtotal()
 	}
-	o.totalAmount = total
+	return total
+}
+
+// AddDiscount records a discount granted on the order.
+// Item discounts must already be set on the items; the discount amount also
+// covers any shipping discount and is subtracted from the total.
+func (o *Order) AddDiscount(discount Discount) error {
+	if o.status != StatusPending {
+		return errors.New("cannot modify a non-pending order")
+	}
+
+	if o.DiscountAmount()+discount.amount > o.Subtotal()+o.shippingCost {
+		return ErrInvalidDiscount
+	}
+
+	o.discounts = append(o.discounts, discount)
+	o.recalculateTotalAmount()
+	o.updatedAt = time.Now()
+	return nil
+}
+
+// DiscountAmount returns the total discount granted on the order
+func (o *Order) DiscountAmount() float64 {
+	total := 0.0
+	for _, discount := range o.discounts {
+		total += discount.amount
+	}
+	return total
+}
+
+// TaxAmount returns the total tax charged on the order, inclusive or not
+func (o *Order) TaxAmount() float64 {
+	total := 0.0
+	for _, item := range o.items {
+		total += item.TaxAmount()
+	}
+	return total
+}
+
+// recalculateTotalAmount recalculates the total amount of the order
+func (o *Order) recalculateTotalAmount() {
+	exclusiveTax := 0.0
+	for _, item := range o.items {
+		exclusiveTax += item.ExclusiveTaxAmount()
+	}
+	o.totalAmount = o.Subtotal() - o.DiscountAmount() + exclusiveTax + o.shippingCost
 }
 
 // ItemCount returns the number of items in the order
@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"e-commerce/internal/domain/product"
 	"e-commerce/internal/domain/user"
 )
 
@@ -27,4 +28,7 @@ type Repository interface {
 
 	// FindByStatus retrieves orders by status
 	FindByStatus(ctx context.Context, status Status, limit, offset int) ([]*Order, error)
+
+	// HasDelivered checks if a user has a delivered order with a product or any of its variants
+	HasDelivered(ctx context.Context, userID user.ID, productID product.ID) (bool, error)
 }
@@ -1,6 +1,7 @@
 package order
 
 import (
+	"e-commerce/internal/domain/warehouse"
 	"errors"
 	"strings"
 )
@@ -20,3 +21,94 @@ func NewID(id string) (ID, error) {
 func (id ID) String() string {
 	return string(id)
 }
+
+// TaxLine represents a tax charged on an order item, frozen at placement time
+type TaxLine struct {
+	name      string
+	rate      float64
+	amount    float64
+	inclusive bool
+}
+
+// NewTaxLine creates a new TaxLine
+func NewTaxLine(name string, rate, amount float64, inclusive bool) (TaxLine, error) {
+	if strings.TrimSpace(name) == "" || rate < 0 || amount < 0 {
+		return TaxLine{}, ErrInvalidTaxLine
+	}
+	return TaxLine{name: name, rate: rate, amount: amount, inclusive: inclusive}, nil
+}
+
+// Name returns the tax name
+func (t TaxLine) Name() string {
+	return t.name
+}
+
+// Rate returns the tax rate as a fraction
+func (t TaxLine) Rate() float64 {
+	return t.rate
+}
+
+// Amount returns the tax amount for the whole line
+func (t TaxLine) Amount() float64 {
+	return t.amount
+}
+
+// Inclusive checks if the tax is contained in the item price
+func (t TaxLine) Inclusive() bool {
+	return t.inclusive
+}
+
+// Discount represents a discount granted on an order, such as a coupon
+type Discount struct {
+	code        string
+	description string
+	amount      float64
+}
+
+// NewDiscount creates a new Discount
+func NewDiscount(code, description string, amount float64) (Discount, error) {
+	if strings.TrimSpace(code) == "" || amount < 0 {
+		return Discount{}, ErrInvalidDiscount
+	}
+	return Discount{code: code, description: description, amount: amount}, nil
+}
+
+// Code returns the code identifying the discount
+func (d Discount) Code() string {
+	return d.code
+}
+
+// Description returns a human readable description of the discount
+func (d Discount) Description() string {
+	return d.description
+}
+
+// Amount returns the discounted amount, including any shipping discount
+func (d Discount) Amount() float64 {
+	return d.amount
+}
+
+// Allocation represents the quantity of an order item shipped from a warehouse
+type Allocation struct {
+	warehouseID warehouse.ID
+	quantity    int
+}
+
+// NewAllocation creates a new Allocation
+func NewAllocation(warehouseID string, quantity int) (Allocation, error) {
+	id, err := warehouse.NewID(warehouseID)
+	if err != nil || quantity <= 0 {
+		return Allocation{}, ErrInvalidAllocation
+	}
+	return Allocation{warehouseID: id, quantity: quantity}, nil
+}
+
+// WarehouseID returns the warehouse the quantity is shipped from
+func (a Allocation) WarehouseID() warehouse.ID {
+	return a.warehouseID
+}
+
+// Quantity returns the quantity shipped from the warehouse
+func (a Allocation) Quantity() int {
+	return a.quantity
+}
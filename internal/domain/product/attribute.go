@@ -0,0 +1,126 @@
+package product
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Attribute errors
+var (
+	ErrInvalidAttribute     = errors.New("invalid product attribute")
+	ErrInvalidAttributeType = errors.New("invalid product attribute type")
+	ErrDuplicateAttribute   = errors.New("product attribute defined more than once")
+)
+
+// AttributeType represents the type of the value of a product attribute
+type AttributeType string
+
+const (
+	AttributeTypeText    AttributeType = "text"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeBoolean AttributeType = "boolean"
+)
+
+// NewAttributeType creates a new AttributeType
+func NewAttributeType(attributeType string) (AttributeType, error) {
+	switch AttributeType(attributeType) {
+	case AttributeTypeText, AttributeTypeNumber, AttributeTypeBoolean:
+		return AttributeType(attributeType), nil
+	}
+	return "", ErrInvalidAttributeType
+}
+
+// String returns the string representation of the AttributeType
+func (t AttributeType) String() string {
+	return string(t)
+}
+
+// Attribute represents a typed product specification, such as brand, material or weight in grams.
+// The value is kept in a canonical text form so equal values always compare equal.
+type Attribute struct {
+	name          string
+	attributeType AttributeType
+	value         string
+}
+
+// NewAttribute creates a new Attribute, checking that the value matches the type
+func NewAttribute(name, attributeType, value string) (Attribute, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || len(name) > 100 {
+		return Attribute{}, ErrInvalidAttribute
+	}
+
+	typeVO, err := NewAttributeType(attributeType)
+	if err != nil {
+		return Attribute{}, err
+	}
+
+	value = strings.TrimSpace(value)
+	switch typeVO {
+	case AttributeTypeText:
+		if value == "" {
+			return Attribute{}, ErrInvalidAttribute
+		}
+	case AttributeTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return Attribute{}, ErrInvalidAttribute
+		}
+		value = strconv.FormatFloat(number, 'f', -1, 64)
+	case AttributeTypeBoolean:
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return Attribute{}, ErrInvalidAttribute
+		}
+		value = strconv.FormatBool(boolean)
+	}
+
+	return Attribute{name: name, attributeType: typeVO, value: value}, nil
+}
+
+// ReconstructAttribute rebuilds an attribute from persisted state
+func ReconstructAttribute(name string, attributeType AttributeType, value string) Attribute {
+	return Attribute{name: name, attributeType: attributeType, value: value}
+}
+
+// Name returns the attribute name
+func (a Attribute) Name() string {
+	return a.name
+}
+
+// Type returns the attribute type
+func (a Attribute) Type() AttributeType {
+	return a.attributeType
+}
+
+// Value returns the canonical text form of the attribute value
+func (a Attribute) Value() string {
+	return a.value
+}
+
+// Number returns the value of a number attribute, zero for other types
+func (a Attribute) Number() float64 {
+	number, _ := strconv.ParseFloat(a.value, 64)
+	return number
+}
+
+// Attributes returns the attributes of the product
+func (p *Product) Attributes() []Attribute {
+	return p.attributes
+}
+
+// ChangeAttributes replaces the attributes of the product
+func (p *Product) ChangeAttributes(attributes []Attribute) error {
+	names := make(map[string]bool, len(attributes))
+	for _, attribute := range attributes {
+		if names[attribute.name] {
+			return ErrDuplicateAttribute
+		}
+		names[attribute.name] = true
+	}
+
+	p.attributes = attributes
+	p.touch()
+	return nil
+}
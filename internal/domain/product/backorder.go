@@ -0,0 +1,260 @@
+package product
+
+import (
+	"e-commerce/internal/domain/warehouse"
+	"errors"
+	"time"
+)
+
+// Backorder errors
+var (
+	ErrInvalidBackorderPolicy = errors.New("invalid backorder policy")
+	ErrInvalidBackorderLimit  = errors.New("invalid backorder limit")
+	ErrMissingAvailability    = errors.New("pre-orders require an expected availability date")
+	ErrBackorderNotAllowed    = errors.New("product cannot be ordered beyond its stock")
+	ErrBackorderLimitReached  = errors.New("backorder limit reached")
+)
+
+// BackorderPolicy represents whether a product can be ordered beyond its stock
+type BackorderPolicy string
+
+const (
+	// BackorderNone only sells the stock held
+	BackorderNone BackorderPolicy = "none"
+
+	// BackorderAllowed sells beyond the stock held, shipping once stock comes in
+	BackorderAllowed BackorderPolicy = "backorder"
+
+	// BackorderPreorder sells a product ahead of its expected availability date
+	BackorderPreorder BackorderPolicy = "preorder"
+)
+
+// NewBackorderPolicy creates a new BackorderPolicy
+func NewBackorderPolicy(policy string) (BackorderPolicy, error) {
+	switch p := BackorderPolicy(policy); p {
+	case BackorderNone, BackorderAllowed, BackorderPreorder:
+		return p, nil
+	}
+	return "", ErrInvalidBackorderPolicy
+}
+
+// String returns the string representation of the BackorderPolicy
+func (p BackorderPolicy) String() string {
+	return string(p)
+}
+
+// Backorder represents the quantity of an order item waiting for the product, or one of its variants,
+// to come back in stock
+type Backorder struct {
+	orderID   string
+	itemID    string
+	variantID VariantID
+	quantity  int
+	placedAt  time.Time
+}
+
+// ReconstructBackorder rebuilds a backorder from persisted state
+func ReconstructBackorder(orderID, itemID string, variantID VariantID, quantity int, placedAt time.Time) *Backorder {
+	return &Backorder{
+		orderID:   orderID,
+		itemID:    itemID,
+		variantID: variantID,
+		quantity:  quantity,
+		placedAt:  placedAt,
+	}
+}
+
+// OrderID returns the order waiting for stock
+func (b *Backorder) OrderID() string {
+	return b.orderID
+}
+
+// ItemID returns the order item waiting for stock
+func (b *Backorder) ItemID() string {
+	return b.itemID
+}
+
+// VariantID returns the variant waited for, empty for the product itself
+func (b *Backorder) VariantID() VariantID {
+	return b.variantID
+}
+
+// Quantity returns the quantity still waiting for stock
+func (b *Backorder) Quantity() int {
+	return b.quantity
+}
+
+// PlacedAt returns when the backorder was placed
+func (b *Backorder) PlacedAt() time.Time {
+	return b.placedAt
+}
+
+// BackorderPolicy returns whether the product can be ordered beyond its stock
+func (p *Product) BackorderPolicy() BackorderPolicy {
+	return p.backorderPolicy
+}
+
+// BackorderLimit returns the quantity of the product, or of each of its variants, that may be waiting
+// for stock at once; zero means no limit
+func (p *Product) BackorderLimit() int {
+	return p.backorderLimit
+}
+
+// AvailableAt returns when backordered or pre-ordered stock is expected, nil when unknown
+func (p *Product) AvailableAt() *time.Time {
+	return p.availableAt
+}
+
+// Backorders returns the order items waiting for stock, oldest first
+func (p *Product) Backorders() []*Backorder {
+	return p.backorders
+}
+
+// ChangeBackorderPolicy changes whether the product can be ordered beyond its stock, up to a limit
+// when it is positive, and when the missing stock is expected. Pre-orders require an expected date.
+func (p *Product) ChangeBackorderPolicy(policy string, limit int, availableAt *time.Time) error {
+	policyVO, err := NewBackorderPolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	if limit < 0 {
+		return ErrInvalidBackorderLimit
+	}
+
+	if policyVO == BackorderPreorder && availableAt == nil {
+		return ErrMissingAvailability
+	}
+
+	if policyVO == BackorderNone {
+		limit = 0
+		availableAt = nil
+	}
+
+	p.backorderPolicy = policyVO
+	p.backorderLimit = limit
+	p.availableAt = availableAt
+	p.touch()
+	return nil
+}
+
+// Backordered returns the quantity of the product, or of the given variant, waiting for stock
+func (p *Product) Backordered(variantID VariantID) int {
+	quantity := 0
+	for _, b := range p.backorders {
+		if b.variantID == variantID {
+			quantity += b.quantity
+		}
+	}
+	return quantity
+}
+
+// Shortfall returns the quantity of the product, or of the given variant when the product has variants,
+// missing from its stock to fill an order of the given quantity
+func (p *Product) Shortfall(variantID VariantID, quantity int) int {
+	v, err := p.ResolveVariant(variantID)
+	if err != nil {
+		return quantity
+	}
+
+	stock := p.stock
+	if v != nil {
+		stock = v.stock
+	}
+	return max(quantity-stock.Value(), 0)
+}
+
+// CanBackorder checks if a quantity of the product, or of the given variant, can be ordered beyond its stock
+func (p *Product) CanBackorder(variantID VariantID, quantity int) bool {
+	if p.backorderPolicy == "" || p.backorderPolicy == BackorderNone {
+		return false
+	}
+
+	return p.backorderLimit == 0 || p.Backordered(variantID)+quantity <= p.backorderLimit
+}
+
+// Backorder queues a quantity of an order item to be filled once the product, or the given variant
+// when the product has variants, comes back in stock
+func (p *Product) Backorder(variantID VariantID, quantity int, orderID, itemID string) error {
+	if quantity <= 0 {
+		return ErrInvalidStock
+	}
+
+	v, err := p.ResolveVariant(variantID)
+	if err != nil {
+		return err
+	}
+
+	if v != nil {
+		variantID = v.id
+	}
+
+	if p.backorderPolicy == "" || p.backorderPolicy == BackorderNone {
+		return ErrBackorderNotAllowed
+	}
+
+	if !p.CanBackorder(variantID, quantity) {
+		return ErrBackorderLimitReached
+	}
+
+	p.backorders = append(p.backorders, &Backorder{
+		orderID:   orderID,
+		itemID:    itemID,
+		variantID: variantID,
+		quantity:  quantity,
+		placedAt:  time.Now(),
+	})
+	p.touch()
+	return nil
+}
+
+// CancelBackorders removes the backorders of an order, returning whether it had any
+func (p *Product) CancelBackorders(orderID string) bool {
+	remaining := make([]*Backorder, 0, len(p.backorders))
+	for _, b := range p.backorders {
+		if b.orderID != orderID {
+			remaining = append(remaining, b)
+		}
+	}
+
+	if len(remaining) == len(p.backorders) {
+		return false
+	}
+
+	p.backorders = remaining
+	p.touch()
+	return true
+}
+
+// fillBackorders allocates the stock held in a warehouse to the order items waiting for the product,
+// or the given variant, oldest first, recording the sale and a backorder allocated event for each
+func (p *Product) fillBackorders(warehouseID warehouse.ID, variantID VariantID) {
+	remaining := make([]*Backorder, 0, len(p.backorders))
+	for _, b := range p.backorders {
+		held := p.StockIn(warehouseID, variantID)
+		if b.variantID != variantID || held == 0 {
+			remaining = append(remaining, b)
+			continue
+		}
+
+		quantity := min(held.Value(), b.quantity)
+		p.setStockLevel(warehouseID, variantID, held-Stock(quantity), ReasonSale, OrderReference(b.orderID))
+		b.quantity -= quantity
+
+		p.events = append(p.events, Event{
+			name:        EventBackorderAllocated,
+			productID:   p.id,
+			variantID:   variantID,
+			orderID:     b.orderID,
+			itemID:      b.itemID,
+			warehouseID: warehouseID,
+			quantity:    quantity,
+			occurredAt:  time.Now(),
+		})
+
+		if b.quantity > 0 {
+			remaining = append(remaining, b)
+		}
+	}
+	p.backorders = remaining
+}
@@ -0,0 +1,111 @@
+package product
+
+import (
+	"e-commerce/internal/domain/warehouse"
+	"time"
+)
+
+// Event names
+const (
+	EventCreated            = "product.created"
+	EventUpdated            = "product.updated"
+	EventLowStock           = "product.low_stock"
+	EventBackorderAllocated = "product.backorder_allocated"
+	EventPriceDropped       = "product.price_dropped"
+	EventBackInStock        = "product.back_in_stock"
+)
+
+// Event represents a change to a product
+type Event struct {
+	name          string
+	productID     ID
+	variantID     VariantID
+	stock         Stock
+	threshold     Stock
+	orderID       string
+	itemID        string
+	warehouseID   warehouse.ID
+	quantity      int
+	price         Price
+	previousPrice Price
+	occurredAt    time.Time
+}
+
+// Name returns the event name
+func (e Event) Name() string {
+	return e.name
+}
+
+// ProductID returns the ID of the changed product
+func (e Event) ProductID() ID {
+	return e.productID
+}
+
+// VariantID returns the variant whose stock or price changed, or whose stock was allocated to a backorder,
+// empty for the product itself and for other events
+func (e Event) VariantID() VariantID {
+	return e.variantID
+}
+
+// Stock returns the stock left when the stock ran low, or held when it came back in stock
+func (e Event) Stock() Stock {
+	return e.stock
+}
+
+// Threshold returns the reorder threshold the stock fell to
+func (e Event) Threshold() Stock {
+	return e.threshold
+}
+
+// OrderID returns the order a backorder was allocated stock for
+func (e Event) OrderID() string {
+	return e.orderID
+}
+
+// ItemID returns the order item a backorder was allocated stock for
+func (e Event) ItemID() string {
+	return e.itemID
+}
+
+// WarehouseID returns the warehouse the stock allocated to a backorder is shipped from
+func (e Event) WarehouseID() warehouse.ID {
+	return e.warehouseID
+}
+
+// Quantity returns the quantity allocated to a backorder
+func (e Event) Quantity() int {
+	return e.quantity
+}
+
+// Price returns the price the product or variant dropped to
+func (e Event) Price() Price {
+	return e.price
+}
+
+// PreviousPrice returns the price the product or variant dropped from
+func (e Event) PreviousPrice() Price {
+	return e.previousPrice
+}
+
+// OccurredAt returns when the change happened
+func (e Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// PullEvents returns the events recorded since the product was loaded and clears them
+func (p *Product) PullEvents() []Event {
+	events := p.events
+	p.events = nil
+	return events
+}
+
+// touch marks the product as updated, recording a single update event per change set
+func (p *Product) touch() {
+	p.updatedAt = time.Now()
+	for _, event := range p.events {
+		if event.name == EventCreated || event.name == EventUpdated {
+			return
+		}
+	}
+	p.events = append(p.events, Event{name: EventUpdated, productID: p.id, occurredAt: p.updatedAt})
+}
@@ -0,0 +1,33 @@
+package product
+
+// Filter represents the criteria products are listed by; the zero Filter matches every
+// product available to customers
+type Filter struct {
+	// Status lists the products with that status, scheduled ones included, instead of
+	// the products available to customers
+	Status Status
+
+	// Attributes maps attribute names to accepted values. A product matches when,
+	// for every attribute, it has one of the accepted values.
+	Attributes map[string][]string
+
+	// MinPrice and MaxPrice bound the product or variant price, zero leaves that side open
+	MinPrice float64
+	MaxPrice float64
+
+	// InStock only matches products, or products with a variant, that are in stock
+	InStock bool
+}
+
+// FacetValue represents an attribute value and how many products have it
+type FacetValue struct {
+	Value string
+	Count int
+}
+
+// Facet represents the values of an attribute across a set of products
+type Facet struct {
+	Name   string
+	Type   AttributeType
+	Values []FacetValue
+}
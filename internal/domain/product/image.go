@@ -0,0 +1,236 @@
+package product
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Image errors
+var (
+	ErrInvalidImageID    = errors.New("invalid product image ID")
+	ErrInvalidAltText    = errors.New("invalid product image alt text")
+	ErrInvalidRendition  = errors.New("invalid product image rendition")
+	ErrImageNotFound     = errors.New("product image not found")
+	ErrInvalidImageOrder = errors.New("image order must list every product image once")
+	ErrUnsupportedImage  = errors.New("unsupported image, expected JPEG, PNG or GIF")
+	ErrImageTooLarge     = errors.New("image too large")
+)
+
+// Rendition names
+const (
+	RenditionOriginal  = "original"
+	RenditionMedium    = "medium"
+	RenditionThumbnail = "thumbnail"
+)
+
+// ImageID represents a product image identifier
+type ImageID string
+
+// NewImageID creates a new ImageID
+func NewImageID(id string) (ImageID, error) {
+	if id == "" {
+		return "", ErrInvalidImageID
+	}
+	return ImageID(id), nil
+}
+
+// String returns the string representation of the ImageID
+func (id ImageID) String() string {
+	return string(id)
+}
+
+// Rendition represents a stored size of a product image
+type Rendition struct {
+	name   string
+	key    string
+	url    string
+	width  int
+	height int
+}
+
+// NewRendition creates a new Rendition of the file stored under key and served at url
+func NewRendition(name, key, url string, width, height int) (Rendition, error) {
+	if name == "" || key == "" || url == "" || width <= 0 || height <= 0 {
+		return Rendition{}, ErrInvalidRendition
+	}
+	return Rendition{name: name, key: key, url: url, width: width, height: height}, nil
+}
+
+// ReconstructRendition rebuilds a rendition from persisted state
+func ReconstructRendition(name, key, url string, width, height int) Rendition {
+	return Rendition{name: name, key: key, url: url, width: width, height: height}
+}
+
+// Name returns the rendition name, such as "thumbnail"
+func (r Rendition) Name() string {
+	return r.name
+}
+
+// Key returns the key the rendition is stored under
+func (r Rendition) Key() string {
+	return r.key
+}
+
+// URL returns the URL the rendition is served at
+func (r Rendition) URL() string {
+	return r.url
+}
+
+// Width returns the width in pixels
+func (r Rendition) Width() int {
+	return r.width
+}
+
+// Height returns the height in pixels
+func (r Rendition) Height() int {
+	return r.height
+}
+
+// Image represents a product image with its stored renditions
+type Image struct {
+	id         ImageID
+	altText    string
+	renditions []Rendition
+	createdAt  time.Time
+}
+
+// NewImage creates a new Image
+func NewImage(id ImageID, altText string, renditions []Rendition) (*Image, error) {
+	altText, err := normalizeAltText(altText)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(renditions) == 0 {
+		return nil, ErrInvalidRendition
+	}
+
+	return &Image{
+		id:         id,
+		altText:    altText,
+		renditions: renditions,
+		createdAt:  time.Now(),
+	}, nil
+}
+
+// ReconstructImage rebuilds an image from persisted state
+func ReconstructImage(id ImageID, altText string, renditions []Rendition, createdAt time.Time) *Image {
+	return &Image{
+		id:         id,
+		altText:    altText,
+		renditions: renditions,
+		createdAt:  createdAt,
+	}
+}
+
+// ID returns the image ID
+func (i *Image) ID() ImageID {
+	return i.id
+}
+
+// AltText returns the text describing the image
+func (i *Image) AltText() string {
+	return i.altText
+}
+
+// Renditions returns the stored sizes of the image
+func (i *Image) Renditions() []Rendition {
+	return i.renditions
+}
+
+// Rendition returns the rendition with the given name
+func (i *Image) Rendition(name string) (Rendition, bool) {
+	for _, r := range i.renditions {
+		if r.name == name {
+			return r, true
+		}
+	}
+	return Rendition{}, false
+}
+
+// CreatedAt returns the image upload time
+func (i *Image) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+// Images returns the product images in display order
+func (p *Product) Images() []*Image {
+	return p.images
+}
+
+// AddImage appends an image to the product images
+func (p *Product) AddImage(image *Image) {
+	p.images = append(p.images, image)
+	p.touch()
+}
+
+// FindImage returns an image by ID
+func (p *Product) FindImage(id ImageID) (*Image, error) {
+	for _, image := range p.images {
+		if image.id == id {
+			return image, nil
+		}
+	}
+	return nil, ErrImageNotFound
+}
+
+// ChangeImageAltText changes the text describing an image
+func (p *Product) ChangeImageAltText(id ImageID, altText string) error {
+	image, err := p.FindImage(id)
+	if err != nil {
+		return err
+	}
+
+	altText, err = normalizeAltText(altText)
+	if err != nil {
+		return err
+	}
+
+	image.altText = altText
+	p.touch()
+	return nil
+}
+
+// ReorderImages puts the images in the given order, which must list every image once
+func (p *Product) ReorderImages(ids []ImageID) error {
+	if len(ids) != len(p.images) {
+		return ErrInvalidImageOrder
+	}
+
+	ordered := make([]*Image, 0, len(ids))
+	seen := make(map[ImageID]bool)
+	for _, id := range ids {
+		image, err := p.FindImage(id)
+		if err != nil || seen[id] {
+			return ErrInvalidImageOrder
+		}
+		seen[id] = true
+		ordered = append(ordered, image)
+	}
+
+	p.images = ordered
+	p.touch()
+	return nil
+}
+
+// RemoveImage removes an image and returns it, so its stored renditions can be deleted
+func (p *Product) RemoveImage(id ImageID) (*Image, error) {
+	for i, image := range p.images {
+		if image.id == id {
+			p.images = append(p.images[:i], p.images[i+1:]...)
+			p.touch()
+			return image, nil
+		}
+	}
+	return nil, ErrImageNotFound
+}
+
+// normalizeAltText trims alt text and checks its length
+func normalizeAltText(altText string) (string, error) {
+	altText = strings.TrimSpace(altText)
+	if len(altText) > 250 {
+		return "", ErrInvalidAltText
+	}
+	return altText, nil
+}
@@ -0,0 +1,94 @@
+package product
+
+import (
+	"errors"
+	"time"
+)
+
+// Lifecycle errors
+var (
+	ErrInvalidStatus      = errors.New("invalid product status")
+	ErrInvalidTransition  = errors.New("invalid product status transition")
+	ErrProductUnavailable = errors.New("product is not available for sale")
+)
+
+// Status represents the lifecycle state of a product
+type Status string
+
+// Product statuses
+const (
+	StatusDraft     Status = "draft"
+	StatusPublished Status = "published"
+	StatusArchived  Status = "archived"
+)
+
+// NewStatus creates a new Status
+func NewStatus(status string) (Status, error) {
+	switch s := Status(status); s {
+	case StatusDraft, StatusPublished, StatusArchived:
+		return s, nil
+	}
+	return "", ErrInvalidStatus
+}
+
+// String returns the string representation of the Status
+func (s Status) String() string {
+	return string(s)
+}
+
+// Status returns the product lifecycle status
+func (p *Product) Status() Status {
+	return p.status
+}
+
+// PublishedAt returns when the product goes or went live, or nil when it was never published
+func (p *Product) PublishedAt() *time.Time {
+	return p.publishedAt
+}
+
+// IsAvailable checks if the product is published and live at the given time,
+// so customers can see and buy it
+func (p *Product) IsAvailable(now time.Time) bool {
+	return p.status == StatusPublished && p.publishedAt != nil && !p.publishedAt.After(now)
+}
+
+// Publish publishes a draft or archived product. A zero time publishes it now,
+// a future time schedules it to go live then.
+func (p *Product) Publish(at time.Time) error {
+	if p.status == StatusPublished {
+		return ErrInvalidTransition
+	}
+
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	p.status = StatusPublished
+	p.publishedAt = &at
+	p.touch()
+	return nil
+}
+
+// Unpublish takes a published or scheduled product back to draft
+func (p *Product) Unpublish() error {
+	if p.status != StatusPublished {
+		return ErrInvalidTransition
+	}
+
+	p.status = StatusDraft
+	p.publishedAt = nil
+	p.touch()
+	return nil
+}
+
+// Archive withdraws a product from sale for good, keeping it for the orders referencing it
+func (p *Product) Archive() error {
+	if p.status == StatusArchived {
+		return ErrInvalidTransition
+	}
+
+	p.status = StatusArchived
+	p.publishedAt = nil
+	p.touch()
+	return nil
+}
@@ -0,0 +1,257 @@
+package product
+
+import (
+	"errors"
+	"time"
+)
+
+// Price schedule errors
+var (
+	ErrInvalidPriceScheduleID   = errors.New("invalid price schedule ID")
+	ErrInvalidPriceSchedule     = errors.New("price schedule must end after it starts")
+	ErrOverlappingPriceSchedule = errors.New("price schedule overlaps another schedule of the product")
+	ErrPriceScheduleNotFound    = errors.New("price schedule not found")
+)
+
+// PriceChange represents a change of the product price, as recorded in its price history
+type PriceChange struct {
+	price         Price
+	previousPrice Price
+	changedAt     time.Time
+}
+
+// ReconstructPriceChange rebuilds a price change from persisted state
+func ReconstructPriceChange(price, previousPrice Price, changedAt time.Time) PriceChange {
+	return PriceChange{price: price, previousPrice: previousPrice, changedAt: changedAt}
+}
+
+// Price returns the new price
+func (c PriceChange) Price() Price {
+	return c.price
+}
+
+// PreviousPrice returns the price before the change, zero for the initial price
+func (c PriceChange) PreviousPrice() Price {
+	return c.previousPrice
+}
+
+// ChangedAt returns when the price changed
+func (c PriceChange) ChangedAt() time.Time {
+	return c.changedAt
+}
+
+// PriceScheduleID represents a price schedule identifier
+type PriceScheduleID string
+
+// NewPriceScheduleID creates a new PriceScheduleID
+func NewPriceScheduleID(id string) (PriceScheduleID, error) {
+	if id == "" {
+		return "", ErrInvalidPriceScheduleID
+	}
+	return PriceScheduleID(id), nil
+}
+
+// String returns the string representation of the PriceScheduleID
+func (id PriceScheduleID) String() string {
+	return string(id)
+}
+
+// PriceSchedule represents a future change of the product price. A schedule with an end
+// is a sale: the price before it is shown as the compare-at price and restored when it ends.
+type PriceSchedule struct {
+	id            PriceScheduleID
+	price         Price
+	startsAt      time.Time
+	endsAt        *time.Time
+	active        bool
+	originalPrice Price
+}
+
+// ReconstructPriceSchedule rebuilds a price schedule from persisted state
+func ReconstructPriceSchedule(
+	id PriceScheduleID,
+	price Price,
+	startsAt time.Time,
+	endsAt *time.Time,
+	active bool,
+	originalPrice Price,
+) *PriceSchedule {
+	return &PriceSchedule{
+		id:            id,
+		price:         price,
+		startsAt:      startsAt,
+		endsAt:        endsAt,
+		active:        active,
+		originalPrice: originalPrice,
+	}
+}
+
+// ID returns the schedule ID
+func (s *PriceSchedule) ID() PriceScheduleID {
+	return s.id
+}
+
+// Price returns the price applied while the schedule runs
+func (s *PriceSchedule) Price() Price {
+	return s.price
+}
+
+// StartsAt returns when the price applies
+func (s *PriceSchedule) StartsAt() time.Time {
+	return s.startsAt
+}
+
+// EndsAt returns when the original price is restored, or nil when the change is permanent
+func (s *PriceSchedule) EndsAt() *time.Time {
+	return s.endsAt
+}
+
+// IsActive checks if the schedule has started and not yet ended
+func (s *PriceSchedule) IsActive() bool {
+	return s.active
+}
+
+// OriginalPrice returns the price before the schedule started, zero until it starts
+func (s *PriceSchedule) OriginalPrice() Price {
+	return s.originalPrice
+}
+
+// overlaps checks if the schedule runs at any time between start and end; a nil end runs forever
+func (s *PriceSchedule) overlaps(start time.Time, end *time.Time) bool {
+	startsBeforeEnd := end == nil || s.startsAt.Before(*end)
+	endsAfterStart := s.endsAt == nil || s.endsAt.After(start)
+	return startsBeforeEnd && endsAfterStart
+}
+
+// CompareAtPrice returns the price the product was sold at before its current sale, zero when not on sale
+func (p *Product) CompareAtPrice() Price {
+	return p.compareAtPrice
+}
+
+// PriceChanges returns the price changes made since the product was loaded, to record in its price history
+func (p *Product) PriceChanges() []PriceChange {
+	return p.priceChanges
+}
+
+// PriceSchedules returns the pending and active price schedules of the product
+func (p *Product) PriceSchedules() []*PriceSchedule {
+	return p.priceSchedules
+}
+
+// SchedulePrice schedules the product price to change at startsAt. With an end,
+// the schedule is a sale and the current price is restored at endsAt.
+func (p *Product) SchedulePrice(id PriceScheduleID, price float64, startsAt time.Time, endsAt *time.Time) (*PriceSchedule, error) {
+	priceVO, err := NewPrice(price)
+	if err != nil {
+		return nil, err
+	}
+
+	if startsAt.IsZero() || (endsAt != nil && !endsAt.After(startsAt)) {
+		return nil, ErrInvalidPriceSchedule
+	}
+
+	for _, s := range p.priceSchedules {
+		if s.overlaps(startsAt, endsAt) {
+			return nil, ErrOverlappingPriceSchedule
+		}
+	}
+
+	schedule := &PriceSchedule{
+		id:       id,
+		price:    priceVO,
+		startsAt: startsAt,
+		endsAt:   endsAt,
+	}
+
+	p.priceSchedules = append(p.priceSchedules, schedule)
+	p.touch()
+	return schedule, nil
+}
+
+// CancelPriceSchedule removes a price schedule. Cancelling a running sale ends it,
+// restoring the price from before it.
+func (p *Product) CancelPriceSchedule(id PriceScheduleID) error {
+	for i, s := range p.priceSchedules {
+		if s.id != id {
+			continue
+		}
+
+		if s.active && s.endsAt != nil {
+			p.endSale(s, time.Now())
+		}
+
+		p.priceSchedules = append(p.priceSchedules[:i], p.priceSchedules[i+1:]...)
+		p.touch()
+		return nil
+	}
+	return ErrPriceScheduleNotFound
+}
+
+// ApplyPriceSchedules starts and ends the price schedules due at the given time,
+// reporting whether the price changed
+func (p *Product) ApplyPriceSchedules(now time.Time) bool {
+	changed := false
+	remaining := p.priceSchedules[:0]
+
+	for _, s := range p.priceSchedules {
+		if !s.active && !s.startsAt.After(now) {
+			s.active = true
+			s.originalPrice = p.price
+			if s.endsAt != nil {
+				p.compareAtPrice = p.price
+			}
+			p.setPrice(s.price, now)
+			changed = true
+		}
+
+		if s.active && (s.endsAt == nil || !s.endsAt.After(now)) {
+			if s.endsAt != nil {
+				p.endSale(s, now)
+			}
+			changed = true
+			continue
+		}
+
+		remaining = append(remaining, s)
+	}
+
+	p.priceSchedules = remaining
+	if changed {
+		p.touch()
+	}
+	return changed
+}
+
+// endSale restores the price from before a sale and clears the compare-at price
+func (p *Product) endSale(s *PriceSchedule, at time.Time) {
+	p.compareAtPrice = 0
+	p.setPrice(s.originalPrice, at)
+}
+
+// setPrice changes the price, recording the change in the price history
+func (p *Product) setPrice(price Price, at time.Time) {
+	if price == p.price {
+		return
+	}
+
+	p.priceChanges = append(p.priceChanges, PriceChange{price: price, previousPrice: p.price, changedAt: at})
+	p.recordPriceDrop("", p.price, price)
+	p.price = price
+}
+
+// recordPriceDrop records a price dropped event when the price of the product, or of the given variant,
+// falls below a previous price
+func (p *Product) recordPriceDrop(variantID VariantID, before, after Price) {
+	if before == 0 || after >= before {
+		return
+	}
+
+	p.events = append(p.events, Event{
+		name:          EventPriceDropped,
+		productID:     p.id,
+		variantID:     variantID,
+		price:         after,
+		previousPrice: before,
+		occurredAt:    time.Now(),
+	})
+}
@@ -1,6 +1,8 @@
 package product
 
 import (
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/warehouse"
 	"errors"
 	"time"
 
@@ -14,21 +16,53 @@ var (
 	ErrInvalidDescription = errors.New("invalid product description")
 	ErrInvalidPrice       = errors.New("invalid product price")
 	ErrInvalidStock       = errors.New("invalid product stock")
+	ErrInvalidWeight      = errors.New("invalid product weight")
+	ErrInvalidDimensions  = errors.New("invalid product dimensions")
+	ErrInsufficientStock  = errors.New("insufficient product stock")
+	ErrInvalidTaxCategory = errors.New("invalid product tax category")
+	ErrInvalidLanguage    = errors.New("invalid product language")
+	ErrProductNotFound    = errors.New("product not found")
+	ErrDuplicateSKU       = errors.New("a product or variant with the same SKU already exists")
+	ErrConcurrentUpdate   = errors.New("product was changed concurrently, reload it and try again")
 )
 
 // Product represents the product aggregate root
 type Product struct {
-	id          ID
-	name        Name
-	description Description
-	price       Price
-	stock       Stock
-	createdAt   time.Time
-	updatedAt   time.Time
+	id              ID
+	sku             SKU
+	name            Name
+	description     Description
+	price           Price
+	compareAtPrice  Price
+	stock           Stock
+	weight          Weight
+	dimensions      Dimensions
+	taxCategory     TaxCategory
+	language        Language
+	categoryIDs     []category.ID
+	options         []Option
+	variants        []*Variant
+	attributes      []Attribute
+	images          []*Image
+	stockLevels     []StockLevel
+	stockMovements  []StockMovement
+	threshold       Stock
+	backorderPolicy BackorderPolicy
+	backorderLimit  int
+	availableAt     *time.Time
+	backorders      []*Backorder
+	status          Status
+	publishedAt     *time.Time
+	priceSchedules  []*PriceSchedule
+	priceChanges    []PriceChange
+	version         int
+	createdAt       time.Time
+	updatedAt       time.Time
+	events          []Event
 }
 
-// NewProduct creates a new product
-func NewProduct(name string, description string, price float64, stock int) (*Product, error) {
+// NewProduct creates a new draft product, hidden from customers until it is published
+func NewProduct(name string, description string, price float64) (*Product, error) {
 	id, err := NewID(uuid.New().String())
 	if err != nil {
 		return nil, err
@@ -49,29 +83,105 @@ func NewProduct(name string, description string, price float64, stock int) (*Pro
 		return nil, err
 	}
 
-	stockVO, err := NewStock(stock)
-	if err != nil {
-		return nil, err
-	}
-
 	now := time.Now()
 
 	return &Product{
-		id:          id,
-		name:        nameVO,
-		description: descriptionVO,
-		price:       priceVO,
-		stock:       stockVO,
-		createdAt:   now,
-		updatedAt:   now,
+		id:              id,
+		name:            nameVO,
+		description:     descriptionVO,
+		price:           priceVO,
+		taxCategory:     DefaultTaxCategory,
+		language:        DefaultLanguage,
+		categoryIDs:     []category.ID{},
+		options:         []Option{},
+		variants:        []*Variant{},
+		attributes:      []Attribute{},
+		images:          []*Image{},
+		stockLevels:     []StockLevel{},
+		backorderPolicy: BackorderNone,
+		backorders:      []*Backorder{},
+		status:          StatusDraft,
+		priceSchedules:  []*PriceSchedule{},
+		priceChanges:    []PriceChange{{price: priceVO, changedAt: now}},
+		createdAt:       now,
+		updatedAt:       now,
+		events:          []Event{{name: EventCreated, productID: id, occurredAt: now}},
 	}, nil
 }
 
+// Reconstruct rebuilds a product from persisted state without validation
+func Reconstruct(
+	id ID,
+	sku SKU,
+	name Name,
+	description Description,
+	price Price,
+	compareAtPrice Price,
+	stock Stock,
+	weight Weight,
+	dimensions Dimensions,
+	taxCategory TaxCategory,
+	language Language,
+	categoryIDs []category.ID,
+	options []Option,
+	variants []*Variant,
+	attributes []Attribute,
+	images []*Image,
+	stockLevels []StockLevel,
+	backorders []*Backorder,
+	threshold Stock,
+	backorderPolicy BackorderPolicy,
+	backorderLimit int,
+	availableAt *time.Time,
+	status Status,
+	publishedAt *time.Time,
+	priceSchedules []*PriceSchedule,
+	version int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Product {
+	return &Product{
+		id:              id,
+		sku:             sku,
+		name:            name,
+		description:     description,
+		price:           price,
+		compareAtPrice:  compareAtPrice,
+		stock:           stock,
+		weight:          weight,
+		dimensions:      dimensions,
+		taxCategory:     taxCategory,
+		language:        language,
+		categoryIDs:     categoryIDs,
+		options:         options,
+		variants:        variants,
+		attributes:      attributes,
+		images:          images,
+		stockLevels:     stockLevels,
+		backorders:      backorders,
+		threshold:       threshold,
+		backorderPolicy: backorderPolicy,
+		backorderLimit:  backorderLimit,
+		availableAt:     availableAt,
+		status:          status,
+		publishedAt:     publishedAt,
+		priceSchedules:  priceSchedules,
+		version:         version,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
+
 // ID returns the product ID
 func (p *Product) ID() ID {
 	return p.id
 }
 
+// SKU returns the stock keeping unit of the product, empty when it has none
+func (p *Product) SKU() SKU {
+	return p.sku
+}
+
 // Name returns the product name
 func (p *Product) Name() Name {
 	return p.name
@@ -92,6 +202,31 @@ func (p *Product) Stock() Stock {
 	return p.stock
 }
 
+// Weight returns the product weight
+func (p *Product) Weight() Weight {
+	return p.weight
+}
+
+// Dimensions returns the product package dimensions
+func (p *Product) Dimensions() Dimensions {
+	return p.dimensions
+}
+
+// TaxCategory returns the product tax category
+func (p *Product) TaxCategory() TaxCategory {
+	return p.taxCategory
+}
+
+// Language returns the language the product is written in
+func (p *Product) Language() Language {
+	return p.language
+}
+
+// CategoryIDs returns the categories the product is assigned to
+func (p *Product) CategoryIDs() []category.ID {
+	return p.categoryIDs
+}
+
 // CreatedAt returns the product creation time
 func (p *Product) CreatedAt() time.Time {
 	return p.createdAt
@@ -102,6 +237,37 @@ func (p *Product) UpdatedAt() time.Time {
 	return p.updatedAt
 }
 
+// Version returns the version of the product as last saved. Every update saves the next version,
+// so that an update made from a stale copy of the product is detected.
+func (p *Product) Version() int {
+	return p.version
+}
+
+// IncrementVersion records that the product was saved as its next version
+func (p *Product) IncrementVersion() {
+	p.version++
+}
+
+// ChangeSKU changes the stock keeping unit of the product; an empty SKU removes it.
+// It must not be the SKU of one of its variants.
+func (p *Product) ChangeSKU(sku string) error {
+	var skuVO SKU
+	if sku != "" {
+		var err error
+		if skuVO, err = NewSKU(sku); err != nil {
+			return err
+		}
+	}
+
+	if _, err := p.FindVariantBySKU(skuVO); skuVO != "" && err == nil {
+		return ErrDuplicateSKU
+	}
+
+	p.sku = skuVO
+	p.touch()
+	return nil
+}
+
 // ChangeName changes the product name
 func (p *Product) ChangeName(name string) error {
 	nameVO, err := NewName(name)
@@ -110,7 +276,7 @@ func (p *Product) ChangeName(name string) error {
 	}
 
 	p.name = nameVO
-	p.updatedAt = time.Now()
+	p.touch()
 	return nil
 }
 
@@ -122,7 +288,7 @@ func (p *Product) ChangeDescription(description string) error {
 	}
 
 	p.description = descriptionVO
-	p.updatedAt = time.Now()
+	p.touch()
 	return nil
 }
 
@@ -133,53 +299,181 @@ func (p *Product) ChangePrice(price float64) error {
 		return err
 	}
 
-	p.price = priceVO
-	p.updatedAt = time.Now()
+	p.setPrice(priceVO, time.Now())
+	p.touch()
 	return nil
 }
 
-// ChangeStock changes the product stock
-func (p *Product) ChangeStock(stock int) error {
+// ChangeShippingProfile changes the product weight and package dimensions
+func (p *Product) ChangeShippingProfile(weight, length, width, height float64) error {
+	weightVO, err := NewWeight(weight)
+	if err != nil {
+		return err
+	}
+
+	dimensionsVO, err := NewDimensions(length, width, height)
+	if err != nil {
+		return err
+	}
+
+	p.weight = weightVO
+	p.dimensions = dimensionsVO
+	p.touch()
+	return nil
+}
+
+// ChangeTaxCategory changes the product tax category
+func (p *Product) ChangeTaxCategory(category string) error {
+	categoryVO, err := NewTaxCategory(category)
+	if err != nil {
+		return err
+	}
+
+	p.taxCategory = categoryVO
+	p.touch()
+	return nil
+}
+
+// ChangeLanguage changes the language the product is written in
+func (p *Product) ChangeLanguage(language string) error {
+	languageVO, err := NewLanguage(language)
+	if err != nil {
+		return err
+	}
+
+	p.language = languageVO
+	p.touch()
+	return nil
+}
+
+// AssignCategories replaces the categories the product is assigned to
+func (p *Product) AssignCategories(categoryIDs []string) error {
+	ids := make([]category.ID, 0, len(categoryIDs))
+	seen := make(map[category.ID]bool)
+	for _, categoryID := range categoryIDs {
+		id, err := category.NewID(categoryID)
+		if err != nil {
+			return err
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	p.categoryIDs = ids
+	p.touch()
+	return nil
+}
+
+// ChangeStock changes the stock of the product held in a warehouse
+func (p *Product) ChangeStock(warehouseID warehouse.ID, stock int, reason MovementReason, reference Reference) error {
 	stockVO, err := NewStock(stock)
 	if err != nil {
 		return err
 	}
 
-	p.stock = stockVO
-	p.updatedAt = time.Now()
+	p.setStockLevel(warehouseID, "", stockVO, reason, reference)
+	p.touch()
+	return nil
+}
+
+// ChangeTotalStock changes the stock of the product, or of the given variant, across all warehouses
+// to total by changing the stock held in the given warehouse
+func (p *Product) ChangeTotalStock(warehouseID warehouse.ID, variantID VariantID, total int, reason MovementReason, reference Reference) error {
+	if _, err := NewStock(total); err != nil {
+		return err
+	}
+
+	current := p.stock
+	if variantID != "" {
+		v, err := p.FindVariant(variantID)
+		if err != nil {
+			return err
+		}
+		current = v.stock
+	}
+
+	held := p.StockIn(warehouseID, variantID)
+	stock, err := NewStock(total - current.Value() + held.Value())
+	if err != nil {
+		return ErrInsufficientStock
+	}
+
+	p.setStockLevel(warehouseID, variantID, stock, reason, reference)
+	p.touch()
 	return nil
 }
 
-// IncreaseStock increases the product stock
-func (p *Product) IncreaseStock(quantity int) error {
+// IncreaseStock increases the stock held in a warehouse of the product, or of the given variant
+// when the product has variants, allocating it to the order items waiting for it first
+func (p *Product) IncreaseStock(warehouseID warehouse.ID, variantID VariantID, quantity int, reason MovementReason, reference Reference) error {
 	if quantity <= 0 {
 		return ErrInvalidStock
 	}
 
-	newStock := p.stock.Value() + quantity
-	return p.ChangeStock(newStock)
+	v, err := p.ResolveVariant(variantID)
+	if err != nil {
+		return err
+	}
+
+	if v != nil {
+		variantID = v.id
+	}
+
+	p.setStockLevel(warehouseID, variantID, p.StockIn(warehouseID, variantID)+Stock(quantity), reason, reference)
+	p.touch()
+	return nil
 }
 
-// DecreaseStock decreases the product stock
-func (p *Product) DecreaseStock(quantity int) error {
+// DecreaseStock decreases the stock held in a warehouse of the product, or of the given variant
+// when the product has variants
+func (p *Product) DecreaseStock(warehouseID warehouse.ID, variantID VariantID, quantity int, reason MovementReason, reference Reference) error {
 	if quantity <= 0 {
 		return ErrInvalidStock
 	}
 
-	newStock := p.stock.Value() - quantity
-	if newStock < 0 {
-		return ErrInvalidStock
+	v, err := p.ResolveVariant(variantID)
+	if err != nil {
+		return err
+	}
+
+	if v != nil {
+		variantID = v.id
 	}
 
-	return p.ChangeStock(newStock)
+	held := p.StockIn(warehouseID, variantID)
+	if held.Value() < quantity {
+		return ErrInsufficientStock
+	}
+
+	p.setStockLevel(warehouseID, variantID, held-Stock(quantity), reason, reference)
+	p.touch()
+	return nil
 }
 
-// IsInStock checks if the product is in stock
+// IsInStock checks if the product, or any of its variants, is in stock
 func (p *Product) IsInStock() bool {
+	if p.HasVariants() {
+		for _, v := range p.variants {
+			if v.stock.Value() > 0 {
+				return true
+			}
+		}
+		return false
+	}
 	return p.stock.Value() > 0
 }
 
-// HasSufficientStock checks if the product has sufficient stock
-func (p *Product) HasSufficientStock(quantity int) bool {
+// HasSufficientStock checks if the product, or the given variant when the product has variants, has sufficient stock
+func (p *Product) HasSufficientStock(variantID VariantID, quantity int) bool {
+	v, err := p.ResolveVariant(variantID)
+	if err != nil {
+		return false
+	}
+
+	if v != nil {
+		return v.stock.Value() >= quantity
+	}
 	return p.stock.Value() >= quantity
 }
@@ -0,0 +1,145 @@
+package product
+
+import (
+	"math"
+	"time"
+)
+
+// ReorderThreshold returns the stock at or below which the product, or one of its variants,
+// is low on stock and should be reordered; zero disables low-stock alerts
+func (p *Product) ReorderThreshold() Stock {
+	return p.threshold
+}
+
+// ChangeReorderThreshold changes the reorder threshold of the product and its variants
+func (p *Product) ChangeReorderThreshold(threshold int) error {
+	thresholdVO, err := NewStock(threshold)
+	if err != nil {
+		return err
+	}
+
+	p.threshold = thresholdVO
+	p.touch()
+	return nil
+}
+
+// IsLowOnStock checks if the stock of the product, or of the given variant, is at or below the reorder threshold
+func (p *Product) IsLowOnStock(variantID VariantID) bool {
+	if p.threshold == 0 {
+		return false
+	}
+
+	if variantID == "" {
+		return p.stock <= p.threshold
+	}
+
+	v, err := p.FindVariant(variantID)
+	if err != nil {
+		return false
+	}
+	return v.stock <= p.threshold
+}
+
+// recordLowStock records a low-stock event when the stock of the product, or of the given variant,
+// falls from above the reorder threshold to or below it
+func (p *Product) recordLowStock(variantID VariantID, before, after Stock) {
+	if p.threshold == 0 || before <= p.threshold || after > p.threshold {
+		return
+	}
+
+	p.events = append(p.events, Event{
+		name:       EventLowStock,
+		productID:  p.id,
+		variantID:  variantID,
+		stock:      after,
+		threshold:  p.threshold,
+		occurredAt: time.Now(),
+	})
+}
+
+// StockSales represents the stock of a product, or of one of its variants, with the units sold
+// over a recent period, from which reorder quantities are suggested
+type StockSales struct {
+	productID ID
+	variantID VariantID
+	name      Name
+	sku       SKU
+	stock     Stock
+	threshold Stock
+	unitsSold int
+}
+
+// ReconstructStockSales rebuilds stock sales from persisted state
+func ReconstructStockSales(productID ID, variantID VariantID, name Name, sku SKU, stock, threshold Stock, unitsSold int) StockSales {
+	return StockSales{
+		productID: productID,
+		variantID: variantID,
+		name:      name,
+		sku:       sku,
+		stock:     stock,
+		threshold: threshold,
+		unitsSold: unitsSold,
+	}
+}
+
+// ProductID returns the product sold
+func (s StockSales) ProductID() ID {
+	return s.productID
+}
+
+// VariantID returns the variant sold, empty for the product itself
+func (s StockSales) VariantID() VariantID {
+	return s.variantID
+}
+
+// Name returns the product name
+func (s StockSales) Name() Name {
+	return s.name
+}
+
+// SKU returns the SKU of the product or variant, empty when it has none
+func (s StockSales) SKU() SKU {
+	return s.sku
+}
+
+// Stock returns the stock held across all warehouses
+func (s StockSales) Stock() Stock {
+	return s.stock
+}
+
+// Threshold returns the reorder threshold of the product
+func (s StockSales) Threshold() Stock {
+	return s.threshold
+}
+
+// UnitsSold returns the units sold over the period
+func (s StockSales) UnitsSold() int {
+	return s.unitsSold
+}
+
+// Velocity returns the average units sold per day over a period of the given number of days
+func (s StockSales) Velocity(days int) float64 {
+	if days <= 0 {
+		return 0
+	}
+	return float64(s.unitsSold) / float64(days)
+}
+
+// IsLow checks if the stock is at or below the reorder threshold
+func (s StockSales) IsLow() bool {
+	return s.threshold > 0 && s.stock <= s.threshold
+}
+
+// SuggestedReorder returns the quantity to reorder so that, at the velocity of a period of the given
+// number of days, the stock covers the given number of days of sales and stays above the threshold
+func (s StockSales) SuggestedReorder(days, coverDays int) int {
+	target := int(math.Ceil(s.Velocity(days)*float64(coverDays))) + s.threshold.Value()
+	if s.IsLow() && target <= s.stock.Value() {
+		target = s.stock.Value() + 1
+	}
+
+	if target <= s.stock.Value() {
+		return 0
+	}
+	return target - s.stock.Value()
+}
@@ -2,6 +2,8 @@ package product
 
 import (
 	"context"
+	"e-commerce/internal/domain/category"
+	"time"
 )
 
 // Repository defines the interface for product persistence operations
@@ -15,12 +17,39 @@ type Repository interface {
 	// Update updates an existing product
 	Update(ctx context.Context, product *Product) error
 
-	// Delete removes a product from the repository
-	Delete(ctx context.Context, id ID) error
+	// List retrieves the products matching a filter with pagination
+	List(ctx context.Context, filter Filter, limit, offset int) ([]*Product, error)
 
-	// List retrieves all products with pagination
-	List(ctx context.Context, limit, offset int) ([]*Product, error)
+	// Count counts the products matching a filter
+	Count(ctx context.Context, filter Filter) (int, error)
 
-	// Search searches for products by name or description
-	Search(ctx context.Context, query string, limit, offset int) ([]*Product, error)
+	// Facets counts the products matching a filter per attribute value
+	Facets(ctx context.Context, filter Filter) ([]Facet, error)
+
+	// FindBySKU retrieves the product with the given SKU or with a variant with it,
+	// returning ErrProductNotFound when there is none
+	FindBySKU(ctx context.Context, sku SKU) (*Product, error)
+
+	// FindByIDs retrieves the products with the given IDs, skipping missing ones
+	FindByIDs(ctx context.Context, ids []ID) ([]*Product, error)
+
+	// FindByCategories retrieves the available products assigned to any of the given categories with pagination
+	FindByCategories(ctx context.Context, categoryIDs []category.ID, limit, offset int) ([]*Product, error)
+
+	// FindPriceHistory retrieves the price changes of a product, most recent first, with pagination
+	FindPriceHistory(ctx context.Context, id ID, limit, offset int) ([]PriceChange, error)
+
+	// FindStockMovements retrieves the stock movements of a product, most recent first, with pagination
+	FindStockMovements(ctx context.Context, id ID, limit, offset int) ([]StockMovement, error)
+
+	// ReconcileStock recomputes the stock held in each warehouse from the stock ledger
+	// and returns the stock levels that differ from it
+	ReconcileStock(ctx context.Context) ([]StockDrift, error)
+
+	// FindStockSales retrieves the stock of each product without variants and of each variant that has a reorder
+	// threshold or was sold since the given time, with the units sold since then
+	FindStockSales(ctx context.Context, since time.Time) ([]StockSales, error)
+
+	// FindDuePriceSchedules retrieves the IDs of the products with a price schedule to start or end by the given time
+	FindDuePriceSchedules(ctx context.Context, now time.Time) ([]ID, error)
 }
@@ -0,0 +1,39 @@
+package product
+
+import (
+	"errors"
+	"strings"
+)
+
+// Search errors
+var (
+	ErrInvalidSearchQuery = errors.New("invalid search query")
+)
+
+// SearchQuery represents a full-text product search
+type SearchQuery struct {
+	// Text is the search text; it accepts quoted phrases, "or" and a leading "-" to exclude words
+	Text string
+
+	// Language selects the stemming rules the text is parsed with
+	Language Language
+}
+
+// NewSearchQuery creates a new SearchQuery, defaulting to the default language
+func NewSearchQuery(text, language string) (SearchQuery, error) {
+	trimmedText := strings.TrimSpace(text)
+	if trimmedText == "" {
+		return SearchQuery{}, ErrInvalidSearchQuery
+	}
+
+	languageVO := DefaultLanguage
+	if language != "" {
+		var err error
+		languageVO, err = NewLanguage(language)
+		if err != nil {
+			return SearchQuery{}, err
+		}
+	}
+
+	return SearchQuery{Text: trimmedText, Language: languageVO}, nil
+}
@@ -0,0 +1,155 @@
+package product
+
+import (
+	"e-commerce/internal/domain/warehouse"
+	"errors"
+	"time"
+)
+
+// ErrInvalidTransfer is returned when stock is transferred to the warehouse it is taken from
+var ErrInvalidTransfer = errors.New("stock must be transferred between two different warehouses")
+
+// StockLevel represents the stock of a product, or of one of its variants, held in a warehouse
+type StockLevel struct {
+	warehouseID warehouse.ID
+	variantID   VariantID
+	quantity    Stock
+}
+
+// ReconstructStockLevel rebuilds a stock level from persisted state
+func ReconstructStockLevel(warehouseID warehouse.ID, variantID VariantID, quantity Stock) StockLevel {
+	return StockLevel{warehouseID: warehouseID, variantID: variantID, quantity: quantity}
+}
+
+// WarehouseID returns the warehouse holding the stock
+func (l StockLevel) WarehouseID() warehouse.ID {
+	return l.warehouseID
+}
+
+// VariantID returns the variant the stock is of, empty for the product itself
+func (l StockLevel) VariantID() VariantID {
+	return l.variantID
+}
+
+// Quantity returns the quantity held
+func (l StockLevel) Quantity() Stock {
+	return l.quantity
+}
+
+// StockLevels returns the stock of the product and its variants per warehouse
+func (p *Product) StockLevels() []StockLevel {
+	return p.stockLevels
+}
+
+// StockIn returns the stock of the product, or of the given variant, held in a warehouse
+func (p *Product) StockIn(warehouseID warehouse.ID, variantID VariantID) Stock {
+	for _, level := range p.stockLevels {
+		if level.warehouseID == warehouseID && level.variantID == variantID {
+			return level.quantity
+		}
+	}
+	return 0
+}
+
+// TransferStock moves stock of the product, or of the given variant when the product has variants,
+// from one warehouse to another
+func (p *Product) TransferStock(variantID VariantID, from, to warehouse.ID, quantity int, reference Reference) error {
+	if from == to {
+		return ErrInvalidTransfer
+	}
+
+	if err := p.DecreaseStock(from, variantID, quantity, ReasonTransfer, reference); err != nil {
+		return err
+	}
+
+	return p.IncreaseStock(to, variantID, quantity, ReasonTransfer, reference)
+}
+
+// setStockLevel sets the stock held in a warehouse, records the movement in the stock ledger
+// and recounts the stock of the product or variant. Stock coming in is allocated to backorders first,
+// and what is left of it brings the product or variant back in stock.
+func (p *Product) setStockLevel(warehouseID warehouse.ID, variantID VariantID, quantity Stock, reason MovementReason, reference Reference) {
+	before := p.StockIn(warehouseID, variantID)
+	wasOutOfStock := p.stockOf(variantID) == 0
+
+	found := false
+	for i, level := range p.stockLevels {
+		if level.warehouseID == warehouseID && level.variantID == variantID {
+			p.recordStockMovement(warehouseID, variantID, reason, reference, level.quantity, quantity)
+			p.stockLevels[i].quantity = quantity
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		p.recordStockMovement(warehouseID, variantID, reason, reference, 0, quantity)
+		p.stockLevels = append(p.stockLevels, StockLevel{warehouseID: warehouseID, variantID: variantID, quantity: quantity})
+	}
+
+	p.recountStock(variantID)
+
+	if quantity > before {
+		p.fillBackorders(warehouseID, variantID)
+	}
+
+	if wasOutOfStock && p.stockOf(variantID) > 0 {
+		p.events = append(p.events, Event{
+			name:       EventBackInStock,
+			productID:  p.id,
+			variantID:  variantID,
+			stock:      p.stockOf(variantID),
+			occurredAt: time.Now(),
+		})
+	}
+}
+
+// stockOf returns the stock of the product, or of the given variant, across warehouses
+func (p *Product) stockOf(variantID VariantID) Stock {
+	if variantID == "" {
+		return p.stock
+	}
+
+	for _, v := range p.variants {
+		if v.id == variantID {
+			return v.stock
+		}
+	}
+	return 0
+}
+
+// recountStock sums the stock of the product or variant across warehouses
+func (p *Product) recountStock(variantID VariantID) {
+	total := Stock(0)
+	for _, level := range p.stockLevels {
+		if level.variantID == variantID {
+			total += level.quantity
+		}
+	}
+
+	if variantID == "" {
+		p.recordLowStock(variantID, p.stock, total)
+		p.stock = total
+		return
+	}
+
+	for _, v := range p.variants {
+		if v.id == variantID {
+			p.recordLowStock(variantID, v.stock, total)
+			v.stock = total
+		}
+	}
+}
+
+// removeStockLevels removes the stock levels of a variant, recording the stock written off
+func (p *Product) removeStockLevels(variantID VariantID) {
+	remaining := p.stockLevels[:0]
+	for _, level := range p.stockLevels {
+		if level.variantID != variantID {
+			remaining = append(remaining, level)
+			continue
+		}
+		p.recordStockMovement(level.warehouseID, variantID, ReasonAdjustment, Reference{}, level.quantity, 0)
+	}
+	p.stockLevels = remaining
+}
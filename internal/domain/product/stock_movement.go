@@ -0,0 +1,241 @@
+package product
+
+import (
+	"e-commerce/internal/domain/warehouse"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stock movement errors
+var (
+	ErrInvalidMovementReason = errors.New("invalid stock movement reason")
+	ErrInvalidReference      = errors.New("invalid stock movement reference")
+)
+
+// MovementReason represents why stock moved
+type MovementReason string
+
+const (
+	ReasonSale       MovementReason = "sale"
+	ReasonReturn     MovementReason = "return"
+	ReasonAdjustment MovementReason = "adjustment"
+	ReasonTransfer   MovementReason = "transfer"
+	ReasonDamage     MovementReason = "damage"
+)
+
+// NewMovementReason creates a new MovementReason
+func NewMovementReason(reason string) (MovementReason, error) {
+	switch r := MovementReason(reason); r {
+	case ReasonSale, ReasonReturn, ReasonAdjustment, ReasonTransfer, ReasonDamage:
+		return r, nil
+	}
+	return "", ErrInvalidMovementReason
+}
+
+// String returns the string representation of the MovementReason
+func (r MovementReason) String() string {
+	return string(r)
+}
+
+// Reference types
+const (
+	ReferenceOrder = "order"
+	ReferenceUser  = "user"
+)
+
+// Reference identifies the order or user behind a stock movement; the zero value references nothing
+type Reference struct {
+	kind string
+	id   string
+}
+
+// OrderReference references the order stock moved for
+func OrderReference(orderID string) Reference {
+	return Reference{kind: ReferenceOrder, id: orderID}
+}
+
+// UserReference references the user who moved stock; an empty ID references nothing
+func UserReference(userID string) Reference {
+	if userID == "" {
+		return Reference{}
+	}
+	return Reference{kind: ReferenceUser, id: userID}
+}
+
+// ReconstructReference rebuilds a reference from persisted state
+func ReconstructReference(kind, id string) Reference {
+	return Reference{kind: kind, id: id}
+}
+
+// Type returns the type of the referenced entity, order or user, empty when nothing is referenced
+func (r Reference) Type() string {
+	return r.kind
+}
+
+// ID returns the ID of the referenced entity
+func (r Reference) ID() string {
+	return r.id
+}
+
+// StockMovement represents a change of the stock of a product, or of one of its variants,
+// held in a warehouse, as recorded in the stock ledger
+type StockMovement struct {
+	id          string
+	warehouseID warehouse.ID
+	variantID   VariantID
+	reason      MovementReason
+	reference   Reference
+	before      Stock
+	after       Stock
+	occurredAt  time.Time
+}
+
+// ReconstructStockMovement rebuilds a stock movement from persisted state
+func ReconstructStockMovement(
+	id string,
+	warehouseID warehouse.ID,
+	variantID VariantID,
+	reason MovementReason,
+	reference Reference,
+	before Stock,
+	after Stock,
+	occurredAt time.Time,
+) StockMovement {
+	return StockMovement{
+		id:          id,
+		warehouseID: warehouseID,
+		variantID:   variantID,
+		reason:      reason,
+		reference:   reference,
+		before:      before,
+		after:       after,
+		occurredAt:  occurredAt,
+	}
+}
+
+// ID returns the movement ID
+func (m StockMovement) ID() string {
+	return m.id
+}
+
+// WarehouseID returns the warehouse the stock moved in
+func (m StockMovement) WarehouseID() warehouse.ID {
+	return m.warehouseID
+}
+
+// VariantID returns the variant the stock is of, empty for the product itself
+func (m StockMovement) VariantID() VariantID {
+	return m.variantID
+}
+
+// Reason returns why the stock moved
+func (m StockMovement) Reason() MovementReason {
+	return m.reason
+}
+
+// Reference returns the order or user behind the movement
+func (m StockMovement) Reference() Reference {
+	return m.reference
+}
+
+// Before returns the quantity held before the movement
+func (m StockMovement) Before() Stock {
+	return m.before
+}
+
+// After returns the quantity held after the movement
+func (m StockMovement) After() Stock {
+	return m.after
+}
+
+// Quantity returns the quantity moved, negative when stock left the warehouse
+func (m StockMovement) Quantity() int {
+	return m.after.Value() - m.before.Value()
+}
+
+// OccurredAt returns when the stock moved
+func (m StockMovement) OccurredAt() time.Time {
+	return m.occurredAt
+}
+
+// StockMovements returns the stock movements made since the product was loaded, to record in the stock ledger
+func (p *Product) StockMovements() []StockMovement {
+	return p.stockMovements
+}
+
+// recordStockMovement records a change of the stock held in a warehouse in the stock ledger
+func (p *Product) recordStockMovement(
+	warehouseID warehouse.ID,
+	variantID VariantID,
+	reason MovementReason,
+	reference Reference,
+	before Stock,
+	after Stock,
+) {
+	if before == after {
+		return
+	}
+
+	p.stockMovements = append(p.stockMovements, StockMovement{
+		id:          uuid.New().String(),
+		warehouseID: warehouseID,
+		variantID:   variantID,
+		reason:      reason,
+		reference:   reference,
+		before:      before,
+		after:       after,
+		occurredAt:  time.Now(),
+	})
+}
+
+// StockDrift represents stock held in a warehouse that differs from the stock recomputed from the ledger
+type StockDrift struct {
+	productID   ID
+	variantID   VariantID
+	warehouseID warehouse.ID
+	recorded    int
+	ledger      int
+}
+
+// ReconstructStockDrift rebuilds a stock drift from persisted state
+func ReconstructStockDrift(productID ID, variantID VariantID, warehouseID warehouse.ID, recorded, ledger int) StockDrift {
+	return StockDrift{
+		productID:   productID,
+		variantID:   variantID,
+		warehouseID: warehouseID,
+		recorded:    recorded,
+		ledger:      ledger,
+	}
+}
+
+// ProductID returns the product whose stock drifted
+func (d StockDrift) ProductID() ID {
+	return d.productID
+}
+
+// VariantID returns the variant whose stock drifted, empty for the product itself
+func (d StockDrift) VariantID() VariantID {
+	return d.variantID
+}
+
+// WarehouseID returns the warehouse holding the stock
+func (d StockDrift) WarehouseID() warehouse.ID {
+	return d.warehouseID
+}
+
+// Recorded returns the stock held according to the stock levels
+func (d StockDrift) Recorded() int {
+	return d.recorded
+}
+
+// Ledger returns the stock held according to the sum of the ledger movements
+func (d StockDrift) Ledger() int {
+	return d.ledger
+}
+
+// Drift returns how much the recorded stock exceeds the ledger stock
+func (d StockDrift) Drift() int {
+	return d.recorded - d.ledger
+}
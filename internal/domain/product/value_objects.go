@@ -85,3 +85,103 @@ func NewStock(stock int) (Stock, error) {
 func (s Stock) Value() int {
 	return int(s)
 }
+
+// Weight represents a product weight in kilograms
+type Weight float64
+
+// NewWeight creates a new Weight
+func NewWeight(weight float64) (Weight, error) {
+	if weight < 0 {
+		return 0, ErrInvalidWeight
+	}
+	return Weight(weight), nil
+}
+
+// Value returns the float64 value of the Weight
+func (w Weight) Value() float64 {
+	return float64(w)
+}
+
+// Dimensions represents the package dimensions of a product in centimeters
+type Dimensions struct {
+	length float64
+	width  float64
+	height float64
+}
+
+// NewDimensions creates new Dimensions
+func NewDimensions(length, width, height float64) (Dimensions, error) {
+	if length < 0 || width < 0 || height < 0 {
+		return Dimensions{}, ErrInvalidDimensions
+	}
+	return Dimensions{length: length, width: width, height: height}, nil
+}
+
+// Length returns the length in centimeters
+func (d Dimensions) Length() float64 {
+	return d.length
+}
+
+// Width returns the width in centimeters
+func (d Dimensions) Width() float64 {
+	return d.width
+}
+
+// Height returns the height in centimeters
+func (d Dimensions) Height() float64 {
+	return d.height
+}
+
+// Volume returns the volume in cubic centimeters
+func (d Dimensions) Volume() float64 {
+	return d.length * d.width * d.height
+}
+
+// TaxCategory represents the tax treatment of a product, such as "standard" or "reduced"
+type TaxCategory string
+
+// DefaultTaxCategory is the tax category of products without a specific treatment
+const DefaultTaxCategory TaxCategory = "standard"
+
+// NewTaxCategory creates a new TaxCategory
+func NewTaxCategory(category string) (TaxCategory, error) {
+	normalized := strings.ToLower(strings.TrimSpace(category))
+	if normalized == "" {
+		return "", ErrInvalidTaxCategory
+	}
+	return TaxCategory(normalized), nil
+}
+
+// String returns the string representation of the TaxCategory
+func (c TaxCategory) String() string {
+	return string(c)
+}
+
+// Language represents the language a product is written in, named after the
+// text search configuration used to stem its name and description
+type Language string
+
+// DefaultLanguage is the language of products created without one
+const DefaultLanguage Language = "english"
+
+// supportedLanguages lists the text search configurations shipped with PostgreSQL
+var supportedLanguages = map[string]bool{
+	"simple": true, "arabic": true, "danish": true, "dutch": true, "english": true,
+	"finnish": true, "french": true, "german": true, "hungarian": true, "italian": true,
+	"norwegian": true, "portuguese": true, "romanian": true, "russian": true,
+	"spanish": true, "swedish": true, "turkish": true,
+}
+
+// NewLanguage creates a new Language
+func NewLanguage(language string) (Language, error) {
+	normalized := strings.ToLower(strings.TrimSpace(language))
+	if !supportedLanguages[normalized] {
+		return "", ErrInvalidLanguage
+	}
+	return Language(normalized), nil
+}
+
+// String returns the string representation of the Language
+func (l Language) String() string {
+	return string(l)
+}
@@ -0,0 +1,396 @@
+package product
+
+import (
+	"e-commerce/internal/domain/warehouse"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Variant errors
+var (
+	ErrInvalidSKU       = errors.New("invalid SKU")
+	ErrInvalidOption    = errors.New("invalid product option")
+	ErrVariantNotFound  = errors.New("product variant not found")
+	ErrVariantRequired  = errors.New("product has variants, a variant must be selected")
+	ErrDuplicateVariant = errors.New("a variant with the same SKU or options already exists")
+)
+
+// VariantID represents a product variant identifier
+type VariantID string
+
+// NewVariantID creates a new VariantID
+func NewVariantID(id string) (VariantID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", ErrVariantNotFound
+	}
+	return VariantID(id), nil
+}
+
+// String returns the string representation of the VariantID
+func (id VariantID) String() string {
+	return string(id)
+}
+
+// SKU represents the stock keeping unit of a product or variant
+type SKU string
+
+var skuPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9._-]{0,63}$`)
+
+// NewSKU creates a new SKU, normalized to upper case
+func NewSKU(sku string) (SKU, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(sku))
+	if !skuPattern.MatchString(normalized) {
+		return "", ErrInvalidSKU
+	}
+	return SKU(normalized), nil
+}
+
+// String returns the string representation of the SKU
+func (s SKU) String() string {
+	return string(s)
+}
+
+// Option represents an option type of a product, such as size or color, with its allowed values
+type Option struct {
+	name   string
+	values []string
+}
+
+// NewOption creates a new Option
+func NewOption(name string, values []string) (Option, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(values) == 0 {
+		return Option{}, ErrInvalidOption
+	}
+
+	seen := make(map[string]bool, len(values))
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			return Option{}, ErrInvalidOption
+		}
+		seen[value] = true
+		normalized = append(normalized, value)
+	}
+
+	return Option{name: name, values: normalized}, nil
+}
+
+// ReconstructOption rebuilds an option from persisted state
+func ReconstructOption(name string, values []string) Option {
+	return Option{name: name, values: values}
+}
+
+// Name returns the option name
+func (o Option) Name() string {
+	return o.name
+}
+
+// Values returns the allowed option values
+func (o Option) Values() []string {
+	return o.values
+}
+
+// Allows checks if a value is allowed for the option
+func (o Option) Allows(value string) bool {
+	for _, v := range o.values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Variant represents a purchasable combination of option values of a product
+type Variant struct {
+	id           VariantID
+	sku          SKU
+	optionValues map[string]string
+	price        Price
+	stock        Stock
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// ReconstructVariant rebuilds a variant from persisted state
+func ReconstructVariant(
+	id VariantID,
+	sku SKU,
+	optionValues map[string]string,
+	price Price,
+	stock Stock,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Variant {
+	return &Variant{
+		id:           id,
+		sku:          sku,
+		optionValues: optionValues,
+		price:        price,
+		stock:        stock,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// ID returns the variant ID
+func (v *Variant) ID() VariantID {
+	return v.id
+}
+
+// SKU returns the variant SKU
+func (v *Variant) SKU() SKU {
+	return v.sku
+}
+
+// OptionValues returns the value of each product option for the variant
+func (v *Variant) OptionValues() map[string]string {
+	return v.optionValues
+}
+
+// PriceOverride returns the variant price, zero when the product price applies
+func (v *Variant) PriceOverride() Price {
+	return v.price
+}
+
+// Stock returns the variant stock across all warehouses
+func (v *Variant) Stock() Stock {
+	return v.stock
+}
+
+// CreatedAt returns the variant creation time
+func (v *Variant) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+// UpdatedAt returns the variant last update time
+func (v *Variant) UpdatedAt() time.Time {
+	return v.updatedAt
+}
+
+// ChangePriceOverride changes the variant price; zero removes the override
+func (v *Variant) ChangePriceOverride(price float64) error {
+	if price < 0 {
+		return ErrInvalidPrice
+	}
+
+	v.price = Price(price)
+	v.updatedAt = time.Now()
+	return nil
+}
+
+// matches checks if the variant has the given option values
+func (v *Variant) matches(optionValues map[string]string) bool {
+	if len(v.optionValues) != len(optionValues) {
+		return false
+	}
+	for name, value := range optionValues {
+		if v.optionValues[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Options returns the option types of the product
+func (p *Product) Options() []Option {
+	return p.options
+}
+
+// Variants returns the variants of the product
+func (p *Product) Variants() []*Variant {
+	return p.variants
+}
+
+// HasVariants checks if the product is sold through variants
+func (p *Product) HasVariants() bool {
+	return len(p.variants) > 0
+}
+
+// ChangeOptions replaces the option types of the product.
+// Existing variants must still have exactly one allowed value for each option.
+func (p *Product) ChangeOptions(options []Option) error {
+	names := make(map[string]bool, len(options))
+	for _, option := range options {
+		if names[option.name] {
+			return ErrInvalidOption
+		}
+		names[option.name] = true
+	}
+
+	for _, v := range p.variants {
+		if err := validateOptionValues(options, v.optionValues); err != nil {
+			return err
+		}
+	}
+
+	p.options = options
+	p.touch()
+	return nil
+}
+
+// AddVariant adds a variant with a value for each product option.
+// A zero price makes the variant use the product price.
+func (p *Product) AddVariant(sku string, optionValues map[string]string, price float64) (*Variant, error) {
+	id, err := NewVariantID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	skuVO, err := NewSKU(sku)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateOptionValues(p.options, optionValues); err != nil {
+		return nil, err
+	}
+
+	if skuVO == p.sku {
+		return nil, ErrDuplicateVariant
+	}
+
+	for _, existing := range p.variants {
+		if existing.sku == skuVO || existing.matches(optionValues) {
+			return nil, ErrDuplicateVariant
+		}
+	}
+
+	if price < 0 {
+		return nil, ErrInvalidPrice
+	}
+
+	now := time.Now()
+	v := &Variant{
+		id:           id,
+		sku:          skuVO,
+		optionValues: optionValues,
+		price:        Price(price),
+		createdAt:    now,
+		updatedAt:    now,
+	}
+
+	p.variants = append(p.variants, v)
+	p.touch()
+	return v, nil
+}
+
+// FindVariant returns a variant by ID
+func (p *Product) FindVariant(id VariantID) (*Variant, error) {
+	for _, v := range p.variants {
+		if v.id == id {
+			return v, nil
+		}
+	}
+	return nil, ErrVariantNotFound
+}
+
+// FindVariantBySKU returns a variant by SKU
+func (p *Product) FindVariantBySKU(sku SKU) (*Variant, error) {
+	for _, v := range p.variants {
+		if v.sku == sku {
+			return v, nil
+		}
+	}
+	return nil, ErrVariantNotFound
+}
+
+// ChangeVariantPrice changes the price override of a variant
+func (p *Product) ChangeVariantPrice(id VariantID, price float64) error {
+	v, err := p.FindVariant(id)
+	if err != nil {
+		return err
+	}
+
+	before, _ := p.UnitPrice(v.id)
+	if err := v.ChangePriceOverride(price); err != nil {
+		return err
+	}
+
+	after, _ := p.UnitPrice(v.id)
+	p.recordPriceDrop(v.id, before, after)
+	p.touch()
+	return nil
+}
+
+// ChangeVariantStock changes the stock of a variant held in a warehouse
+func (p *Product) ChangeVariantStock(id VariantID, warehouseID warehouse.ID, stock int, reason MovementReason, reference Reference) error {
+	v, err := p.FindVariant(id)
+	if err != nil {
+		return err
+	}
+
+	stockVO, err := NewStock(stock)
+	if err != nil {
+		return err
+	}
+
+	p.setStockLevel(warehouseID, v.id, stockVO, reason, reference)
+	v.updatedAt = time.Now()
+	p.touch()
+	return nil
+}
+
+// RemoveVariant removes a variant
+func (p *Product) RemoveVariant(id VariantID) error {
+	for i, v := range p.variants {
+		if v.id == id {
+			p.variants = append(p.variants[:i], p.variants[i+1:]...)
+			p.removeStockLevels(id)
+			p.touch()
+			return nil
+		}
+	}
+	return ErrVariantNotFound
+}
+
+// ResolveVariant returns the variant selected for a purchase, nil for products without variants.
+// Products with variants can only be bought through one of them.
+func (p *Product) ResolveVariant(id VariantID) (*Variant, error) {
+	if !p.HasVariants() {
+		if id != "" {
+			return nil, ErrVariantNotFound
+		}
+		return nil, nil
+	}
+
+	if id == "" {
+		return nil, ErrVariantRequired
+	}
+
+	return p.FindVariant(id)
+}
+
+// UnitPrice returns the price of the product or of the selected variant
+func (p *Product) UnitPrice(variantID VariantID) (Price, error) {
+	v, err := p.ResolveVariant(variantID)
+	if err != nil {
+		return 0, err
+	}
+
+	if v != nil && v.price > 0 {
+		return v.price, nil
+	}
+	return p.price, nil
+}
+
+// validateOptionValues checks that option values give exactly one allowed value for each option
+func validateOptionValues(options []Option, optionValues map[string]string) error {
+	if len(optionValues) != len(options) {
+		return ErrInvalidOption
+	}
+
+	for _, option := range options {
+		value, ok := optionValues[option.name]
+		if !ok || !option.Allows(value) {
+			return ErrInvalidOption
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package promotion
+
+import (
+	"e-commerce/pkg/money"
+	"sort"
+	"time"
+)
+
+// Outcome explains the result of evaluating one promotion
+type Outcome struct {
+	Promotion *Promotion
+	Applied   bool
+
+	// LineAdjustments holds the discount of each line, in the order of the evaluated lines
+	LineAdjustments []float64
+	Amount          float64
+
+	// Reason explains why the promotion did not apply, nil when applied
+	Reason error
+}
+
+// Result represents the promotions evaluated against a set of lines
+type Result struct {
+	Outcomes []*Outcome
+
+	// LineAdjustments holds the combined discount of each line
+	LineAdjustments []float64
+}
+
+// Total returns the combined discount of all applied promotions
+func (r *Result) Total() float64 {
+	total := 0.0
+	for _, adjustment := range r.LineAdjustments {
+		total += adjustment
+	}
+	return total
+}
+
+// Evaluate applies promotions to lines by descending priority. Each promotion
+// sees line amounts reduced by the promotions applied before it. A promotion
+// that is not stackable only applies alone: it is skipped when another promotion
+// already applied, and once it applies every later promotion is skipped.
+func Evaluate(promotions []*Promotion, lines []Line, now time.Time) *Result {
+	ordered := make([]*Promotion, len(promotions))
+	copy(ordered, promotions)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].priority != ordered[j].priority {
+			return ordered[i].priority > ordered[j].priority
+		}
+		return ordered[i].createdAt.Before(ordered[j].createdAt)
+	})
+
+	current := make([]Line, len(lines))
+	copy(current, lines)
+
+	result := &Result{LineAdjustments: make([]float64, len(lines))}
+	applied := 0
+	blocked := false
+
+	for _, p := range ordered {
+		outcome := &Outcome{Promotion: p}
+		result.Outcomes = append(result.Outcomes, outcome)
+
+		if blocked {
+			outcome.Reason = ErrBlocked
+			continue
+		}
+
+		adjustments, err := p.Evaluate(current, now)
+		if err != nil {
+			outcome.Reason = err
+			continue
+		}
+
+		if !p.stackable && applied > 0 {
+			outcome.Reason = ErrNotStackable
+			continue
+		}
+
+		outcome.Applied = true
+		outcome.LineAdjustments = adjustments
+		for i, adjustment := range adjustments {
+			current[i].Amount -= adjustment
+			result.LineAdjustments[i] += adjustment
+			outcome.Amount += adjustment
+		}
+		outcome.Amount = money.Round(outcome.Amount)
+
+		applied++
+		if !p.stackable {
+			blocked = true
+		}
+	}
+
+	for i := range result.LineAdjustments {
+		result.LineAdjustments[i] = money.Round(result.LineAdjustments[i])
+	}
+
+	return result
+}
@@ -0,0 +1,429 @@
+package promotion
+
+import (
+	"e-commerce/internal/domain/product"
+	"e-commerce/pkg/money"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Promotion errors
+var (
+	ErrInvalidName        = errors.New("promotion name cannot be empty")
+	ErrInvalidType        = errors.New("invalid promotion type")
+	ErrInvalidValue       = errors.New("invalid promotion value")
+	ErrInvalidTier        = errors.New("invalid promotion tier")
+	ErrInvalidValidity    = errors.New("invalid promotion validity window")
+	ErrPromotionInactive  = errors.New("promotion is not active")
+	ErrNotStarted         = errors.New("promotion has not started yet")
+	ErrExpired            = errors.New("promotion has ended")
+	ErrMinimumNotMet      = errors.New("cart does not reach the promotion minimum value")
+	ErrNoEligibleProducts = errors.New("no products in the cart are eligible for the promotion")
+	ErrTierNotReached     = errors.New("cart does not reach the lowest quantity tier")
+	ErrNotStackable       = errors.New("promotion does not stack with promotions already applied")
+	ErrBlocked            = errors.New("a promotion already applied does not stack with others")
+)
+
+// Promotion represents an automatic promotion aggregate root
+type Promotion struct {
+	id            ID
+	name          string
+	description   string
+	promotionType Type
+	value         float64
+	tiers         []Tier
+	priority      int
+	stackable     bool
+	minOrderValue float64
+	validFrom     time.Time
+	validUntil    time.Time
+	productIDs    []product.ID
+	categoryIDs   []string
+	active        bool
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewPromotion creates a new active promotion.
+// value is a percentage (0-100] for percentage promotions and an amount for
+// fixed-amount promotions; volume-tiered promotions use tiers instead.
+func NewPromotion(name, promotionType string, value float64, tiers []Tier, priority int, stackable bool) (*Promotion, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return nil, ErrInvalidName
+	}
+
+	typeVO, err := NewType(promotionType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateReward(typeVO, value, tiers); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Promotion{
+		id:            id,
+		name:          strings.TrimSpace(name),
+		promotionType: typeVO,
+		value:         value,
+		tiers:         sortTiers(tiers),
+		priority:      priority,
+		stackable:     stackable,
+		active:        true,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// Reconstruct rebuilds a promotion from persisted state
+func Reconstruct(
+	id ID,
+	name string,
+	description string,
+	promotionType Type,
+	value float64,
+	tiers []Tier,
+	priority int,
+	stackable bool,
+	minOrderValue float64,
+	validFrom time.Time,
+	validUntil time.Time,
+	productIDs []product.ID,
+	categoryIDs []string,
+	active bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Promotion {
+	return &Promotion{
+		id:            id,
+		name:          name,
+		description:   description,
+		promotionType: promotionType,
+		value:         value,
+		tiers:         tiers,
+		priority:      priority,
+		stackable:     stackable,
+		minOrderValue: minOrderValue,
+		validFrom:     validFrom,
+		validUntil:    validUntil,
+		productIDs:    productIDs,
+		categoryIDs:   categoryIDs,
+		active:        active,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// ID returns the promotion ID
+func (p *Promotion) ID() ID {
+	return p.id
+}
+
+// Name returns the promotion name
+func (p *Promotion) Name() string {
+	return p.name
+}
+
+// Description returns the promotion description
+func (p *Promotion) Description() string {
+	return p.description
+}
+
+// Type returns the promotion type
+func (p *Promotion) Type() Type {
+	return p.promotionType
+}
+
+// Value returns the percentage or amount of the promotion
+func (p *Promotion) Value() float64 {
+	return p.value
+}
+
+// Tiers returns the quantity tiers of a volume-tiered promotion, lowest first
+func (p *Promotion) Tiers() []Tier {
+	return p.tiers
+}
+
+// Priority returns the priority; higher priorities are evaluated first
+func (p *Promotion) Priority() int {
+	return p.priority
+}
+
+// IsStackable returns whether the promotion combines with other promotions
+func (p *Promotion) IsStackable() bool {
+	return p.stackable
+}
+
+// MinOrderValue returns the minimum cart subtotal required for the promotion
+func (p *Promotion) MinOrderValue() float64 {
+	return p.minOrderValue
+}
+
+// ValidFrom returns the start of the validity window, zero when open
+func (p *Promotion) ValidFrom() time.Time {
+	return p.validFrom
+}
+
+// ValidUntil returns the end of the validity window, zero when open
+func (p *Promotion) ValidUntil() time.Time {
+	return p.validUntil
+}
+
+// ProductIDs returns the products the promotion is restricted to
+func (p *Promotion) ProductIDs() []product.ID {
+	return p.productIDs
+}
+
+// CategoryIDs returns the categories the promotion is restricted to
+func (p *Promotion) CategoryIDs() []string {
+	return p.categoryIDs
+}
+
+// IsActive returns whether the promotion is enabled
+func (p *Promotion) IsActive() bool {
+	return p.active
+}
+
+// CreatedAt returns when the promotion was created
+func (p *Promotion) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// UpdatedAt returns when the promotion was last updated
+func (p *Promotion) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// ChangeDetails changes the name and description of the promotion
+func (p *Promotion) ChangeDetails(name, description string) error {
+	if strings.TrimSpace(name) == "" {
+		return ErrInvalidName
+	}
+
+	p.name = strings.TrimSpace(name)
+	p.description = description
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeReward changes the value and tiers of the promotion
+func (p *Promotion) ChangeReward(value float64, tiers []Tier) error {
+	if err := validateReward(p.promotionType, value, tiers); err != nil {
+		return err
+	}
+
+	p.value = value
+	p.tiers = sortTiers(tiers)
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeStacking changes the priority and stacking behaviour of the promotion
+func (p *Promotion) ChangeStacking(priority int, stackable bool) {
+	p.priority = priority
+	p.stackable = stackable
+	p.updatedAt = time.Now()
+}
+
+// ChangeValidity changes the validity window; zero times leave that side open
+func (p *Promotion) ChangeValidity(validFrom, validUntil time.Time) error {
+	if !validFrom.IsZero() && !validUntil.IsZero() && !validUntil.After(validFrom) {
+		return ErrInvalidValidity
+	}
+
+	p.validFrom = validFrom
+	p.validUntil = validUntil
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeMinOrderValue changes the minimum subtotal required for the promotion
+func (p *Promotion) ChangeMinOrderValue(minOrderValue float64) error {
+	if minOrderValue < 0 {
+		return ErrInvalidValue
+	}
+
+	p.minOrderValue = minOrderValue
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeEligibility restricts the promotion to products and categories.
+// A line is eligible when it matches either list; empty lists make every line eligible.
+func (p *Promotion) ChangeEligibility(productIDs, categoryIDs []string) error {
+	products := make([]product.ID, 0, len(productIDs))
+	for _, productID := range productIDs {
+		id, err := product.NewID(productID)
+		if err != nil {
+			return err
+		}
+		products = append(products, id)
+	}
+
+	categories := make([]string, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		if categoryID == "" {
+			return ErrInvalidValue
+		}
+		categories = append(categories, categoryID)
+	}
+
+	p.productIDs = products
+	p.categoryIDs = categories
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// Activate enables the promotion
+func (p *Promotion) Activate() {
+	p.active = true
+	p.updatedAt = time.Now()
+}
+
+// Deactivate disables the promotion
+func (p *Promotion) Deactivate() {
+	p.active = false
+	p.updatedAt = time.Now()
+}
+
+// IsEligible checks if a line can be discounted by the promotion
+func (p *Promotion) IsEligible(line Line) bool {
+	if len(p.productIDs) == 0 && len(p.categoryIDs) == 0 {
+		return true
+	}
+
+	for _, id := range p.productIDs {
+		if id == line.ProductID {
+			return true
+		}
+	}
+
+	for _, categoryID := range p.categoryIDs {
+		for _, lineCategoryID := range line.CategoryIDs {
+			if categoryID == lineCategoryID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Evaluate returns the adjustment the promotion makes to each line,
+// or an error explaining why it does not apply
+func (p *Promotion) Evaluate(lines []Line, now time.Time) ([]float64, error) {
+	if !p.active {
+		return nil, ErrPromotionInactive
+	}
+
+	if !p.validFrom.IsZero() && now.Before(p.validFrom) {
+		return nil, ErrNotStarted
+	}
+
+	if !p.validUntil.IsZero() && !now.Before(p.validUntil) {
+		return nil, ErrExpired
+	}
+
+	subtotal := 0.0
+	eligibleTotal := 0.0
+	eligibleQuantity := 0
+	eligible := make([]bool, len(lines))
+	for i, line := range lines {
+		subtotal += line.Amount
+		if p.IsEligible(line) && line.Amount > 0 {
+			eligible[i] = true
+			eligibleTotal += line.Amount
+			eligibleQuantity += line.Quantity
+		}
+	}
+
+	if subtotal < p.minOrderValue {
+		return nil, ErrMinimumNotMet
+	}
+
+	if eligibleTotal == 0 {
+		return nil, ErrNoEligibleProducts
+	}
+
+	adjustments := make([]float64, len(lines))
+
+	switch p.promotionType {
+	case TypePercentage:
+		for i, line := range lines {
+			if eligible[i] {
+				adjustments[i] = money.Round(line.Amount * p.value / 100)
+			}
+		}
+	case TypeFixedAmount:
+		// Spread the amount over eligible lines in proportion to their amounts
+		amount := math.Min(p.value, eligibleTotal)
+		remaining := amount
+		last := -1
+		for i, line := range lines {
+			if eligible[i] {
+				adjustments[i] = money.Round(amount * line.Amount / eligibleTotal)
+				remaining -= adjustments[i]
+				last = i
+			}
+		}
+		adjustments[last] = money.Round(adjustments[last] + remaining)
+	case TypeVolumeTiered:
+		// The highest tier reached by the eligible quantity applies to every eligible line
+		var reached *Tier
+		for i := range p.tiers {
+			if eligibleQuantity >= p.tiers[i].minQuantity {
+				reached = &p.tiers[i]
+			}
+		}
+		if reached == nil {
+			return nil, ErrTierNotReached
+		}
+		for i, line := range lines {
+			if eligible[i] {
+				adjustments[i] = money.Round(line.Amount * reached.percentage / 100)
+			}
+		}
+	}
+
+	return adjustments, nil
+}
+
+// validateReward checks the value and tiers of a promotion type
+func validateReward(promotionType Type, value float64, tiers []Tier) error {
+	switch promotionType {
+	case TypePercentage:
+		if value <= 0 || value > 100 {
+			return ErrInvalidValue
+		}
+	case TypeFixedAmount:
+		if value <= 0 {
+			return ErrInvalidValue
+		}
+	case TypeVolumeTiered:
+		if len(tiers) == 0 {
+			return ErrInvalidTier
+		}
+	}
+	return nil
+}
+
+// sortTiers returns the tiers ordered by increasing minimum quantity
+func sortTiers(tiers []Tier) []Tier {
+	sorted := make([]Tier, len(tiers))
+	copy(sorted, tiers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].minQuantity < sorted[j].minQuantity
+	})
+	return sorted
+}
@@ -0,0 +1,26 @@
+package promotion
+
+import (
+	"context"
+)
+
+// Repository defines the interface for promotion persistence operations
+type Repository interface {
+	// Save persists a promotion to the repository
+	Save(ctx context.Context, promotion *Promotion) error
+
+	// FindByID retrieves a promotion by ID
+	FindByID(ctx context.Context, id ID) (*Promotion, error)
+
+	// FindActive retrieves all active promotions, whatever their validity window
+	FindActive(ctx context.Context) ([]*Promotion, error)
+
+	// Update updates an existing promotion
+	Update(ctx context.Context, promotion *Promotion) error
+
+	// Delete removes a promotion from the repository
+	Delete(ctx context.Context, id ID) error
+
+	// List retrieves all promotions with pagination
+	List(ctx context.Context, limit, offset int) ([]*Promotion, error)
+}
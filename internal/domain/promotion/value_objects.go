@@ -0,0 +1,79 @@
+package promotion
+
+import (
+	"e-commerce/internal/domain/product"
+	"errors"
+	"strings"
+)
+
+// ID represents a promotion ID value object
+type ID string
+
+// NewID creates a new promotion ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", errors.New("promotion ID cannot be empty")
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the promotion ID
+func (id ID) String() string {
+	return string(id)
+}
+
+// Type represents how a promotion discounts a cart
+type Type string
+
+const (
+	TypePercentage   Type = "percentage"
+	TypeFixedAmount  Type = "fixed_amount"
+	TypeVolumeTiered Type = "volume_tiered"
+)
+
+// NewType creates a new Type
+func NewType(promotionType string) (Type, error) {
+	switch Type(promotionType) {
+	case TypePercentage, TypeFixedAmount, TypeVolumeTiered:
+		return Type(promotionType), nil
+	}
+	return "", ErrInvalidType
+}
+
+// String returns the string representation of the Type
+func (t Type) String() string {
+	return string(t)
+}
+
+// Tier represents the percentage taken off once a quantity of eligible units is reached
+type Tier struct {
+	minQuantity int
+	percentage  float64
+}
+
+// NewTier creates a new Tier
+func NewTier(minQuantity int, percentage float64) (Tier, error) {
+	if minQuantity <= 0 || percentage <= 0 || percentage > 100 {
+		return Tier{}, ErrInvalidTier
+	}
+	return Tier{minQuantity: minQuantity, percentage: percentage}, nil
+}
+
+// MinQuantity returns the number of eligible units needed to reach the tier
+func (t Tier) MinQuantity() int {
+	return t.minQuantity
+}
+
+// Percentage returns the percentage taken off in the tier
+func (t Tier) Percentage() float64 {
+	return t.percentage
+}
+
+// Line represents a priced cart line a promotion is evaluated against.
+// Amount is what the line costs after promotions applied before this one.
+type Line struct {
+	ProductID   product.ID
+	CategoryIDs []string
+	Quantity    int
+	Amount      float64
+}
@@ -0,0 +1,35 @@
+package review
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+)
+
+// Repository defines the interface for review persistence operations
+type Repository interface {
+	// Save persists a review to the repository
+	Save(ctx context.Context, review *Review) error
+
+	// FindByID retrieves a review by ID
+	FindByID(ctx context.Context, id ID) (*Review, error)
+
+	// FindByProductAndUser retrieves the review of a product by a user
+	FindByProductAndUser(ctx context.Context, productID product.ID, userID user.ID) (*Review, error)
+
+	// Update updates an existing review with its votes
+	Update(ctx context.Context, review *Review) error
+
+	// Delete removes a review from the repository
+	Delete(ctx context.Context, id ID) error
+
+	// ListByProduct retrieves the reviews of a product in a moderation state, optionally only verified ones,
+	// in the given order with pagination
+	ListByProduct(ctx context.Context, productID product.ID, status Status, verifiedOnly bool, sort Sort, limit, offset int) ([]*Review, error)
+
+	// ListByStatus retrieves the reviews in a moderation state, oldest first, with pagination
+	ListByStatus(ctx context.Context, status Status, limit, offset int) ([]*Review, error)
+
+	// Summarize retrieves the approved ratings of the given products, keyed by product
+	Summarize(ctx context.Context, productIDs []product.ID) (map[product.ID]RatingSummary, error)
+}
@@ -0,0 +1,300 @@
+package review
+
+import (
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Review errors
+var (
+	ErrInvalidID        = errors.New("invalid review ID")
+	ErrInvalidProductID = errors.New("invalid product ID")
+	ErrInvalidUserID    = errors.New("invalid user ID")
+	ErrInvalidRating    = errors.New("rating must be between 1 and 5")
+	ErrInvalidTitle     = errors.New("review title must be between 1 and 150 characters")
+	ErrInvalidBody      = errors.New("review body must be at most 5000 characters")
+	ErrInvalidStatus    = errors.New("invalid review status")
+	ErrInvalidSort      = errors.New("invalid review sort")
+	ErrReviewNotFound   = errors.New("review not found")
+	ErrDuplicateReview  = errors.New("user has already reviewed this product")
+	ErrNotAuthor        = errors.New("review can only be changed by its author")
+	ErrOwnReview        = errors.New("users cannot vote on their own review")
+	ErrNotApproved      = errors.New("only approved reviews can be voted on")
+)
+
+// Vote represents whether a user found a review helpful
+type Vote struct {
+	userID  user.ID
+	helpful bool
+	votedAt time.Time
+}
+
+// ReconstructVote rebuilds a vote from persisted state
+func ReconstructVote(userID user.ID, helpful bool, votedAt time.Time) *Vote {
+	return &Vote{userID: userID, helpful: helpful, votedAt: votedAt}
+}
+
+// UserID returns the user who voted
+func (v *Vote) UserID() user.ID {
+	return v.userID
+}
+
+// Helpful checks if the user found the review helpful
+func (v *Vote) Helpful() bool {
+	return v.helpful
+}
+
+// VotedAt returns when the user voted
+func (v *Vote) VotedAt() time.Time {
+	return v.votedAt
+}
+
+// Review represents the review aggregate root: a user's rating of a product with a title and body,
+// shown to customers once approved
+type Review struct {
+	id             ID
+	productID      product.ID
+	userID         user.ID
+	rating         Rating
+	title          Title
+	body           Body
+	verified       bool
+	status         Status
+	moderationNote string
+	votes          []*Vote
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewReview creates a new review awaiting moderation; verified tells whether the user
+// has received the product in a delivered order
+func NewReview(productID, userID string, rating int, title, body string, verified bool) (*Review, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	productIDVO, err := product.NewID(productID)
+	if err != nil {
+		return nil, ErrInvalidProductID
+	}
+
+	userIDVO, err := user.NewID(userID)
+	if err != nil {
+		return nil, ErrInvalidUserID
+	}
+
+	r := &Review{
+		id:        id,
+		productID: productIDVO,
+		userID:    userIDVO,
+		verified:  verified,
+		status:    StatusPending,
+		votes:     []*Vote{},
+	}
+
+	if err := r.setContent(rating, title, body); err != nil {
+		return nil, err
+	}
+
+	r.createdAt = r.updatedAt
+	return r, nil
+}
+
+// Reconstruct rebuilds a review from persisted state
+func Reconstruct(
+	id ID,
+	productID product.ID,
+	userID user.ID,
+	rating Rating,
+	title Title,
+	body Body,
+	verified bool,
+	status Status,
+	moderationNote string,
+	votes []*Vote,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Review {
+	return &Review{
+		id:             id,
+		productID:      productID,
+		userID:         userID,
+		rating:         rating,
+		title:          title,
+		body:           body,
+		verified:       verified,
+		status:         status,
+		moderationNote: moderationNote,
+		votes:          votes,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// ID returns the review ID
+func (r *Review) ID() ID {
+	return r.id
+}
+
+// ProductID returns the product reviewed
+func (r *Review) ProductID() product.ID {
+	return r.productID
+}
+
+// UserID returns the author of the review
+func (r *Review) UserID() user.ID {
+	return r.userID
+}
+
+// Rating returns the number of stars given
+func (r *Review) Rating() Rating {
+	return r.rating
+}
+
+// Title returns the review title
+func (r *Review) Title() Title {
+	return r.title
+}
+
+// Body returns the review body
+func (r *Review) Body() Body {
+	return r.body
+}
+
+// IsVerified checks if the author received the product in a delivered order
+func (r *Review) IsVerified() bool {
+	return r.verified
+}
+
+// Status returns the moderation state of the review
+func (r *Review) Status() Status {
+	return r.status
+}
+
+// ModerationNote returns the reason given when the review was moderated, empty when none was given
+func (r *Review) ModerationNote() string {
+	return r.moderationNote
+}
+
+// Votes returns the helpfulness votes on the review
+func (r *Review) Votes() []*Vote {
+	return r.votes
+}
+
+// CreatedAt returns the review creation time
+func (r *Review) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns the review last update time
+func (r *Review) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// IsApproved checks if the review is shown to customers
+func (r *Review) IsApproved() bool {
+	return r.status == StatusApproved
+}
+
+// HelpfulVotes returns the number of users who found the review helpful
+func (r *Review) HelpfulVotes() int {
+	count := 0
+	for _, v := range r.votes {
+		if v.helpful {
+			count++
+		}
+	}
+	return count
+}
+
+// UnhelpfulVotes returns the number of users who did not find the review helpful
+func (r *Review) UnhelpfulVotes() int {
+	return len(r.votes) - r.HelpfulVotes()
+}
+
+// Edit changes the rating, title and body of the review on behalf of its author, sending it back
+// to moderation; verified tells whether the author has received the product by now
+func (r *Review) Edit(userID string, rating int, title, body string, verified bool) error {
+	if r.userID.String() != userID {
+		return ErrNotAuthor
+	}
+
+	if err := r.setContent(rating, title, body); err != nil {
+		return err
+	}
+
+	r.verified = r.verified || verified
+	r.status = StatusPending
+	r.moderationNote = ""
+	return nil
+}
+
+// Moderate approves or rejects the review, with an optional note explaining why
+func (r *Review) Moderate(status string, note string) error {
+	statusVO, err := NewStatus(status)
+	if err != nil {
+		return err
+	}
+
+	r.status = statusVO
+	r.moderationNote = note
+	r.updatedAt = time.Now()
+	return nil
+}
+
+// Vote records whether a user found an approved review helpful, replacing any previous vote of the user;
+// authors cannot vote on their own review
+func (r *Review) Vote(userID string, helpful bool) error {
+	userIDVO, err := user.NewID(userID)
+	if err != nil {
+		return ErrInvalidUserID
+	}
+
+	if userIDVO == r.userID {
+		return ErrOwnReview
+	}
+
+	if !r.IsApproved() {
+		return ErrNotApproved
+	}
+
+	for _, v := range r.votes {
+		if v.userID == userIDVO {
+			v.helpful = helpful
+			v.votedAt = time.Now()
+			return nil
+		}
+	}
+
+	r.votes = append(r.votes, &Vote{userID: userIDVO, helpful: helpful, votedAt: time.Now()})
+	return nil
+}
+
+// setContent validates and sets the rating, title and body of the review
+func (r *Review) setContent(rating int, title, body string) error {
+	ratingVO, err := NewRating(rating)
+	if err != nil {
+		return err
+	}
+
+	titleVO, err := NewTitle(title)
+	if err != nil {
+		return err
+	}
+
+	bodyVO, err := NewBody(body)
+	if err != nil {
+		return err
+	}
+
+	r.rating = ratingVO
+	r.title = titleVO
+	r.body = bodyVO
+	r.updatedAt = time.Now()
+	return nil
+}
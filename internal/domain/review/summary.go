@@ -0,0 +1,52 @@
+package review
+
+import "math"
+
+// RatingSummary represents the approved ratings of a product: how many reviews gave each number of stars
+type RatingSummary struct {
+	counts [MaxRating]int
+}
+
+// ReconstructRatingSummary rebuilds a rating summary from the number of reviews per rating,
+// ignoring ratings out of bounds
+func ReconstructRatingSummary(counts map[Rating]int) RatingSummary {
+	var s RatingSummary
+	for rating, count := range counts {
+		if rating >= MinRating && rating <= MaxRating {
+			s.counts[rating-1] = count
+		}
+	}
+	return s
+}
+
+// Count returns the number of ratings
+func (s RatingSummary) Count() int {
+	total := 0
+	for _, count := range s.counts {
+		total += count
+	}
+	return total
+}
+
+// Average returns the average rating rounded to one decimal, zero without ratings
+func (s RatingSummary) Average() float64 {
+	count := s.Count()
+	if count == 0 {
+		return 0
+	}
+
+	sum := 0
+	for i, c := range s.counts {
+		sum += (i + 1) * c
+	}
+	return math.Round(float64(sum)/float64(count)*10) / 10
+}
+
+// Distribution returns the number of ratings per number of stars, from 1 to 5
+func (s RatingSummary) Distribution() map[int]int {
+	distribution := make(map[int]int, MaxRating)
+	for i, count := range s.counts {
+		distribution[i+1] = count
+	}
+	return distribution
+}
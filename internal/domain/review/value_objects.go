@@ -0,0 +1,140 @@
+package review
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ID represents a review ID value object
+type ID string
+
+// NewID creates a new review ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", ErrInvalidID
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the review ID
+func (id ID) String() string {
+	return string(id)
+}
+
+// Rating bounds
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// Rating represents the number of stars given to a product, from 1 to 5
+type Rating int
+
+// NewRating creates a new Rating
+func NewRating(rating int) (Rating, error) {
+	if rating < MinRating || rating > MaxRating {
+		return 0, ErrInvalidRating
+	}
+	return Rating(rating), nil
+}
+
+// Value returns the value of the Rating
+func (r Rating) Value() int {
+	return int(r)
+}
+
+const maxTitleLength = 150
+
+// Title represents the headline of a review
+type Title string
+
+// NewTitle creates a new Title
+func NewTitle(title string) (Title, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > maxTitleLength {
+		return "", ErrInvalidTitle
+	}
+	return Title(title), nil
+}
+
+// String returns the string representation of the Title
+func (t Title) String() string {
+	return string(t)
+}
+
+const maxBodyLength = 5000
+
+// Body represents the text of a review, which may be empty
+type Body string
+
+// NewBody creates a new Body
+func NewBody(body string) (Body, error) {
+	body = strings.TrimSpace(body)
+	if utf8.RuneCountInString(body) > maxBodyLength {
+		return "", ErrInvalidBody
+	}
+	return Body(body), nil
+}
+
+// String returns the string representation of the Body
+func (b Body) String() string {
+	return string(b)
+}
+
+// Status represents the moderation state of a review
+type Status string
+
+const (
+	// StatusPending awaits moderation and is not shown to customers
+	StatusPending Status = "pending"
+
+	// StatusApproved is shown to customers and counted in the product rating
+	StatusApproved Status = "approved"
+
+	// StatusRejected is hidden from customers
+	StatusRejected Status = "rejected"
+)
+
+// NewStatus creates a new Status
+func NewStatus(status string) (Status, error) {
+	switch s := Status(status); s {
+	case StatusPending, StatusApproved, StatusRejected:
+		return s, nil
+	}
+	return "", ErrInvalidStatus
+}
+
+// String returns the string representation of the Status
+func (s Status) String() string {
+	return string(s)
+}
+
+// Sort represents the order reviews are listed in
+type Sort string
+
+const (
+	// SortNewest lists the most recent reviews first
+	SortNewest Sort = "newest"
+
+	// SortHelpful lists the reviews with the most helpful votes first
+	SortHelpful Sort = "helpful"
+
+	// SortHighest lists the highest rated reviews first
+	SortHighest Sort = "highest"
+
+	// SortLowest lists the lowest rated reviews first
+	SortLowest Sort = "lowest"
+)
+
+// NewSort creates a new Sort, defaulting to the newest reviews first
+func NewSort(sort string) (Sort, error) {
+	if sort == "" {
+		return SortNewest, nil
+	}
+
+	switch s := Sort(sort); s {
+	case SortNewest, SortHelpful, SortHighest, SortLowest:
+		return s, nil
+	}
+	return "", ErrInvalidSort
+}
@@ -0,0 +1,27 @@
+package shipping
+
+import (
+	"context"
+)
+
+// Repository defines the interface for shipping zone persistence operations
+type Repository interface {
+	// Save persists a zone to the repository
+	Save(ctx context.Context, zone *Zone) error
+
+	// FindByID retrieves a zone by ID
+	FindByID(ctx context.Context, id ID) (*Zone, error)
+
+	// FindByCountry retrieves the zone covering a country, falling back to
+	// the zone without countries when no specific zone matches
+	FindByCountry(ctx context.Context, country CountryCode) (*Zone, error)
+
+	// Update updates an existing zone
+	Update(ctx context.Context, zone *Zone) error
+
+	// Delete removes a zone from the repository
+	Delete(ctx context.Context, id ID) error
+
+	// List retrieves all zones
+	List(ctx context.Context) ([]*Zone, error)
+}
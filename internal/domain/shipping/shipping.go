@@ -0,0 +1,295 @@
+package shipping
+
+import (
+	"e-commerce/pkg/money"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Shipping errors
+var (
+	ErrInvalidZoneName    = errors.New("invalid shipping zone name")
+	ErrInvalidCountry     = errors.New("invalid country code")
+	ErrInvalidMethod      = errors.New("invalid shipping method")
+	ErrInvalidRateType    = errors.New("invalid shipping rate type")
+	ErrInvalidAmount      = errors.New("invalid shipping amount")
+	ErrInvalidTier        = errors.New("invalid weight tier")
+	ErrDuplicateMethod    = errors.New("shipping method already defined for zone")
+	ErrMethodNotAvailable = errors.New("shipping method not available for destination")
+	ErrWeightNotCovered   = errors.New("parcel weight exceeds the rate's weight tiers")
+	ErrZoneNotFound       = errors.New("no shipping zone covers the destination")
+)
+
+// Rate represents the price table of a shipping method within a zone
+type Rate struct {
+	id            ID
+	method        Method
+	rateType      RateType
+	amount        float64
+	freeThreshold float64
+	tiers         []WeightTier
+}
+
+// NewRate creates a new shipping rate.
+// For flat rates amount is the price charged; for free-over-threshold rates it
+// is the price charged while the subtotal is below freeThreshold; for
+// weight-tiered rates the price comes from the tiers.
+func NewRate(method, rateType string, amount, freeThreshold float64, tiers []WeightTier) (*Rate, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	methodVO, err := NewMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	rateTypeVO, err := NewRateType(rateType)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount < 0 || freeThreshold < 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	if rateTypeVO == RateTypeWeightTiered && len(tiers) == 0 {
+		return nil, ErrInvalidTier
+	}
+
+	if rateTypeVO == RateTypeFreeOverThreshold && freeThreshold == 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	sorted := make([]WeightTier, len(tiers))
+	copy(sorted, tiers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].maxWeight < sorted[j].maxWeight
+	})
+
+	return &Rate{
+		id:            id,
+		method:        methodVO,
+		rateType:      rateTypeVO,
+		amount:        amount,
+		freeThreshold: freeThreshold,
+		tiers:         sorted,
+	}, nil
+}
+
+// ReconstructRate rebuilds a rate from persisted state
+func ReconstructRate(id ID, method Method, rateType RateType, amount, freeThreshold float64, tiers []WeightTier) *Rate {
+	return &Rate{
+		id:            id,
+		method:        method,
+		rateType:      rateType,
+		amount:        amount,
+		freeThreshold: freeThreshold,
+		tiers:         tiers,
+	}
+}
+
+// ID returns the rate ID
+func (r *Rate) ID() ID {
+	return r.id
+}
+
+// Method returns the shipping method
+func (r *Rate) Method() Method {
+	return r.method
+}
+
+// Type returns the rate type
+func (r *Rate) Type() RateType {
+	return r.rateType
+}
+
+// Amount returns the base amount of the rate
+func (r *Rate) Amount() float64 {
+	return r.amount
+}
+
+// FreeThreshold returns the subtotal from which shipping is free
+func (r *Rate) FreeThreshold() float64 {
+	return r.freeThreshold
+}
+
+// Tiers returns the weight tiers ordered by ascending maximum weight
+func (r *Rate) Tiers() []WeightTier {
+	return r.tiers
+}
+
+// Calculate returns the shipping cost for a parcel
+func (r *Rate) Calculate(parcel Parcel) (float64, error) {
+	switch r.rateType {
+	case RateTypeFlat:
+		return r.amount, nil
+	case RateTypeFreeOverThreshold:
+		if parcel.Subtotal() >= r.freeThreshold {
+			return 0, nil
+		}
+		return r.amount, nil
+	case RateTypeWeightTiered:
+		for _, tier := range r.tiers {
+			if parcel.Weight() <= tier.maxWeight {
+				return tier.price, nil
+			}
+		}
+		return 0, ErrWeightNotCovered
+	}
+	return 0, ErrInvalidRateType
+}
+
+// Zone represents the shipping zone aggregate root.
+// A zone without countries is the fallback zone used for every destination
+// not covered by a more specific zone.
+type Zone struct {
+	id        ID
+	name      string
+	countries []CountryCode
+	rates     []*Rate
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewZone creates a new shipping zone
+func NewZone(name string, countries []string) (*Zone, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	trimmedName := strings.TrimSpace(name)
+	if trimmedName == "" {
+		return nil, ErrInvalidZoneName
+	}
+
+	codes := make([]CountryCode, 0, len(countries))
+	for _, country := range countries {
+		code, err := NewCountryCode(country)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+
+	now := time.Now()
+
+	return &Zone{
+		id:        id,
+		name:      trimmedName,
+		countries: codes,
+		rates:     []*Rate{},
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructZone rebuilds a zone from persisted state
+func ReconstructZone(id ID, name string, countries []CountryCode, rates []*Rate, createdAt, updatedAt time.Time) *Zone {
+	return &Zone{
+		id:        id,
+		name:      name,
+		countries: countries,
+		rates:     rates,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the zone ID
+func (z *Zone) ID() ID {
+	return z.id
+}
+
+// Name returns the zone name
+func (z *Zone) Name() string {
+	return z.name
+}
+
+// Countries returns the countries covered by the zone
+func (z *Zone) Countries() []CountryCode {
+	return z.countries
+}
+
+// Rates returns the rates offered in the zone
+func (z *Zone) Rates() []*Rate {
+	return z.rates
+}
+
+// CreatedAt returns the zone creation time
+func (z *Zone) CreatedAt() time.Time {
+	return z.createdAt
+}
+
+// UpdatedAt returns the zone last update time
+func (z *Zone) UpdatedAt() time.Time {
+	return z.updatedAt
+}
+
+// IsFallback checks if the zone applies to every uncovered destination
+func (z *Zone) IsFallback() bool {
+	return len(z.countries) == 0
+}
+
+// Covers checks if the zone ships to a country
+func (z *Zone) Covers(country CountryCode) bool {
+	if z.IsFallback() {
+		return true
+	}
+	for _, c := range z.countries {
+		if c == country {
+			return true
+		}
+	}
+	return false
+}
+
+// AddRate adds a rate to the zone
+func (z *Zone) AddRate(rate *Rate) error {
+	for _, r := range z.rates {
+		if r.method == rate.method {
+			return ErrDuplicateMethod
+		}
+	}
+
+	z.rates = append(z.rates, rate)
+	z.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveRate removes the rate of a shipping method from the zone
+func (z *Zone) RemoveRate(method string) error {
+	for i, r := range z.rates {
+		if r.method.String() == method {
+			z.rates = append(z.rates[:i], z.rates[i+1:]...)
+			z.updatedAt = time.Now()
+			return nil
+		}
+	}
+	return ErrMethodNotAvailable
+}
+
+// Quote returns the cost of shipping a parcel with a method
+func (z *Zone) Quote(method string, parcel Parcel) (float64, error) {
+	methodVO, err := NewMethod(method)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, r := range z.rates {
+		if r.method == methodVO {
+			cost, err := r.Calculate(parcel)
+			if err != nil {
+				return 0, err
+			}
+			return money.Round(cost), nil
+		}
+	}
+	return 0, ErrMethodNotAvailable
+}
@@ -0,0 +1,138 @@
+package shipping
+
+import (
+	"errors"
+	"strings"
+)
+
+// ID represents a shipping zone or rate ID value object
+type ID string
+
+// NewID creates a new shipping ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", errors.New("shipping ID cannot be empty")
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the shipping ID
+func (id ID) String() string {
+	return string(id)
+}
+
+// CountryCode represents an ISO 3166-1 alpha-2 country code
+type CountryCode string
+
+// NewCountryCode creates a new CountryCode
+func NewCountryCode(code string) (CountryCode, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if len(normalized) != 2 {
+		return "", ErrInvalidCountry
+	}
+	for _, r := range normalized {
+		if r < 'A' || r > 'Z' {
+			return "", ErrInvalidCountry
+		}
+	}
+	return CountryCode(normalized), nil
+}
+
+// String returns the string representation of the CountryCode
+func (c CountryCode) String() string {
+	return string(c)
+}
+
+// Method represents a shipping method such as "standard" or "express"
+type Method string
+
+// NewMethod creates a new Method
+func NewMethod(method string) (Method, error) {
+	normalized := strings.ToLower(strings.TrimSpace(method))
+	if normalized == "" {
+		return "", ErrInvalidMethod
+	}
+	return Method(normalized), nil
+}
+
+// String returns the string representation of the Method
+func (m Method) String() string {
+	return string(m)
+}
+
+// RateType represents how a shipping rate is calculated
+type RateType string
+
+const (
+	RateTypeFlat              RateType = "flat"
+	RateTypeWeightTiered      RateType = "weight_tiered"
+	RateTypeFreeOverThreshold RateType = "free_over_threshold"
+)
+
+// NewRateType creates a new RateType
+func NewRateType(rateType string) (RateType, error) {
+	switch RateType(rateType) {
+	case RateTypeFlat, RateTypeWeightTiered, RateTypeFreeOverThreshold:
+		return RateType(rateType), nil
+	}
+	return "", ErrInvalidRateType
+}
+
+// String returns the string representation of the RateType
+func (t RateType) String() string {
+	return string(t)
+}
+
+// WeightTier represents the price charged up to a maximum parcel weight
+type WeightTier struct {
+	maxWeight float64
+	price     float64
+}
+
+// NewWeightTier creates a new WeightTier
+func NewWeightTier(maxWeight, price float64) (WeightTier, error) {
+	if maxWeight <= 0 || price < 0 {
+		return WeightTier{}, ErrInvalidTier
+	}
+	return WeightTier{maxWeight: maxWeight, price: price}, nil
+}
+
+// MaxWeight returns the maximum weight in kilograms covered by the tier
+func (t WeightTier) MaxWeight() float64 {
+	return t.maxWeight
+}
+
+// Price returns the price of the tier
+func (t WeightTier) Price() float64 {
+	return t.price
+}
+
+// VolumetricDivisor converts a volume in cubic centimeters to a volumetric weight in kilograms
+const VolumetricDivisor = 5000.0
+
+// Parcel represents the goods being shipped
+type Parcel struct {
+	weight   float64
+	subtotal float64
+}
+
+// Add adds a line of goods to the parcel, charging the greater of the actual
+// and volumetric weight for each unit
+func (p *Parcel) Add(weight, volume float64, quantity int, lineTotal float64) {
+	chargeable := weight
+	if volumetric := volume / VolumetricDivisor; volumetric > chargeable {
+		chargeable = volumetric
+	}
+	p.weight += chargeable * float64(quantity)
+	p.subtotal += lineTotal
+}
+
+// Weight returns the chargeable weight of the parcel in kilograms
+func (p Parcel) Weight() float64 {
+	return p.weight
+}
+
+// Subtotal returns the value of the goods in the parcel
+func (p Parcel) Subtotal() float64 {
+	return p.subtotal
+}
@@ -0,0 +1,83 @@
+package tax
+
+import (
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/shipping"
+	"e-commerce/pkg/money"
+	"strings"
+)
+
+// Line represents the tax charged by one rule on a priced line
+type Line struct {
+	Name      string
+	Rate      float64
+	Amount    float64
+	Inclusive bool
+}
+
+// Calculator computes the taxes due on priced lines for a destination
+type Calculator struct {
+	rules   []*Rule
+	country shipping.CountryCode
+	region  string
+}
+
+// NewCalculator creates a new Calculator for a destination using the rules
+// defined for its country
+func NewCalculator(rules []*Rule, country shipping.CountryCode, region string) *Calculator {
+	return &Calculator{
+		rules:   rules,
+		country: country,
+		region:  strings.ToUpper(strings.TrimSpace(region)),
+	}
+}
+
+// Calculate returns the tax lines for a line of a category sold for amount,
+// the line total after discounts. Inclusive taxes are extracted from the
+// amount; exclusive taxes are charged on the amount net of inclusive taxes.
+func (c *Calculator) Calculate(category product.TaxCategory, amount float64) []Line {
+	var applicable []*Rule
+	inclusiveRate := 0.0
+	for _, rule := range c.rules {
+		if rule.AppliesTo(c.country, c.region, category) {
+			applicable = append(applicable, rule)
+			if rule.inclusive {
+				inclusiveRate += rule.rate.Value()
+			}
+		}
+	}
+
+	net := amount / (1 + inclusiveRate)
+
+	lines := make([]Line, 0, len(applicable))
+	for _, rule := range applicable {
+		lines = append(lines, Line{
+			Name:      rule.name,
+			Rate:      rule.rate.Value(),
+			Amount:    money.Round(net * rule.rate.Value()),
+			Inclusive: rule.inclusive,
+		})
+	}
+
+	return lines
+}
+
+// ExclusiveTotal returns the sum of the tax lines added on top of prices
+func ExclusiveTotal(lines []Line) float64 {
+	total := 0.0
+	for _, line := range lines {
+		if !line.Inclusive {
+			total += line.Amount
+		}
+	}
+	return total
+}
+
+// Total returns the sum of all tax lines
+func Total(lines []Line) float64 {
+	total := 0.0
+	for _, line := range lines {
+		total += line.Amount
+	}
+	return total
+}
@@ -0,0 +1,24 @@
+package tax
+
+import (
+	"context"
+	"e-commerce/internal/domain/shipping"
+)
+
+// Repository defines the interface for tax rule persistence operations
+type Repository interface {
+	// Save persists a rule to the repository
+	Save(ctx context.Context, rule *Rule) error
+
+	// FindByID retrieves a rule by ID
+	FindByID(ctx context.Context, id ID) (*Rule, error)
+
+	// FindByCountry retrieves all rules of a country, including regional ones
+	FindByCountry(ctx context.Context, country shipping.CountryCode) ([]*Rule, error)
+
+	// Delete removes a rule from the repository
+	Delete(ctx context.Context, id ID) error
+
+	// List retrieves all rules
+	List(ctx context.Context) ([]*Rule, error)
+}
@@ -0,0 +1,161 @@
+package tax
+
+import (
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/shipping"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tax errors
+var (
+	ErrInvalidName   = errors.New("invalid tax rule name")
+	ErrInvalidRate   = errors.New("invalid tax rate")
+	ErrInvalidRegion = errors.New("invalid tax region")
+)
+
+// Rule represents the tax rule aggregate root.
+// A rule taxes one product category in a country, or only in one region of
+// that country when region is set. Rules for a country and for one of its
+// regions stack, e.g. a federal and a provincial sales tax.
+type Rule struct {
+	id        ID
+	name      string
+	country   shipping.CountryCode
+	region    string
+	category  product.TaxCategory
+	rate      Rate
+	inclusive bool
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewRule creates a new tax rule.
+// Inclusive rules are already contained in catalog prices (e.g. VAT in the
+// EU); exclusive rules are added on top of them (e.g. US sales tax).
+func NewRule(name, country, region, category string, rate float64, inclusive bool) (*Rule, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	trimmedName := strings.TrimSpace(name)
+	if trimmedName == "" {
+		return nil, ErrInvalidName
+	}
+
+	countryVO, err := shipping.NewCountryCode(country)
+	if err != nil {
+		return nil, err
+	}
+
+	regionVO := strings.ToUpper(strings.TrimSpace(region))
+	if len(regionVO) > 10 {
+		return nil, ErrInvalidRegion
+	}
+
+	categoryVO, err := product.NewTaxCategory(category)
+	if err != nil {
+		return nil, err
+	}
+
+	rateVO, err := NewRate(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Rule{
+		id:        id,
+		name:      trimmedName,
+		country:   countryVO,
+		region:    regionVO,
+		category:  categoryVO,
+		rate:      rateVO,
+		inclusive: inclusive,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructRule rebuilds a rule from persisted state
+func ReconstructRule(
+	id ID,
+	name string,
+	country shipping.CountryCode,
+	region string,
+	category product.TaxCategory,
+	rate Rate,
+	inclusive bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Rule {
+	return &Rule{
+		id:        id,
+		name:      name,
+		country:   country,
+		region:    region,
+		category:  category,
+		rate:      rate,
+		inclusive: inclusive,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the rule ID
+func (r *Rule) ID() ID {
+	return r.id
+}
+
+// Name returns the rule name shown on tax lines, e.g. "VAT"
+func (r *Rule) Name() string {
+	return r.name
+}
+
+// Country returns the country the rule applies to
+func (r *Rule) Country() shipping.CountryCode {
+	return r.country
+}
+
+// Region returns the region the rule is limited to, empty for the whole country
+func (r *Rule) Region() string {
+	return r.region
+}
+
+// Category returns the product tax category the rule applies to
+func (r *Rule) Category() product.TaxCategory {
+	return r.category
+}
+
+// Rate returns the tax rate
+func (r *Rule) Rate() Rate {
+	return r.rate
+}
+
+// Inclusive checks if catalog prices already include the tax
+func (r *Rule) Inclusive() bool {
+	return r.inclusive
+}
+
+// CreatedAt returns the rule creation time
+func (r *Rule) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns the rule last update time
+func (r *Rule) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// AppliesTo checks if the rule taxes a category at a destination
+func (r *Rule) AppliesTo(country shipping.CountryCode, region string, category product.TaxCategory) bool {
+	if r.country != country || r.category != category {
+		return false
+	}
+	return r.region == "" || strings.EqualFold(r.region, region)
+}
@@ -0,0 +1,38 @@
+package tax
+
+import (
+	"errors"
+	"strings"
+)
+
+// ID represents a tax rule ID value object
+type ID string
+
+// NewID creates a new tax rule ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", errors.New("tax rule ID cannot be empty")
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the tax rule ID
+func (id ID) String() string {
+	return string(id)
+}
+
+// Rate represents a tax rate as a fraction, e.g. 0.2 for 20%
+type Rate float64
+
+// NewRate creates a new Rate
+func NewRate(rate float64) (Rate, error) {
+	if rate < 0 || rate >= 1 {
+		return 0, ErrInvalidRate
+	}
+	return Rate(rate), nil
+}
+
+// Value returns the float64 value of the Rate
+func (r Rate) Value() float64 {
+	return float64(r)
+}
@@ -0,0 +1,180 @@
+package user
+
+import (
+	"e-commerce/internal/domain/address"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SavedAddress represents an address saved in a user's address book
+type SavedAddress struct {
+	id        AddressID
+	label     string
+	address   address.Address
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// ReconstructSavedAddress rebuilds a saved address from persisted state
+func ReconstructSavedAddress(id AddressID, label string, addr address.Address, createdAt, updatedAt time.Time) *SavedAddress {
+	return &SavedAddress{
+		id:        id,
+		label:     label,
+		address:   addr,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the saved address ID
+func (a *SavedAddress) ID() AddressID {
+	return a.id
+}
+
+// Label returns the label chosen by the user, such as "Home" or "Work"
+func (a *SavedAddress) Label() string {
+	return a.label
+}
+
+// Address returns the postal address
+func (a *SavedAddress) Address() address.Address {
+	return a.address
+}
+
+// CreatedAt returns when the address was saved
+func (a *SavedAddress) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// UpdatedAt returns when the address was last updated
+func (a *SavedAddress) UpdatedAt() time.Time {
+	return a.updatedAt
+}
+
+// Addresses returns the user's saved addresses
+func (u *User) Addresses() []*SavedAddress {
+	return u.addresses
+}
+
+// DefaultShippingAddressID returns the ID of the default shipping address, empty when none
+func (u *User) DefaultShippingAddressID() AddressID {
+	return u.defaultShippingAddressID
+}
+
+// DefaultBillingAddressID returns the ID of the default billing address, empty when none
+func (u *User) DefaultBillingAddressID() AddressID {
+	return u.defaultBillingAddressID
+}
+
+// FindAddress returns a saved address by ID
+func (u *User) FindAddress(id AddressID) (*SavedAddress, error) {
+	for _, saved := range u.addresses {
+		if saved.id == id {
+			return saved, nil
+		}
+	}
+	return nil, ErrAddressNotFound
+}
+
+// DefaultShippingAddress returns the default shipping address, nil when none
+func (u *User) DefaultShippingAddress() *SavedAddress {
+	saved, _ := u.FindAddress(u.defaultShippingAddressID)
+	return saved
+}
+
+// DefaultBillingAddress returns the default billing address, nil when none
+func (u *User) DefaultBillingAddress() *SavedAddress {
+	saved, _ := u.FindAddress(u.defaultBillingAddressID)
+	return saved
+}
+
+// AddAddress saves an address in the address book.
+// The first saved address becomes the default shipping and billing address.
+func (u *User) AddAddress(label string, addr address.Address) (*SavedAddress, error) {
+	if addr.IsZero() {
+		return nil, address.ErrInvalidLine
+	}
+
+	now := time.Now()
+	saved := &SavedAddress{
+		id:        AddressID(uuid.New().String()),
+		label:     strings.TrimSpace(label),
+		address:   addr,
+		createdAt: now,
+		updatedAt: now,
+	}
+
+	u.addresses = append(u.addresses, saved)
+	if u.defaultShippingAddressID == "" {
+		u.defaultShippingAddressID = saved.id
+	}
+	if u.defaultBillingAddressID == "" {
+		u.defaultBillingAddressID = saved.id
+	}
+
+	u.updatedAt = now
+	return saved, nil
+}
+
+// UpdateAddress changes the label and address of a saved address
+func (u *User) UpdateAddress(id AddressID, label string, addr address.Address) error {
+	saved, err := u.FindAddress(id)
+	if err != nil {
+		return err
+	}
+
+	if addr.IsZero() {
+		return address.ErrInvalidLine
+	}
+
+	now := time.Now()
+	saved.label = strings.TrimSpace(label)
+	saved.address = addr
+	saved.updatedAt = now
+	u.updatedAt = now
+	return nil
+}
+
+// RemoveAddress removes a saved address, clearing it as a default
+func (u *User) RemoveAddress(id AddressID) error {
+	for i, saved := range u.addresses {
+		if saved.id == id {
+			u.addresses = append(u.addresses[:i], u.addresses[i+1:]...)
+
+			if u.defaultShippingAddressID == id {
+				u.defaultShippingAddressID = ""
+			}
+			if u.defaultBillingAddressID == id {
+				u.defaultBillingAddressID = ""
+			}
+
+			u.updatedAt = time.Now()
+			return nil
+		}
+	}
+	return ErrAddressNotFound
+}
+
+// SetDefaultShippingAddress makes a saved address the default shipping address
+func (u *User) SetDefaultShippingAddress(id AddressID) error {
+	if _, err := u.FindAddress(id); err != nil {
+		return err
+	}
+
+	u.defaultShippingAddressID = id
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// SetDefaultBillingAddress makes a saved address the default billing address
+func (u *User) SetDefaultBillingAddress(id AddressID) error {
+	if _, err := u.FindAddress(id); err != nil {
+		return err
+	}
+
+	u.defaultBillingAddressID = id
+	u.updatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,47 @@
+package user
+
+import "time"
+
+// Event names
+const (
+	EventRegistered             = "user.registered"
+	EventPasswordResetRequested = "user.password_reset_requested"
+	EventVerificationRequested  = "user.verification_requested"
+)
+
+// Event represents something that happened to a user account
+type Event struct {
+	name       string
+	userID     ID
+	occurredAt time.Time
+
+	resetToken PasswordResetToken
+}
+
+// Name returns the event name
+func (e Event) Name() string {
+	return e.name
+}
+
+// UserID returns the ID of the user
+func (e Event) UserID() ID {
+	return e.userID
+}
+
+// OccurredAt returns when it happened
+func (e Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// PasswordResetToken returns the token of a requested password reset, the only place it is kept
+// in plain text, empty for other events
+func (e Event) PasswordResetToken() PasswordResetToken {
+	return e.resetToken
+}
+
+// PullEvents returns the events recorded since the user was loaded and clears them
+func (u *User) PullEvents() []Event {
+	events := u.events
+	u.events = nil
+	return events
+}
@@ -0,0 +1,72 @@
+package user
+
+import (
+	"crypto/subtle"
+	"errors"
+	"time"
+)
+
+// Password reset errors
+var (
+	ErrInvalidResetToken        = errors.New("invalid password reset token")
+	ErrResetTokenExpired        = errors.New("password reset token has expired")
+	ErrPasswordResetRateLimited = errors.New("a password reset was requested too recently, try again later")
+)
+
+// PasswordResetTokenHash returns the hash of the token of the pending password reset, empty when none was requested
+func (u *User) PasswordResetTokenHash() PasswordResetTokenHash {
+	return u.passwordResetTokenHash
+}
+
+// PasswordResetExpiresAt returns when the pending password reset expires, nil when none was requested
+func (u *User) PasswordResetExpiresAt() *time.Time {
+	return u.passwordResetExpiresAt
+}
+
+// PasswordResetRequestedAt returns when a password reset was last requested, nil when never
+func (u *User) PasswordResetRequestedAt() *time.Time {
+	return u.passwordResetRequestedAt
+}
+
+// RequestPasswordReset starts a password reset valid for the given time, replacing any pending one,
+// and returns the token the user proves they own their email address with. Only the hash of the
+// token is kept on the user. Requests less than cooldown apart are refused.
+func (u *User) RequestPasswordReset(now time.Time, ttl time.Duration, cooldown time.Duration) (PasswordResetToken, error) {
+	if u.passwordResetRequestedAt != nil && now.Before(u.passwordResetRequestedAt.Add(cooldown)) {
+		return "", ErrPasswordResetRateLimited
+	}
+
+	token, err := NewPasswordResetToken()
+	if err != nil {
+		return "", err
+	}
+
+	expiresAt := now.Add(ttl)
+	u.passwordResetTokenHash = token.Hash()
+	u.passwordResetExpiresAt = &expiresAt
+	u.passwordResetRequestedAt = &now
+	u.updatedAt = now
+	u.events = append(u.events, Event{name: EventPasswordResetRequested, userID: u.id, occurredAt: now, resetToken: token})
+	return token, nil
+}
+
+// ResetPassword changes the password of a user holding the token of their pending password reset.
+// A token can be used once.
+func (u *User) ResetPassword(token PasswordResetToken, password string, now time.Time) error {
+	if u.passwordResetTokenHash == "" ||
+		subtle.ConstantTimeCompare([]byte(token.Hash()), []byte(u.passwordResetTokenHash)) != 1 {
+		return ErrInvalidResetToken
+	}
+
+	if u.passwordResetExpiresAt == nil || !now.Before(*u.passwordResetExpiresAt) {
+		return ErrResetTokenExpired
+	}
+
+	if err := u.ChangePassword(password); err != nil {
+		return err
+	}
+
+	u.passwordResetTokenHash = ""
+	u.passwordResetExpiresAt = nil
+	return nil
+}
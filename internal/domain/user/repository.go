@@ -13,6 +13,9 @@ type Repository interface {
 	// FindByEmail retrieves a user by email
 	FindByEmail(ctx context.Context, email Email) (*User, error)
 
+	// FindByPasswordResetTokenHash retrieves the user with a pending password reset by the hash of its token
+	FindByPasswordResetTokenHash(ctx context.Context, hash PasswordResetTokenHash) (*User, error)
+
 	// Update updates an existing user
 	Update(ctx context.Context, user *User) error
 
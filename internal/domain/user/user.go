@@ -13,6 +13,8 @@ var (
 	ErrInvalidEmail    = errors.New("invalid email address")
 	ErrInvalidPassword = errors.New("invalid password")
 	ErrInvalidName     = errors.New("invalid name")
+	ErrAddressNotFound = errors.New("address not found in address book")
+	ErrInvalidLocale   = errors.New("invalid locale")
 )
 
 // CartItem represents an item in a user's cart
@@ -58,13 +60,29 @@ type User struct {
 	email     Email
 	password  Password
 	name      Name
+	locale    Locale
 	cart      []CartItem
 	orders    []Order
+	addresses []*SavedAddress
+
+	defaultShippingAddressID AddressID
+	defaultBillingAddressID  AddressID
+
+	passwordResetTokenHash   PasswordResetTokenHash
+	passwordResetExpiresAt   *time.Time
+	passwordResetRequestedAt *time.Time
+
+	emailVerifiedAt    *time.Time
+	verificationNonce  VerificationNonce
+	verificationSentAt *time.Time
+
+	events []Event
+
 	createdAt time.Time
 	updatedAt time.Time
 }
 
-// NewUser creates a new user
+// NewUser creates a new user whose email address is not verified yet
 func NewUser(email string, password string, name string) (*User, error) {
 	id, err := NewID(uuid.New().String())
 	if err != nil {
@@ -95,11 +113,54 @@ func NewUser(email string, password string, name string) (*User, error) {
 		name:      nameVO,
 		cart:      []CartItem{},
 		orders:    []Order{},
+		addresses: []*SavedAddress{},
+		events:    []Event{{name: EventRegistered, userID: id, occurredAt: now}},
 		createdAt: now,
 		updatedAt: now,
 	}, nil
 }
 
+// Reconstruct rebuilds a user from persisted state
+func Reconstruct(
+	id ID,
+	email Email,
+	password Password,
+	name Name,
+	locale Locale,
+	addresses []*SavedAddress,
+	defaultShippingAddressID AddressID,
+	defaultBillingAddressID AddressID,
+	passwordResetTokenHash PasswordResetTokenHash,
+	passwordResetExpiresAt *time.Time,
+	passwordResetRequestedAt *time.Time,
+	emailVerifiedAt *time.Time,
+	verificationNonce VerificationNonce,
+	verificationSentAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *User {
+	return &User{
+		id:                       id,
+		email:                    email,
+		password:                 password,
+		name:                     name,
+		locale:                   locale,
+		cart:                     []CartItem{},
+		orders:                   []Order{},
+		addresses:                addresses,
+		defaultShippingAddressID: defaultShippingAddressID,
+		defaultBillingAddressID:  defaultBillingAddressID,
+		passwordResetTokenHash:   passwordResetTokenHash,
+		passwordResetExpiresAt:   passwordResetExpiresAt,
+		passwordResetRequestedAt: passwordResetRequestedAt,
+		emailVerifiedAt:          emailVerifiedAt,
+		verificationNonce:        verificationNonce,
+		verificationSentAt:       verificationSentAt,
+		createdAt:                createdAt,
+		updatedAt:                updatedAt,
+	}
+}
+
 // ID returns the user ID
 func (u *User) ID() ID {
 	return u.id
@@ -120,6 +181,11 @@ func (u *User) Name() Name {
 	return u.name
 }
 
+// Locale returns the locale the user is written to in, empty for the default locale
+func (u *User) Locale() Locale {
+	return u.locale
+}
+
 // Cart returns the user's cart
 func (u *User) Cart() []CartItem {
 	return u.cart
@@ -207,7 +273,7 @@ func (u *User) AddOrder(order Order) {
 	u.updatedAt = time.Now()
 }
 
-// ChangeEmail changes the user email
+// ChangeEmail changes the user email, which has to be verified again
 func (u *User) ChangeEmail(email string) error {
 	emailVO, err := NewEmail(email)
 	if err != nil {
@@ -215,6 +281,7 @@ func (u *User) ChangeEmail(email string) error {
 	}
 
 	u.email = emailVO
+	u.resetEmailVerification()
 	u.updatedAt = time.Now()
 	return nil
 }
@@ -231,6 +298,18 @@ func (u *User) ChangeName(name string) error {
 	return nil
 }
 
+// ChangeLocale changes the locale the user is written to in, clearing it with an empty locale
+func (u *User) ChangeLocale(locale string) error {
+	localeVO, err := NewLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	u.locale = localeVO
+	u.updatedAt = time.Now()
+	return nil
+}
+
 // ChangePassword changes the user password
 func (u *User) ChangePassword(password string) error {
 	passwordVO, err := NewPassword(password)
@@ -1,6 +1,9 @@
 package user
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/mail"
 	"regexp"
 	"strings"
@@ -87,3 +90,88 @@ func NewName(name string) (Name, error) {
 func (n Name) String() string {
 	return string(n)
 }
+
+// AddressID represents the identifier of a saved address
+type AddressID string
+
+// NewAddressID creates a new AddressID
+func NewAddressID(id string) (AddressID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", ErrAddressNotFound
+	}
+	return AddressID(id), nil
+}
+
+// String returns the string representation of the AddressID
+func (id AddressID) String() string {
+	return string(id)
+}
+
+// localePattern matches a language tag such as "en" or "pt-br"
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// Locale represents the language tag of the locale a user is written to in, such as "en" or "pt-br"
+type Locale string
+
+// NewLocale creates a new Locale, normalized to lower case with hyphens; an empty locale is the default one
+func NewLocale(locale string) (Locale, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if normalized != "" && !localePattern.MatchString(normalized) {
+		return "", ErrInvalidLocale
+	}
+	return Locale(normalized), nil
+}
+
+// String returns the string representation of the Locale
+func (l Locale) String() string {
+	return string(l)
+}
+
+// PasswordResetToken represents the unguessable token in the link letting a user choose a new password
+type PasswordResetToken string
+
+// NewPasswordResetToken generates a new random PasswordResetToken
+func NewPasswordResetToken() (PasswordResetToken, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return PasswordResetToken(hex.EncodeToString(b)), nil
+}
+
+// String returns the string representation of the PasswordResetToken
+func (t PasswordResetToken) String() string {
+	return string(t)
+}
+
+// Hash returns the SHA-256 hash of the token, the only form of it that is stored
+func (t PasswordResetToken) Hash() PasswordResetTokenHash {
+	sum := sha256.Sum256([]byte(t))
+	return PasswordResetTokenHash(hex.EncodeToString(sum[:]))
+}
+
+// PasswordResetTokenHash represents the hash of a PasswordResetToken
+type PasswordResetTokenHash string
+
+// String returns the string representation of the PasswordResetTokenHash
+func (h PasswordResetTokenHash) String() string {
+	return string(h)
+}
+
+// VerificationNonce represents the random value an email verification token is bound to,
+// replaced by every new verification so that earlier tokens stop working
+type VerificationNonce string
+
+// NewVerificationNonce generates a new random VerificationNonce
+func NewVerificationNonce() (VerificationNonce, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return VerificationNonce(hex.EncodeToString(b)), nil
+}
+
+// String returns the string representation of the VerificationNonce
+func (n VerificationNonce) String() string {
+	return string(n)
+}
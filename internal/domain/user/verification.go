@@ -0,0 +1,110 @@
+package user
+
+import (
+	"errors"
+	"time"
+)
+
+// Email verification errors
+var (
+	ErrEmailAlreadyVerified     = errors.New("email address is already verified")
+	ErrEmailNotVerified         = errors.New("email address is not verified")
+	ErrInvalidVerificationToken = errors.New("invalid email verification token")
+	ErrVerificationTokenExpired = errors.New("email verification token has expired")
+	ErrVerificationRateLimited  = errors.New("a verification email was sent recently, try again later")
+)
+
+// IsEmailVerified checks if the user proved they own their email address
+func (u *User) IsEmailVerified() bool {
+	return u.emailVerifiedAt != nil
+}
+
+// EmailVerifiedAt returns when the user verified their email address, nil while it is unverified
+func (u *User) EmailVerifiedAt() *time.Time {
+	return u.emailVerifiedAt
+}
+
+// VerificationNonce returns the nonce of the pending email verification, empty when none is pending
+func (u *User) VerificationNonce() VerificationNonce {
+	return u.verificationNonce
+}
+
+// VerificationSentAt returns when the last verification email was sent, or is due when it waits for the
+// resend cooldown, nil when none was
+func (u *User) VerificationSentAt() *time.Time {
+	return u.verificationSentAt
+}
+
+// RequestEmailVerification starts a new verification of the user's email address, invalidating the
+// links sent before, and returns the nonce the verification token is bound to. It fails when the
+// address is verified already, or when the last verification was requested less than cooldown ago.
+func (u *User) RequestEmailVerification(now time.Time, cooldown time.Duration) (VerificationNonce, error) {
+	if u.IsEmailVerified() {
+		return "", ErrEmailAlreadyVerified
+	}
+
+	if u.verificationSentAt != nil && now.Before(u.verificationSentAt.Add(cooldown)) {
+		return "", ErrVerificationRateLimited
+	}
+
+	nonce, err := NewVerificationNonce()
+	if err != nil {
+		return "", err
+	}
+
+	u.verificationNonce = nonce
+	u.verificationSentAt = &now
+	u.updatedAt = now
+	u.events = append(u.events, Event{name: EventVerificationRequested, userID: u.id, occurredAt: now})
+	return nonce, nil
+}
+
+// RequestChangedEmailVerification starts the verification of a changed email address, invalidating the
+// links sent before, and returns the nonce the verification token is bound to. The change is never refused
+// on the cooldown: when the last verification email was sent less than cooldown ago, the new one is due
+// once the cooldown ends.
+func (u *User) RequestChangedEmailVerification(now time.Time, cooldown time.Duration) (VerificationNonce, error) {
+	if u.IsEmailVerified() {
+		return "", ErrEmailAlreadyVerified
+	}
+
+	nonce, err := NewVerificationNonce()
+	if err != nil {
+		return "", err
+	}
+
+	sendAt := now
+	if u.verificationSentAt != nil && now.Before(u.verificationSentAt.Add(cooldown)) {
+		sendAt = u.verificationSentAt.Add(cooldown)
+	}
+
+	u.verificationNonce = nonce
+	u.verificationSentAt = &sendAt
+	u.updatedAt = now
+	u.events = append(u.events, Event{name: EventVerificationRequested, userID: u.id, occurredAt: now})
+	return nonce, nil
+}
+
+// VerifyEmail marks the user's email address as verified with the nonce of their pending verification.
+// A nonce can be used once.
+func (u *User) VerifyEmail(nonce VerificationNonce, now time.Time) error {
+	if u.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	if u.verificationNonce == "" || nonce != u.verificationNonce {
+		return ErrInvalidVerificationToken
+	}
+
+	u.emailVerifiedAt = &now
+	u.verificationNonce = ""
+	u.updatedAt = now
+	return nil
+}
+
+// resetEmailVerification marks the user's email address as unverified, cancelling any pending verification.
+// When the last verification email was sent is kept for the resend cooldown.
+func (u *User) resetEmailVerification() {
+	u.emailVerifiedAt = nil
+	u.verificationNonce = ""
+}
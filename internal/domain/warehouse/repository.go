@@ -0,0 +1,33 @@
+package warehouse
+
+import (
+	"context"
+)
+
+// Repository defines the interface for warehouse persistence operations
+type Repository interface {
+	// Save persists a warehouse to the repository
+	Save(ctx context.Context, warehouse *Warehouse) error
+
+	// FindByID retrieves a warehouse by ID
+	FindByID(ctx context.Context, id ID) (*Warehouse, error)
+
+	// FindByCode retrieves a warehouse by code
+	FindByCode(ctx context.Context, code Code) (*Warehouse, error)
+
+	// FindDefault retrieves the warehouse stock is kept in when none is given,
+	// the one with the lowest priority; it returns ErrNoWarehouse when there is none
+	FindDefault(ctx context.Context) (*Warehouse, error)
+
+	// Update updates an existing warehouse
+	Update(ctx context.Context, warehouse *Warehouse) error
+
+	// Delete removes a warehouse holding no stock from the repository
+	Delete(ctx context.Context, id ID) error
+
+	// List retrieves all warehouses by priority
+	List(ctx context.Context) ([]*Warehouse, error)
+
+	// HasStock checks if any product or variant has stock in a warehouse
+	HasStock(ctx context.Context, id ID) (bool, error)
+}
@@ -0,0 +1,41 @@
+package warehouse
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ID represents a warehouse ID value object
+type ID string
+
+// NewID creates a new warehouse ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", ErrInvalidID
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the warehouse ID
+func (id ID) String() string {
+	return string(id)
+}
+
+var codePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{0,19}$`)
+
+// Code represents the short code staff refer to a warehouse by, such as "EU-1"
+type Code string
+
+// NewCode creates a new Code
+func NewCode(code string) (Code, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if !codePattern.MatchString(normalized) {
+		return "", ErrInvalidCode
+	}
+	return Code(normalized), nil
+}
+
+// String returns the string representation of the Code
+func (c Code) String() string {
+	return string(c)
+}
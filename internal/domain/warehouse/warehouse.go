@@ -0,0 +1,196 @@
+package warehouse
+
+import (
+	"e-commerce/internal/domain/address"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Warehouse errors
+var (
+	ErrInvalidID         = errors.New("invalid warehouse ID")
+	ErrInvalidCode       = errors.New("invalid warehouse code")
+	ErrInvalidName       = errors.New("invalid warehouse name")
+	ErrInvalidAddress    = errors.New("invalid warehouse address")
+	ErrInvalidPriority   = errors.New("invalid warehouse priority")
+	ErrDuplicateCode     = errors.New("a warehouse with the same code already exists")
+	ErrWarehouseNotFound = errors.New("warehouse not found")
+	ErrWarehouseInUse    = errors.New("warehouse still holds stock")
+	ErrNoWarehouse       = errors.New("no warehouse to keep stock in")
+)
+
+// Distances of a warehouse to a destination, from nearest to farthest
+const (
+	DistanceLocal   = 0
+	DistanceCountry = 1
+	DistanceAbroad  = 2
+)
+
+// Warehouse represents a location stock is kept in and shipped from
+type Warehouse struct {
+	id        ID
+	code      Code
+	name      string
+	address   address.Address
+	priority  int
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewWarehouse creates a new warehouse. Warehouses with a lower priority are
+// filled and shipped from first.
+func NewWarehouse(code, name string, addr address.Address, priority int) (*Warehouse, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	codeVO, err := NewCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+
+	if addr.IsZero() {
+		return nil, ErrInvalidAddress
+	}
+
+	if priority < 0 {
+		return nil, ErrInvalidPriority
+	}
+
+	now := time.Now()
+
+	return &Warehouse{
+		id:        id,
+		code:      codeVO,
+		name:      name,
+		address:   addr,
+		priority:  priority,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// Reconstruct rebuilds a warehouse from persisted state
+func Reconstruct(
+	id ID,
+	code Code,
+	name string,
+	addr address.Address,
+	priority int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Warehouse {
+	return &Warehouse{
+		id:        id,
+		code:      code,
+		name:      name,
+		address:   addr,
+		priority:  priority,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the warehouse ID
+func (w *Warehouse) ID() ID {
+	return w.id
+}
+
+// Code returns the warehouse code
+func (w *Warehouse) Code() Code {
+	return w.code
+}
+
+// Name returns the warehouse name
+func (w *Warehouse) Name() string {
+	return w.name
+}
+
+// Address returns the address the warehouse ships from
+func (w *Warehouse) Address() address.Address {
+	return w.address
+}
+
+// Priority returns the warehouse priority, lower first
+func (w *Warehouse) Priority() int {
+	return w.priority
+}
+
+// CreatedAt returns the warehouse creation time
+func (w *Warehouse) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// UpdatedAt returns the warehouse last update time
+func (w *Warehouse) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// ChangeName changes the warehouse name
+func (w *Warehouse) ChangeName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidName
+	}
+
+	w.name = name
+	w.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeAddress changes the address the warehouse ships from
+func (w *Warehouse) ChangeAddress(addr address.Address) error {
+	if addr.IsZero() {
+		return ErrInvalidAddress
+	}
+
+	w.address = addr
+	w.updatedAt = time.Now()
+	return nil
+}
+
+// ChangePriority changes the warehouse priority
+func (w *Warehouse) ChangePriority(priority int) error {
+	if priority < 0 {
+		return ErrInvalidPriority
+	}
+
+	w.priority = priority
+	w.updatedAt = time.Now()
+	return nil
+}
+
+// DistanceTo estimates how far the warehouse is from a destination without geocoding:
+// local when in the same country and region or postal area, then within the country, then abroad
+func (w *Warehouse) DistanceTo(destination address.Address) int {
+	if w.address.Country() == "" || w.address.Country() != destination.Country() {
+		return DistanceAbroad
+	}
+
+	if w.address.Region() != "" && w.address.Region() == destination.Region() {
+		return DistanceLocal
+	}
+
+	if postalArea(w.address.PostalCode()) != "" && postalArea(w.address.PostalCode()) == postalArea(destination.PostalCode()) {
+		return DistanceLocal
+	}
+
+	return DistanceCountry
+}
+
+// postalArea returns the leading characters of a postal code, which name its area in most countries
+func postalArea(postalCode string) string {
+	if len(postalCode) < 2 {
+		return ""
+	}
+	return postalCode[:2]
+}
@@ -0,0 +1,25 @@
+package wishlist
+
+import (
+	"context"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+)
+
+// Repository defines the interface for wishlist persistence operations
+type Repository interface {
+	// Save persists a wishlist to the repository
+	Save(ctx context.Context, wishlist *Wishlist) error
+
+	// FindByUserID retrieves the wishlist of a user
+	FindByUserID(ctx context.Context, userID user.ID) (*Wishlist, error)
+
+	// FindByShareToken retrieves the wishlist holding the list shared under a token
+	FindByShareToken(ctx context.Context, token ShareToken) (*Wishlist, error)
+
+	// FindByProduct retrieves the wishlists holding a product or any of its variants
+	FindByProduct(ctx context.Context, productID product.ID) ([]*Wishlist, error)
+
+	// Update updates an existing wishlist
+	Update(ctx context.Context, wishlist *Wishlist) error
+}
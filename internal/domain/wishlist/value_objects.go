@@ -0,0 +1,75 @@
+package wishlist
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// ID represents a wishlist ID value object
+type ID string
+
+// NewID creates a new wishlist ID
+func NewID(id string) (ID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", ErrInvalidID
+	}
+	return ID(id), nil
+}
+
+// String returns the string representation of the wishlist ID
+func (id ID) String() string {
+	return string(id)
+}
+
+// ListID represents the ID of a named list within a wishlist
+type ListID string
+
+// NewListID creates a new ListID
+func NewListID(id string) (ListID, error) {
+	if strings.TrimSpace(id) == "" {
+		return "", ErrInvalidListID
+	}
+	return ListID(id), nil
+}
+
+// String returns the string representation of the ListID
+func (id ListID) String() string {
+	return string(id)
+}
+
+const maxListNameLength = 100
+
+// ListName represents the name of a list
+type ListName string
+
+// NewListName creates a new ListName
+func NewListName(name string) (ListName, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxListNameLength {
+		return "", ErrInvalidListName
+	}
+	return ListName(name), nil
+}
+
+// String returns the string representation of the ListName
+func (n ListName) String() string {
+	return string(n)
+}
+
+// ShareToken represents the unguessable token in the public link of a shared list
+type ShareToken string
+
+// NewShareToken generates a new random ShareToken
+func NewShareToken() (ShareToken, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return ShareToken(hex.EncodeToString(b)), nil
+}
+
+// String returns the string representation of the ShareToken
+func (t ShareToken) String() string {
+	return string(t)
+}
@@ -0,0 +1,407 @@
+package wishlist
+
+import (
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Wishlist errors
+var (
+	ErrInvalidID         = errors.New("invalid wishlist ID")
+	ErrInvalidUserID     = errors.New("invalid user ID")
+	ErrInvalidListID     = errors.New("invalid wishlist list ID")
+	ErrInvalidListName   = errors.New("list name must be between 1 and 100 characters")
+	ErrInvalidProductID  = errors.New("invalid product ID")
+	ErrDuplicateListName = errors.New("a list with this name already exists")
+	ErrWishlistNotFound  = errors.New("wishlist not found")
+	ErrListNotFound      = errors.New("list not found in wishlist")
+	ErrItemNotFound      = errors.New("product not found in list")
+)
+
+// SavedForLaterList is the name of the list items saved for later from the cart go to by default
+const SavedForLaterList = "Saved for later"
+
+// Item represents a product, or one of its variants, saved to a list
+type Item struct {
+	productID  product.ID
+	variantID  product.VariantID
+	addedPrice product.Price
+	addedAt    time.Time
+}
+
+// ReconstructItem rebuilds an item from persisted state
+func ReconstructItem(productID product.ID, variantID product.VariantID, addedPrice product.Price, addedAt time.Time) *Item {
+	return &Item{
+		productID:  productID,
+		variantID:  variantID,
+		addedPrice: addedPrice,
+		addedAt:    addedAt,
+	}
+}
+
+// ProductID returns the product saved
+func (i *Item) ProductID() product.ID {
+	return i.productID
+}
+
+// VariantID returns the variant saved, empty for the product itself
+func (i *Item) VariantID() product.VariantID {
+	return i.variantID
+}
+
+// AddedPrice returns the price of the product or variant when it was saved
+func (i *Item) AddedPrice() product.Price {
+	return i.addedPrice
+}
+
+// AddedAt returns when the item was saved
+func (i *Item) AddedAt() time.Time {
+	return i.addedAt
+}
+
+// matches checks if the item holds the given product variant
+func (i *Item) matches(productID, variantID string) bool {
+	return i.productID.String() == productID && i.variantID.String() == variantID
+}
+
+// List represents a named list of saved products, private unless shared through a public link
+type List struct {
+	id         ListID
+	name       ListName
+	shareToken ShareToken
+	items      []*Item
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// ReconstructList rebuilds a list from persisted state
+func ReconstructList(id ListID, name ListName, shareToken ShareToken, items []*Item, createdAt, updatedAt time.Time) *List {
+	return &List{
+		id:         id,
+		name:       name,
+		shareToken: shareToken,
+		items:      items,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// ID returns the list ID
+func (l *List) ID() ListID {
+	return l.id
+}
+
+// Name returns the list name
+func (l *List) Name() ListName {
+	return l.name
+}
+
+// ShareToken returns the token of the public link to the list, empty when it is not shared
+func (l *List) ShareToken() ShareToken {
+	return l.shareToken
+}
+
+// IsShared checks if the list can be viewed through a public link
+func (l *List) IsShared() bool {
+	return l.shareToken != ""
+}
+
+// Items returns the items of the list, oldest first
+func (l *List) Items() []*Item {
+	return l.items
+}
+
+// CreatedAt returns the list creation time
+func (l *List) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+// UpdatedAt returns the list last update time
+func (l *List) UpdatedAt() time.Time {
+	return l.updatedAt
+}
+
+// HasItem checks if the list holds a specific product variant
+func (l *List) HasItem(productID, variantID string) bool {
+	_, err := l.GetItem(productID, variantID)
+	return err == nil
+}
+
+// GetItem returns an item of the list by product and variant ID
+func (l *List) GetItem(productID, variantID string) (*Item, error) {
+	for _, item := range l.items {
+		if item.matches(productID, variantID) {
+			return item, nil
+		}
+	}
+	return nil, ErrItemNotFound
+}
+
+// Wishlist represents the wishlist aggregate root, holding the named lists of a user
+type Wishlist struct {
+	id        ID
+	userID    user.ID
+	lists     []*List
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewWishlist creates a new wishlist with no lists
+func NewWishlist(userID string) (*Wishlist, error) {
+	id, err := NewID(uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	userIDVO, err := user.NewID(userID)
+	if err != nil {
+		return nil, ErrInvalidUserID
+	}
+
+	now := time.Now()
+
+	return &Wishlist{
+		id:        id,
+		userID:    userIDVO,
+		lists:     []*List{},
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// Reconstruct rebuilds a wishlist from persisted state
+func Reconstruct(id ID, userID user.ID, lists []*List, createdAt, updatedAt time.Time) *Wishlist {
+	return &Wishlist{
+		id:        id,
+		userID:    userID,
+		lists:     lists,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the wishlist ID
+func (w *Wishlist) ID() ID {
+	return w.id
+}
+
+// UserID returns the user ID
+func (w *Wishlist) UserID() user.ID {
+	return w.userID
+}
+
+// Lists returns the lists of the wishlist, oldest first
+func (w *Wishlist) Lists() []*List {
+	return w.lists
+}
+
+// CreatedAt returns the wishlist creation time
+func (w *Wishlist) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// UpdatedAt returns the wishlist last update time
+func (w *Wishlist) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// FindList returns a list by ID
+func (w *Wishlist) FindList(id ListID) (*List, error) {
+	for _, l := range w.lists {
+		if l.id == id {
+			return l, nil
+		}
+	}
+	return nil, ErrListNotFound
+}
+
+// FindListByName returns a list by name, ignoring case
+func (w *Wishlist) FindListByName(name string) (*List, error) {
+	for _, l := range w.lists {
+		if strings.EqualFold(l.name.String(), strings.TrimSpace(name)) {
+			return l, nil
+		}
+	}
+	return nil, ErrListNotFound
+}
+
+// FindSharedList returns the list shared under a token
+func (w *Wishlist) FindSharedList(token ShareToken) (*List, error) {
+	for _, l := range w.lists {
+		if token != "" && l.shareToken == token {
+			return l, nil
+		}
+	}
+	return nil, ErrListNotFound
+}
+
+// CreateList adds a new empty list; list names are unique within a wishlist, ignoring case
+func (w *Wishlist) CreateList(name string) (*List, error) {
+	nameVO, err := NewListName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.FindListByName(nameVO.String()); err == nil {
+		return nil, ErrDuplicateListName
+	}
+
+	now := time.Now()
+	l := &List{
+		id:        ListID(uuid.New().String()),
+		name:      nameVO,
+		items:     []*Item{},
+		createdAt: now,
+		updatedAt: now,
+	}
+
+	w.lists = append(w.lists, l)
+	w.updatedAt = now
+	return l, nil
+}
+
+// SavedForLater returns the list items are saved for later to, creating it when the wishlist has none
+func (w *Wishlist) SavedForLater() *List {
+	if l, err := w.FindListByName(SavedForLaterList); err == nil {
+		return l
+	}
+
+	l, _ := w.CreateList(SavedForLaterList)
+	return l
+}
+
+// RenameList changes the name of a list
+func (w *Wishlist) RenameList(id ListID, name string) error {
+	l, err := w.FindList(id)
+	if err != nil {
+		return err
+	}
+
+	nameVO, err := NewListName(name)
+	if err != nil {
+		return err
+	}
+
+	if other, err := w.FindListByName(nameVO.String()); err == nil && other.id != id {
+		return ErrDuplicateListName
+	}
+
+	l.name = nameVO
+	w.touch(l)
+	return nil
+}
+
+// RemoveList removes a list and its items
+func (w *Wishlist) RemoveList(id ListID) error {
+	for i, l := range w.lists {
+		if l.id == id {
+			w.lists = append(w.lists[:i], w.lists[i+1:]...)
+			w.updatedAt = time.Now()
+			return nil
+		}
+	}
+	return ErrListNotFound
+}
+
+// AddItem saves a product, or one of its variants, at its current price to a list;
+// an item already in the list keeps the price it was saved at
+func (w *Wishlist) AddItem(listID ListID, productID, variantID string, price product.Price) error {
+	l, err := w.FindList(listID)
+	if err != nil {
+		return err
+	}
+
+	productIDVO, err := product.NewID(productID)
+	if err != nil {
+		return ErrInvalidProductID
+	}
+
+	if l.HasItem(productID, variantID) {
+		return nil
+	}
+
+	l.items = append(l.items, &Item{
+		productID:  productIDVO,
+		variantID:  product.VariantID(variantID),
+		addedPrice: price,
+		addedAt:    time.Now(),
+	})
+	w.touch(l)
+	return nil
+}
+
+// RemoveItem removes a product variant from a list
+func (w *Wishlist) RemoveItem(listID ListID, productID, variantID string) error {
+	l, err := w.FindList(listID)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range l.items {
+		if item.matches(productID, variantID) {
+			l.items = append(l.items[:i], l.items[i+1:]...)
+			w.touch(l)
+			return nil
+		}
+	}
+	return ErrItemNotFound
+}
+
+// ShareList makes a list viewable through a public link, returning the token of the link;
+// sharing a shared list keeps its link
+func (w *Wishlist) ShareList(id ListID) (ShareToken, error) {
+	l, err := w.FindList(id)
+	if err != nil {
+		return "", err
+	}
+
+	if l.IsShared() {
+		return l.shareToken, nil
+	}
+
+	token, err := NewShareToken()
+	if err != nil {
+		return "", err
+	}
+
+	l.shareToken = token
+	w.touch(l)
+	return token, nil
+}
+
+// UnshareList makes a list private again, invalidating its public link
+func (w *Wishlist) UnshareList(id ListID) error {
+	l, err := w.FindList(id)
+	if err != nil {
+		return err
+	}
+
+	l.shareToken = ""
+	w.touch(l)
+	return nil
+}
+
+// ItemsOf returns the items holding a product or any of its variants across all lists
+func (w *Wishlist) ItemsOf(productID product.ID) []*Item {
+	items := []*Item{}
+	for _, l := range w.lists {
+		for _, item := range l.items {
+			if item.productID == productID {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// touch marks a list and the wishlist as updated
+func (w *Wishlist) touch(l *List) {
+	now := time.Now()
+	l.updatedAt = now
+	w.updatedAt = now
+}
@@ -0,0 +1,490 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/cart/commands"
+	"e-commerce/internal/application/cart/queries"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CartHandler handles HTTP requests related to carts
+type CartHandler struct {
+	createCartHandler         *commands.CreateCartHandler
+	createGuestCartHandler    *commands.CreateGuestCartHandler
+	mergeGuestCartHandler     *commands.MergeGuestCartHandler
+	addCartItemHandler        *commands.AddCartItemHandler
+	removeCartItemHandler     *commands.RemoveCartItemHandler
+	applyCouponHandler        *commands.ApplyCouponHandler
+	removeCouponHandler       *commands.RemoveCouponHandler
+	adjustCartHandler         *commands.AdjustCartHandler
+	restoreCartHandler        *commands.RestoreCartHandler
+	getCartHandler            *queries.GetCartHandler
+	getUserCartHandler        *queries.GetUserCartHandler
+	getGuestCartHandler       *queries.GetGuestCartHandler
+	quoteCartHandler          *queries.QuoteCartHandler
+	evaluatePromotionsHandler *queries.EvaluatePromotionsHandler
+	validateCartHandler       *queries.ValidateCartHandler
+	getRecoveryReportHandler  *queries.GetRecoveryReportHandler
+}
+
+// NewCartHandler creates a new CartHandler
+func NewCartHandler(
+	createCartHandler *commands.CreateCartHandler,
+	createGuestCartHandler *commands.CreateGuestCartHandler,
+	mergeGuestCartHandler *commands.MergeGuestCartHandler,
+	addCartItemHandler *commands.AddCartItemHandler,
+	removeCartItemHandler *commands.RemoveCartItemHandler,
+	applyCouponHandler *commands.ApplyCouponHandler,
+	removeCouponHandler *commands.RemoveCouponHandler,
+	adjustCartHandler *commands.AdjustCartHandler,
+	restoreCartHandler *commands.RestoreCartHandler,
+	getCartHandler *queries.GetCartHandler,
+	getUserCartHandler *queries.GetUserCartHandler,
+	getGuestCartHandler *queries.GetGuestCartHandler,
+	quoteCartHandler *queries.QuoteCartHandler,
+	evaluatePromotionsHandler *queries.EvaluatePromotionsHandler,
+	validateCartHandler *queries.ValidateCartHandler,
+	getRecoveryReportHandler *queries.GetRecoveryReportHandler,
+) *CartHandler {
+	return &CartHandler{
+		createCartHandler:         createCartHandler,
+		createGuestCartHandler:    createGuestCartHandler,
+		mergeGuestCartHandler:     mergeGuestCartHandler,
+		addCartItemHandler:        addCartItemHandler,
+		removeCartItemHandler:     removeCartItemHandler,
+		applyCouponHandler:        applyCouponHandler,
+		removeCouponHandler:       removeCouponHandler,
+		adjustCartHandler:         adjustCartHandler,
+		restoreCartHandler:        restoreCartHandler,
+		getCartHandler:            getCartHandler,
+		getUserCartHandler:        getUserCartHandler,
+		getGuestCartHandler:       getGuestCartHandler,
+		quoteCartHandler:          quoteCartHandler,
+		evaluatePromotionsHandler: evaluatePromotionsHandler,
+		validateCartHandler:       validateCartHandler,
+		getRecoveryReportHandler:  getRecoveryReportHandler,
+	}
+}
+
+// RegisterRoutes registers the cart routes
+func (h *CartHandler) RegisterRoutes(app *fiber.App) {
+	carts := app.Group("/api/carts")
+
+	carts.Post("/", h.CreateCart)
+	carts.Post("/guest", h.CreateGuestCart)
+	carts.Post("/merge", h.MergeGuestCart)
+	carts.Get("/user/:userId", h.GetUserCart)
+	carts.Get("/guest/:token", h.GetGuestCart)
+	carts.Get("/recover/:token", h.RestoreCart)
+	carts.Get("/abandoned/report", h.GetRecoveryReport)
+	carts.Get("/:id", h.GetCart)
+	carts.Get("/:id/quote", h.QuoteCart)
+	carts.Get("/:id/promotions", h.EvaluatePromotions)
+	carts.Get("/:id/validation", h.ValidateCart)
+	carts.Post("/:id/validation", h.AdjustCart)
+	carts.Put("/:id/items", h.AddCartItem)
+	carts.Delete("/:id/items/:productId", h.RemoveCartItem)
+	carts.Post("/:id/coupon", h.ApplyCoupon)
+	carts.Delete("/:id/coupon", h.RemoveCoupon)
+}
+
+// CreateCart handles the creation of a cart for a user
+func (h *CartHandler) CreateCart(c *fiber.Ctx) error {
+	var cmd commands.CreateCartCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cartID, err := h.createCartHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": cartID,
+	})
+}
+
+// CreateGuestCart handles the creation of a cart for an anonymous visitor
+func (h *CartHandler) CreateGuestCart(c *fiber.Ctx) error {
+	cartID, sessionToken, err := h.createGuestCartHandler.Handle(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id":            cartID,
+		"session_token": sessionToken,
+	})
+}
+
+// MergeGuestCart handles combining the guest cart of a visitor into their cart when they log in
+func (h *CartHandler) MergeGuestCart(c *fiber.Ctx) error {
+	var cmd commands.MergeGuestCartCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cartID, err := h.mergeGuestCartHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"id": cartID,
+	})
+}
+
+// GetCart handles retrieving a cart by ID
+func (h *CartHandler) GetCart(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID is required",
+		})
+	}
+
+	query := queries.GetCartQuery{
+		ID: id,
+	}
+
+	cart, err := h.getCartHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Cart not found",
+		})
+	}
+
+	return c.JSON(cart)
+}
+
+// GetUserCart handles retrieving the cart of a user
+func (h *CartHandler) GetUserCart(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	if userID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID is required",
+		})
+	}
+
+	query := queries.GetUserCartQuery{
+		UserID: userID,
+	}
+
+	cart, err := h.getUserCartHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Cart not found",
+		})
+	}
+
+	return c.JSON(cart)
+}
+
+// AddCartItem handles adding an item to a cart
+func (h *CartHandler) AddCartItem(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID is required",
+		})
+	}
+
+	var cmd commands.AddCartItemCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.CartID = id
+
+	if err := h.addCartItemHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Item added to cart successfully",
+	})
+}
+
+// RemoveCartItem handles removing an item from a cart
+func (h *CartHandler) RemoveCartItem(c *fiber.Ctx) error {
+	id := c.Params("id")
+	productID := c.Params("productId")
+	if id == "" || productID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID and product ID are required",
+		})
+	}
+
+	cmd := commands.RemoveCartItemCommand{
+		CartID:    id,
+		ProductID: productID,
+		VariantID: c.Query("variant_id"),
+	}
+
+	if err := h.removeCartItemHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Item removed from cart successfully",
+	})
+}
+
+// ApplyCoupon handles applying a coupon code to a cart
+func (h *CartHandler) ApplyCoupon(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID is required",
+		})
+	}
+
+	var cmd commands.ApplyCouponCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.CartID = id
+
+	if err := h.applyCouponHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Coupon applied successfully",
+	})
+}
+
+// RemoveCoupon handles removing the coupon from a cart
+func (h *CartHandler) RemoveCoupon(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID is required",
+		})
+	}
+
+	cmd := commands.RemoveCouponCommand{
+		CartID: id,
+	}
+
+	if err := h.removeCouponHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Coupon removed from cart successfully",
+	})
+}
+
+// GetGuestCart handles retrieving the cart of an anonymous visitor by its session token
+func (h *CartHandler) GetGuestCart(c *fiber.Ctx) error {
+	token := c.Params("token")
+	if token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Session token is required",
+		})
+	}
+
+	query := queries.GetGuestCartQuery{
+		SessionToken: token,
+	}
+
+	cart, err := h.getGuestCartHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Cart not found",
+		})
+	}
+
+	return c.JSON(cart)
+}
+
+// QuoteCart handles pricing a cart with taxes and shipping for a destination
+func (h *CartHandler) QuoteCart(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID is required",
+		})
+	}
+
+	query := queries.QuoteCartQuery{
+		CartID:         id,
+		Country:        c.Query("country"),
+		Region:         c.Query("region"),
+		ShippingMethod: c.Query("shipping_method"),
+	}
+
+	quote, err := h.quoteCartHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(quote)
+}
+
+// ValidateCart handles checking a cart against the live catalog
+func (h *CartHandler) ValidateCart(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID is required",
+		})
+	}
+
+	query := queries.ValidateCartQuery{
+		CartID:         id,
+		Country:        c.Query("country"),
+		Region:         c.Query("region"),
+		ShippingMethod: c.Query("shipping_method"),
+	}
+
+	validation, err := h.validateCartHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(validation)
+}
+
+// AdjustCart handles checking a cart against the live catalog and adjusting it to what can be ordered,
+// returning the warnings found before the adjustment
+func (h *CartHandler) AdjustCart(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID is required",
+		})
+	}
+
+	query := queries.ValidateCartQuery{
+		CartID:         id,
+		Country:        c.Query("country"),
+		Region:         c.Query("region"),
+		ShippingMethod: c.Query("shipping_method"),
+	}
+
+	validation, err := h.validateCartHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	cmd := commands.AdjustCartCommand{
+		CartID: id,
+	}
+
+	adjusted, err := h.adjustCartHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	validation.Adjusted = adjusted
+
+	return c.JSON(validation)
+}
+
+// EvaluatePromotions handles explaining which automatic promotions apply to a cart
+func (h *CartHandler) EvaluatePromotions(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID is required",
+		})
+	}
+
+	query := queries.EvaluatePromotionsQuery{
+		CartID: id,
+	}
+
+	promotions, err := h.evaluatePromotionsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(promotions)
+}
+
+// RestoreCart handles restoring an abandoned cart through the link of a reminder
+func (h *CartHandler) RestoreCart(c *fiber.Ctx) error {
+	token := c.Params("token")
+	if token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Recovery token is required",
+		})
+	}
+
+	cmd := commands.RestoreCartCommand{
+		RecoveryToken: token,
+	}
+
+	id, err := h.restoreCartHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Cart not found",
+		})
+	}
+
+	cart, err := h.getCartHandler.Handle(c.Context(), queries.GetCartQuery{ID: id})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(cart)
+}
+
+// GetRecoveryReport handles reporting how many abandoned carts were recovered through reminders
+func (h *CartHandler) GetRecoveryReport(c *fiber.Ctx) error {
+	days, err := strconv.Atoi(c.Query("days", "30"))
+	if err != nil {
+		days = queries.DefaultRecoveryWindowDays
+	}
+
+	query := queries.GetRecoveryReportQuery{
+		Days: days,
+	}
+
+	report, err := h.getRecoveryReportHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(report)
+}
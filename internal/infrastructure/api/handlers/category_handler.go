@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/category/commands"
+	"e-commerce/internal/application/category/queries"
+	productqueries "e-commerce/internal/application/product/queries"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CategoryHandler handles HTTP requests related to categories
+type CategoryHandler struct {
+	createCategoryHandler       *commands.CreateCategoryHandler
+	updateCategoryHandler       *commands.UpdateCategoryHandler
+	deleteCategoryHandler       *commands.DeleteCategoryHandler
+	getCategoryHandler          *queries.GetCategoryHandler
+	getCategoryTreeHandler      *queries.GetCategoryTreeHandler
+	listCategoryProductsHandler *productqueries.ListCategoryProductsHandler
+}
+
+// NewCategoryHandler creates a new CategoryHandler
+func NewCategoryHandler(
+	createCategoryHandler *commands.CreateCategoryHandler,
+	updateCategoryHandler *commands.UpdateCategoryHandler,
+	deleteCategoryHandler *commands.DeleteCategoryHandler,
+	getCategoryHandler *queries.GetCategoryHandler,
+	getCategoryTreeHandler *queries.GetCategoryTreeHandler,
+	listCategoryProductsHandler *productqueries.ListCategoryProductsHandler,
+) *CategoryHandler {
+	return &CategoryHandler{
+		createCategoryHandler:       createCategoryHandler,
+		updateCategoryHandler:       updateCategoryHandler,
+		deleteCategoryHandler:       deleteCategoryHandler,
+		getCategoryHandler:          getCategoryHandler,
+		getCategoryTreeHandler:      getCategoryTreeHandler,
+		listCategoryProductsHandler: listCategoryProductsHandler,
+	}
+}
+
+// RegisterRoutes registers the category routes
+func (h *CategoryHandler) RegisterRoutes(app *fiber.App) {
+	categories := app.Group("/api/categories")
+
+	categories.Post("/", h.CreateCategory)
+	categories.Get("/", h.GetCategoryTree)
+	categories.Get("/slug/:slug", h.GetCategoryBySlug)
+	categories.Get("/:id", h.GetCategory)
+	categories.Put("/:id", h.UpdateCategory)
+	categories.Delete("/:id", h.DeleteCategory)
+	categories.Get("/:id/products", h.ListCategoryProducts)
+}
+
+// CreateCategory handles the creation of a new category
+func (h *CategoryHandler) CreateCategory(c *fiber.Ctx) error {
+	var cmd commands.CreateCategoryCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	categoryID, err := h.createCategoryHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": categoryID,
+	})
+}
+
+// GetCategoryTree handles retrieving the whole category taxonomy
+func (h *CategoryHandler) GetCategoryTree(c *fiber.Ctx) error {
+	tree, err := h.getCategoryTreeHandler.Handle(c.Context(), queries.GetCategoryTreeQuery{})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(tree)
+}
+
+// GetCategory handles retrieving a category by ID
+func (h *CategoryHandler) GetCategory(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Category ID is required",
+		})
+	}
+
+	query := queries.GetCategoryQuery{
+		ID: id,
+	}
+
+	category, err := h.getCategoryHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Category not found",
+		})
+	}
+
+	return c.JSON(category)
+}
+
+// GetCategoryBySlug handles retrieving a category by slug
+func (h *CategoryHandler) GetCategoryBySlug(c *fiber.Ctx) error {
+	slug := c.Params("slug")
+	if slug == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Category slug is required",
+		})
+	}
+
+	query := queries.GetCategoryQuery{
+		Slug: slug,
+	}
+
+	category, err := h.getCategoryHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Category not found",
+		})
+	}
+
+	return c.JSON(category)
+}
+
+// UpdateCategory handles updating or moving a category
+func (h *CategoryHandler) UpdateCategory(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Category ID is required",
+		})
+	}
+
+	var cmd commands.UpdateCategoryCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.updateCategoryHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Category updated successfully",
+	})
+}
+
+// DeleteCategory handles deleting a category
+func (h *CategoryHandler) DeleteCategory(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Category ID is required",
+		})
+	}
+
+	cmd := commands.DeleteCategoryCommand{
+		ID: id,
+	}
+
+	if err := h.deleteCategoryHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Category deleted successfully",
+	})
+}
+
+// ListCategoryProducts handles listing the products of a category and its subcategories with pagination
+func (h *CategoryHandler) ListCategoryProducts(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Category ID is required",
+		})
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := productqueries.ListCategoryProductsQuery{
+		CategoryID: id,
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	products, err := h.listCategoryProductsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(products)
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/coupon/commands"
+	"e-commerce/internal/application/coupon/queries"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CouponHandler handles HTTP requests related to coupons
+type CouponHandler struct {
+	createCouponHandler *commands.CreateCouponHandler
+	updateCouponHandler *commands.UpdateCouponHandler
+	deleteCouponHandler *commands.DeleteCouponHandler
+	getCouponHandler    *queries.GetCouponHandler
+	listCouponsHandler  *queries.ListCouponsHandler
+}
+
+// NewCouponHandler creates a new CouponHandler
+func NewCouponHandler(
+	createCouponHandler *commands.CreateCouponHandler,
+	updateCouponHandler *commands.UpdateCouponHandler,
+	deleteCouponHandler *commands.DeleteCouponHandler,
+	getCouponHandler *queries.GetCouponHandler,
+	listCouponsHandler *queries.ListCouponsHandler,
+) *CouponHandler {
+	return &CouponHandler{
+		createCouponHandler: createCouponHandler,
+		updateCouponHandler: updateCouponHandler,
+		deleteCouponHandler: deleteCouponHandler,
+		getCouponHandler:    getCouponHandler,
+		listCouponsHandler:  listCouponsHandler,
+	}
+}
+
+// RegisterRoutes registers the coupon routes
+func (h *CouponHandler) RegisterRoutes(app *fiber.App) {
+	coupons := app.Group("/api/coupons")
+
+	coupons.Post("/", h.CreateCoupon)
+	coupons.Get("/", h.ListCoupons)
+	coupons.Get("/:id", h.GetCoupon)
+	coupons.Put("/:id", h.UpdateCoupon)
+	coupons.Delete("/:id", h.DeleteCoupon)
+}
+
+// CreateCoupon handles the creation of a new coupon
+func (h *CouponHandler) CreateCoupon(c *fiber.Ctx) error {
+	var cmd commands.CreateCouponCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	couponID, err := h.createCouponHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": couponID,
+	})
+}
+
+// GetCoupon handles retrieving a coupon by ID
+func (h *CouponHandler) GetCoupon(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Coupon ID is required",
+		})
+	}
+
+	query := queries.GetCouponQuery{
+		ID: id,
+	}
+
+	coupon, err := h.getCouponHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Coupon not found",
+		})
+	}
+
+	return c.JSON(coupon)
+}
+
+// UpdateCoupon handles updating the terms of a coupon
+func (h *CouponHandler) UpdateCoupon(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Coupon ID is required",
+		})
+	}
+
+	var cmd commands.UpdateCouponCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.updateCouponHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Coupon updated successfully",
+	})
+}
+
+// DeleteCoupon handles deleting a coupon
+func (h *CouponHandler) DeleteCoupon(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Coupon ID is required",
+		})
+	}
+
+	cmd := commands.DeleteCouponCommand{
+		ID: id,
+	}
+
+	if err := h.deleteCouponHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Coupon deleted successfully",
+	})
+}
+
+// ListCoupons handles listing coupons with pagination
+func (h *CouponHandler) ListCoupons(c *fiber.Ctx) error {
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.ListCouponsQuery{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	coupons, err := h.listCouponsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(coupons)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/product/queries"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MediaHandler handles HTTP requests for stored media files
+type MediaHandler struct {
+	getMediaHandler *queries.GetMediaHandler
+}
+
+// NewMediaHandler creates a new MediaHandler
+func NewMediaHandler(getMediaHandler *queries.GetMediaHandler) *MediaHandler {
+	return &MediaHandler{
+		getMediaHandler: getMediaHandler,
+	}
+}
+
+// RegisterRoutes registers the media routes
+func (h *MediaHandler) RegisterRoutes(app *fiber.App) {
+	app.Get("/media/*", h.GetMedia)
+}
+
+// GetMedia handles serving a stored media file by key
+func (h *MediaHandler) GetMedia(c *fiber.Ctx) error {
+	key := c.Params("*")
+	if key == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Media key is required",
+		})
+	}
+
+	query := queries.GetMediaQuery{
+		Key: key,
+	}
+
+	blob, err := h.getMediaHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Media not found",
+		})
+	}
+
+	// Keys contain the image ID, so a stored file never changes
+	c.Set(fiber.HeaderContentType, blob.ContentType)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=31536000, immutable")
+	return c.SendStream(blob.Body, int(blob.Size))
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/notification/queries"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NotificationHandler handles HTTP requests related to notifications
+type NotificationHandler struct {
+	listDeliveriesHandler *queries.ListDeliveriesHandler
+}
+
+// NewNotificationHandler creates a new NotificationHandler
+func NewNotificationHandler(listDeliveriesHandler *queries.ListDeliveriesHandler) *NotificationHandler {
+	return &NotificationHandler{
+		listDeliveriesHandler: listDeliveriesHandler,
+	}
+}
+
+// RegisterRoutes registers the notification routes
+func (h *NotificationHandler) RegisterRoutes(app *fiber.App) {
+	notifications := app.Group("/api/notifications")
+
+	notifications.Get("/deliveries", h.ListDeliveries)
+}
+
+// ListDeliveries handles listing the delivery log with pagination
+func (h *NotificationHandler) ListDeliveries(c *fiber.Ctx) error {
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.ListDeliveriesQuery{
+		Status: c.Query("status"),
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	deliveries, err := h.listDeliveriesHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(deliveries)
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/order/commands"
+	"e-commerce/internal/application/order/queries"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/user"
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// OrderHandler handles HTTP requests related to orders
+type OrderHandler struct {
+	placeOrderHandler        *commands.PlaceOrderHandler
+	placeGuestOrderHandler   *commands.PlaceGuestOrderHandler
+	updateOrderStatusHandler *commands.UpdateOrderStatusHandler
+	getOrderHandler          *queries.GetOrderHandler
+	listUserOrdersHandler    *queries.ListUserOrdersHandler
+}
+
+// NewOrderHandler creates a new OrderHandler
+func NewOrderHandler(
+	placeOrderHandler *commands.PlaceOrderHandler,
+	placeGuestOrderHandler *commands.PlaceGuestOrderHandler,
+	updateOrderStatusHandler *commands.UpdateOrderStatusHandler,
+	getOrderHandler *queries.GetOrderHandler,
+	listUserOrdersHandler *queries.ListUserOrdersHandler,
+) *OrderHandler {
+	return &OrderHandler{
+		placeOrderHandler:        placeOrderHandler,
+		placeGuestOrderHandler:   placeGuestOrderHandler,
+		updateOrderStatusHandler: updateOrderStatusHandler,
+		getOrderHandler:          getOrderHandler,
+		listUserOrdersHandler:    listUserOrdersHandler,
+	}
+}
+
+// RegisterRoutes registers the order routes
+func (h *OrderHandler) RegisterRoutes(app *fiber.App) {
+	orders := app.Group("/api/orders")
+
+	orders.Post("/", h.PlaceOrder)
+	orders.Post("/guest", h.PlaceGuestOrder)
+	orders.Get("/user/:userId", h.ListUserOrders)
+	orders.Get("/:id", h.GetOrder)
+	orders.Put("/:id/status", h.UpdateOrderStatus)
+}
+
+// PlaceOrder handles placing an order from a user's cart
+func (h *OrderHandler) PlaceOrder(c *fiber.Ctx) error {
+	var cmd commands.PlaceOrderCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	orderID, err := h.placeOrderHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		if errors.Is(err, user.ErrEmailNotVerified) {
+			status = fiber.StatusForbidden
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": orderID,
+	})
+}
+
+// PlaceGuestOrder handles placing an order from the cart of an anonymous visitor
+func (h *OrderHandler) PlaceGuestOrder(c *fiber.Ctx) error {
+	var cmd commands.PlaceGuestOrderCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	orderID, err := h.placeGuestOrderHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": orderID,
+	})
+}
+
+// GetOrder handles retrieving an order by ID
+func (h *OrderHandler) GetOrder(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Order ID is required",
+		})
+	}
+
+	query := queries.GetOrderQuery{
+		ID: id,
+	}
+
+	order, err := h.getOrderHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Order not found",
+		})
+	}
+
+	return c.JSON(order)
+}
+
+// UpdateOrderStatus handles changing the status of an order
+func (h *OrderHandler) UpdateOrderStatus(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Order ID is required",
+		})
+	}
+
+	var cmd commands.UpdateOrderStatusCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.updateOrderStatusHandler.Handle(c.Context(), cmd); err != nil {
+		status := fiber.StatusInternalServerError
+		if errors.Is(err, order.ErrInvalidTransition) {
+			status = fiber.StatusConflict
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Order status updated successfully",
+	})
+}
+
+// ListUserOrders handles listing the orders of a user with pagination
+func (h *OrderHandler) ListUserOrders(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	if userID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID is required",
+		})
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.ListUserOrdersQuery{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	orders, err := h.listUserOrdersHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(orders)
+}
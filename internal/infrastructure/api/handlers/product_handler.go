@@ -0,0 +1,943 @@
+package handlers
+
+import (
+	"bufio"
+	"e-commerce/internal/application/product/commands"
+	"e-commerce/internal/application/product/queries"
+	"io"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ProductHandler handles HTTP requests related to products
+type ProductHandler struct {
+	createProductHandler       *commands.CreateProductHandler
+	updateProductHandler       *commands.UpdateProductHandler
+	archiveProductHandler      *commands.ArchiveProductHandler
+	publishProductHandler      *commands.PublishProductHandler
+	unpublishProductHandler    *commands.UnpublishProductHandler
+	addVariantHandler          *commands.AddVariantHandler
+	updateVariantHandler       *commands.UpdateVariantHandler
+	removeVariantHandler       *commands.RemoveVariantHandler
+	transferStockHandler       *commands.TransferStockHandler
+	uploadImageHandler         *commands.UploadImageHandler
+	updateImageHandler         *commands.UpdateImageHandler
+	reorderImagesHandler       *commands.ReorderImagesHandler
+	removeImageHandler         *commands.RemoveImageHandler
+	schedulePriceHandler       *commands.SchedulePriceHandler
+	cancelPriceScheduleHandler *commands.CancelPriceScheduleHandler
+	reindexProductsHandler     *commands.ReindexProductsHandler
+	importProductsHandler      *commands.ImportProductsHandler
+	getProductHandler          *queries.GetProductHandler
+	listProductsHandler        *queries.ListProductsHandler
+	searchProductsHandler      *queries.SearchProductsHandler
+	productFacetsHandler       *queries.GetProductFacetsHandler
+	suggestProductsHandler     *queries.SuggestProductsHandler
+	priceHistoryHandler        *queries.GetPriceHistoryHandler
+	stockMovementsHandler      *queries.GetStockMovementsHandler
+	reconcileStockHandler      *queries.ReconcileStockHandler
+	reorderReportHandler       *queries.GetReorderReportHandler
+	importJobHandler           *queries.GetImportJobHandler
+	exportProductsHandler      *queries.ExportProductsHandler
+}
+
+// NewProductHandler creates a new ProductHandler
+func NewProductHandler(
+	createProductHandler *commands.CreateProductHandler,
+	updateProductHandler *commands.UpdateProductHandler,
+	archiveProductHandler *commands.ArchiveProductHandler,
+	publishProductHandler *commands.PublishProductHandler,
+	unpublishProductHandler *commands.UnpublishProductHandler,
+	addVariantHandler *commands.AddVariantHandler,
+	updateVariantHandler *commands.UpdateVariantHandler,
+	removeVariantHandler *commands.RemoveVariantHandler,
+	transferStockHandler *commands.TransferStockHandler,
+	uploadImageHandler *commands.UploadImageHandler,
+	updateImageHandler *commands.UpdateImageHandler,
+	reorderImagesHandler *commands.ReorderImagesHandler,
+	removeImageHandler *commands.RemoveImageHandler,
+	schedulePriceHandler *commands.SchedulePriceHandler,
+	cancelPriceScheduleHandler *commands.CancelPriceScheduleHandler,
+	reindexProductsHandler *commands.ReindexProductsHandler,
+	importProductsHandler *commands.ImportProductsHandler,
+	getProductHandler *queries.GetProductHandler,
+	listProductsHandler *queries.ListProductsHandler,
+	searchProductsHandler *queries.SearchProductsHandler,
+	productFacetsHandler *queries.GetProductFacetsHandler,
+	suggestProductsHandler *queries.SuggestProductsHandler,
+	priceHistoryHandler *queries.GetPriceHistoryHandler,
+	stockMovementsHandler *queries.GetStockMovementsHandler,
+	reconcileStockHandler *queries.ReconcileStockHandler,
+	reorderReportHandler *queries.GetReorderReportHandler,
+	importJobHandler *queries.GetImportJobHandler,
+	exportProductsHandler *queries.ExportProductsHandler,
+) *ProductHandler {
+	return &ProductHandler{
+		createProductHandler:       createProductHandler,
+		updateProductHandler:       updateProductHandler,
+		archiveProductHandler:      archiveProductHandler,
+		publishProductHandler:      publishProductHandler,
+		unpublishProductHandler:    unpublishProductHandler,
+		addVariantHandler:          addVariantHandler,
+		updateVariantHandler:       updateVariantHandler,
+		removeVariantHandler:       removeVariantHandler,
+		transferStockHandler:       transferStockHandler,
+		uploadImageHandler:         uploadImageHandler,
+		updateImageHandler:         updateImageHandler,
+		reorderImagesHandler:       reorderImagesHandler,
+		removeImageHandler:         removeImageHandler,
+		schedulePriceHandler:       schedulePriceHandler,
+		cancelPriceScheduleHandler: cancelPriceScheduleHandler,
+		reindexProductsHandler:     reindexProductsHandler,
+		importProductsHandler:      importProductsHandler,
+		getProductHandler:          getProductHandler,
+		listProductsHandler:        listProductsHandler,
+		searchProductsHandler:      searchProductsHandler,
+		productFacetsHandler:       productFacetsHandler,
+		suggestProductsHandler:     suggestProductsHandler,
+		priceHistoryHandler:        priceHistoryHandler,
+		stockMovementsHandler:      stockMovementsHandler,
+		reconcileStockHandler:      reconcileStockHandler,
+		reorderReportHandler:       reorderReportHandler,
+		importJobHandler:           importJobHandler,
+		exportProductsHandler:      exportProductsHandler,
+	}
+}
+
+// RegisterRoutes registers the product routes
+func (h *ProductHandler) RegisterRoutes(app *fiber.App) {
+	products := app.Group("/api/products")
+
+	products.Post("/", h.CreateProduct)
+	products.Get("/", h.ListProducts)
+	products.Get("/search", h.SearchProducts)
+	products.Get("/facets", h.GetProductFacets)
+	products.Get("/suggest", h.SuggestProducts)
+	products.Post("/reindex", h.ReindexProducts)
+	products.Post("/import", h.ImportProducts)
+	products.Get("/import/:jobId", h.GetImportJob)
+	products.Get("/export", h.ExportProducts)
+	products.Get("/stock/reconciliation", h.ReconcileStock)
+	products.Get("/stock/reorder", h.GetReorderReport)
+	products.Get("/:id", h.GetProduct)
+	products.Put("/:id", h.UpdateProduct)
+	products.Delete("/:id", h.ArchiveProduct)
+	products.Post("/:id/publish", h.PublishProduct)
+	products.Post("/:id/unpublish", h.UnpublishProduct)
+	products.Post("/:id/variants", h.AddVariant)
+	products.Put("/:id/variants/:variantId", h.UpdateVariant)
+	products.Delete("/:id/variants/:variantId", h.RemoveVariant)
+	products.Post("/:id/stock/transfers", h.TransferStock)
+	products.Get("/:id/stock/movements", h.GetStockMovements)
+	products.Post("/:id/images", h.UploadImage)
+	products.Put("/:id/images/order", h.ReorderImages)
+	products.Put("/:id/images/:imageId", h.UpdateImage)
+	products.Delete("/:id/images/:imageId", h.RemoveImage)
+	products.Get("/:id/price-history", h.GetPriceHistory)
+	products.Post("/:id/price-schedules", h.SchedulePrice)
+	products.Delete("/:id/price-schedules/:scheduleId", h.CancelPriceSchedule)
+}
+
+// CreateProduct handles the creation of a new product
+func (h *ProductHandler) CreateProduct(c *fiber.Ctx) error {
+	var cmd commands.CreateProductCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	productID, err := h.createProductHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": productID,
+	})
+}
+
+// UploadImage handles uploading an image of a product as the multipart form field "image"
+func (h *ProductHandler) UploadImage(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Image file is required",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid image file",
+		})
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid image file",
+		})
+	}
+
+	cmd := commands.UploadImageCommand{
+		ProductID: id,
+		AltText:   c.FormValue("alt_text"),
+		Data:      data,
+	}
+
+	imageID, err := h.uploadImageHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": imageID,
+	})
+}
+
+// UpdateImage handles changing the alt text of a product image
+func (h *ProductHandler) UpdateImage(c *fiber.Ctx) error {
+	id := c.Params("id")
+	imageID := c.Params("imageId")
+	if id == "" || imageID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID and image ID are required",
+		})
+	}
+
+	var cmd commands.UpdateImageCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ProductID = id
+	cmd.ImageID = imageID
+
+	if err := h.updateImageHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Image updated successfully",
+	})
+}
+
+// ReorderImages handles changing the display order of the images of a product
+func (h *ProductHandler) ReorderImages(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	var cmd commands.ReorderImagesCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ProductID = id
+
+	if err := h.reorderImagesHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Images reordered successfully",
+	})
+}
+
+// RemoveImage handles removing an image from a product
+func (h *ProductHandler) RemoveImage(c *fiber.Ctx) error {
+	id := c.Params("id")
+	imageID := c.Params("imageId")
+	if id == "" || imageID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID and image ID are required",
+		})
+	}
+
+	cmd := commands.RemoveImageCommand{
+		ProductID: id,
+		ImageID:   imageID,
+	}
+
+	if err := h.removeImageHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Image removed successfully",
+	})
+}
+
+// SchedulePrice handles scheduling a change of a product price, or a sale when it has an end
+func (h *ProductHandler) SchedulePrice(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	var cmd commands.SchedulePriceCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ProductID = id
+
+	scheduleID, err := h.schedulePriceHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": scheduleID,
+	})
+}
+
+// CancelPriceSchedule handles cancelling a price schedule of a product
+func (h *ProductHandler) CancelPriceSchedule(c *fiber.Ctx) error {
+	id := c.Params("id")
+	scheduleID := c.Params("scheduleId")
+	if id == "" || scheduleID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID and schedule ID are required",
+		})
+	}
+
+	cmd := commands.CancelPriceScheduleCommand{
+		ProductID:  id,
+		ScheduleID: scheduleID,
+	}
+
+	if err := h.cancelPriceScheduleHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Price schedule cancelled successfully",
+	})
+}
+
+// GetPriceHistory handles getting the price history of a product, most recent first
+func (h *ProductHandler) GetPriceHistory(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.GetPriceHistoryQuery{
+		ProductID: id,
+		Limit:     limit,
+		Offset:    offset,
+	}
+
+	history, err := h.priceHistoryHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Product not found",
+		})
+	}
+
+	return c.JSON(history)
+}
+
+// GetStockMovements handles retrieving the stock ledger of a product with pagination
+func (h *ProductHandler) GetStockMovements(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.GetStockMovementsQuery{
+		ProductID: id,
+		Limit:     limit,
+		Offset:    offset,
+	}
+
+	movements, err := h.stockMovementsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Product not found",
+		})
+	}
+
+	return c.JSON(movements)
+}
+
+// ReconcileStock handles recomputing stock from the stock ledger and reporting drift
+func (h *ProductHandler) ReconcileStock(c *fiber.Ctx) error {
+	reconciliation, err := h.reconcileStockHandler.Handle(c.Context(), queries.ReconcileStockQuery{})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(reconciliation)
+}
+
+// GetReorderReport handles suggesting reorder quantities from recent sales velocity
+func (h *ProductHandler) GetReorderReport(c *fiber.Ctx) error {
+	days, err := strconv.Atoi(c.Query("days", "30"))
+	if err != nil {
+		days = 30
+	}
+
+	coverDays, err := strconv.Atoi(c.Query("cover_days", "30"))
+	if err != nil {
+		coverDays = 30
+	}
+
+	query := queries.GetReorderReportQuery{
+		Days:      days,
+		CoverDays: coverDays,
+	}
+
+	report, err := h.reorderReportHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(report)
+}
+
+// GetProduct handles retrieving a product by ID
+func (h *ProductHandler) GetProduct(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	query := queries.GetProductQuery{
+		ID:      id,
+		Preview: c.QueryBool("preview", false),
+	}
+
+	product, err := h.getProductHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Product not found",
+		})
+	}
+
+	return c.JSON(product)
+}
+
+// UpdateProduct handles updating a product
+func (h *ProductHandler) UpdateProduct(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	var cmd commands.UpdateProductCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.updateProductHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Product updated successfully",
+	})
+}
+
+// ArchiveProduct handles archiving a product; products are never deleted, as orders reference them
+func (h *ProductHandler) ArchiveProduct(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	cmd := commands.ArchiveProductCommand{
+		ID: id,
+	}
+
+	if err := h.archiveProductHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Product archived successfully",
+	})
+}
+
+// PublishProduct handles publishing a product, now or at the optional publish_at time
+func (h *ProductHandler) PublishProduct(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	var cmd commands.PublishProductCommand
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&cmd); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+			})
+		}
+	}
+
+	cmd.ID = id
+
+	if err := h.publishProductHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Product published successfully",
+	})
+}
+
+// UnpublishProduct handles taking a product back to draft
+func (h *ProductHandler) UnpublishProduct(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	cmd := commands.UnpublishProductCommand{
+		ID: id,
+	}
+
+	if err := h.unpublishProductHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Product unpublished successfully",
+	})
+}
+
+// AddVariant handles adding a variant to a product
+func (h *ProductHandler) AddVariant(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	var cmd commands.AddVariantCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ProductID = id
+
+	variantID, err := h.addVariantHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": variantID,
+	})
+}
+
+// UpdateVariant handles updating the price or stock of a product variant
+func (h *ProductHandler) UpdateVariant(c *fiber.Ctx) error {
+	id := c.Params("id")
+	variantID := c.Params("variantId")
+	if id == "" || variantID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID and variant ID are required",
+		})
+	}
+
+	var cmd commands.UpdateVariantCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ProductID = id
+	cmd.VariantID = variantID
+
+	if err := h.updateVariantHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Variant updated successfully",
+	})
+}
+
+// RemoveVariant handles removing a variant from a product
+func (h *ProductHandler) RemoveVariant(c *fiber.Ctx) error {
+	id := c.Params("id")
+	variantID := c.Params("variantId")
+	if id == "" || variantID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID and variant ID are required",
+		})
+	}
+
+	cmd := commands.RemoveVariantCommand{
+		ProductID: id,
+		VariantID: variantID,
+	}
+
+	if err := h.removeVariantHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Variant removed successfully",
+	})
+}
+
+// TransferStock handles moving stock of a product or variant between warehouses
+func (h *ProductHandler) TransferStock(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	var cmd commands.TransferStockCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ProductID = id
+
+	if err := h.transferStockHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Stock transferred successfully",
+	})
+}
+
+// ListProducts handles listing products with filters and pagination
+func (h *ProductHandler) ListProducts(c *fiber.Ctx) error {
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.ListProductsQuery{
+		FilterInput: parseProductFilter(c),
+		Limit:       limit,
+		Offset:      offset,
+	}
+
+	products, err := h.listProductsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(products)
+}
+
+// GetProductFacets handles counting the filtered products per attribute value
+func (h *ProductHandler) GetProductFacets(c *fiber.Ctx) error {
+	query := queries.GetProductFacetsQuery{
+		FilterInput: parseProductFilter(c),
+	}
+
+	facets, err := h.productFacetsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(facets)
+}
+
+// parseProductFilter reads product filters from the query string. Attribute filters
+// are given as repeated attr=name:value parameters; values of the same attribute are alternatives.
+func parseProductFilter(c *fiber.Ctx) queries.FilterInput {
+	filter := queries.FilterInput{
+		Status:     c.Query("status"),
+		Attributes: make(map[string][]string),
+		MinPrice:   c.QueryFloat("min_price", 0),
+		MaxPrice:   c.QueryFloat("max_price", 0),
+		InStock:    c.QueryBool("in_stock", false),
+	}
+
+	for _, raw := range c.Context().QueryArgs().PeekMulti("attr") {
+		name, value, ok := strings.Cut(string(raw), ":")
+		if ok && name != "" && value != "" {
+			filter.Attributes[name] = append(filter.Attributes[name], value)
+		}
+	}
+
+	return filter
+}
+
+// SearchProducts handles searching products by keyword, most relevant first
+func (h *ProductHandler) SearchProducts(c *fiber.Ctx) error {
+	if strings.TrimSpace(c.Query("query")) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Search query is required",
+		})
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.SearchProductsQuery{
+		Query:    c.Query("query"),
+		Language: c.Query("lang"),
+		Limit:    limit,
+		Offset:   offset,
+	}
+
+	products, err := h.searchProductsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(products)
+}
+
+// SuggestProducts handles autocompleting a search prefix with product names
+func (h *ProductHandler) SuggestProducts(c *fiber.Ctx) error {
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	query := queries.SuggestProductsQuery{
+		Prefix: c.Query("prefix"),
+		Limit:  limit,
+	}
+
+	suggestions, err := h.suggestProductsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(suggestions)
+}
+
+// ReindexProducts handles rebuilding the search index from the catalog
+func (h *ProductHandler) ReindexProducts(c *fiber.Ctx) error {
+	indexed, err := h.reindexProductsHandler.Handle(c.Context(), commands.ReindexProductsCommand{})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Products reindexed successfully",
+		"indexed": indexed,
+	})
+}
+
+// ImportProducts handles uploading a catalog file as the multipart form field "file" to import
+// in the background. The format is taken from the format parameter or the file extension.
+func (h *ProductHandler) ImportProducts(c *fiber.Ctx) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Import file is required",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid import file",
+		})
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid import file",
+		})
+	}
+
+	format := c.Query("format", c.FormValue("format"))
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(fileHeader.Filename), ".")
+	}
+
+	cmd := commands.ImportProductsCommand{
+		Format:   format,
+		FileName: fileHeader.Filename,
+		Data:     data,
+	}
+
+	jobID, err := h.importProductsHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"id": jobID,
+	})
+}
+
+// GetImportJob handles retrieving the progress and row errors of a product import
+func (h *ProductHandler) GetImportJob(c *fiber.Ctx) error {
+	jobID := c.Params("jobId")
+	if jobID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Import job ID is required",
+		})
+	}
+
+	job, err := h.importJobHandler.Handle(c.Context(), queries.GetImportJobQuery{ID: jobID})
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Import job not found",
+		})
+	}
+
+	return c.JSON(job)
+}
+
+// ExportProducts handles streaming the filtered catalog as a csv or ndjson file
+func (h *ProductHandler) ExportProducts(c *fiber.Ctx) error {
+	query := queries.ExportProductsQuery{
+		FilterInput: parseProductFilter(c),
+		Format:      c.Query("format", "csv"),
+	}
+
+	export, err := h.exportProductsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, export.ContentType)
+	c.Attachment(export.FileName)
+
+	ctx := c.Context()
+	ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := export.Write(ctx, w); err != nil {
+			log.Printf("Failed to export products: %v", err)
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/promotion/commands"
+	"e-commerce/internal/application/promotion/queries"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PromotionHandler handles HTTP requests related to promotions
+type PromotionHandler struct {
+	createPromotionHandler *commands.CreatePromotionHandler
+	updatePromotionHandler *commands.UpdatePromotionHandler
+	deletePromotionHandler *commands.DeletePromotionHandler
+	getPromotionHandler    *queries.GetPromotionHandler
+	listPromotionsHandler  *queries.ListPromotionsHandler
+}
+
+// NewPromotionHandler creates a new PromotionHandler
+func NewPromotionHandler(
+	createPromotionHandler *commands.CreatePromotionHandler,
+	updatePromotionHandler *commands.UpdatePromotionHandler,
+	deletePromotionHandler *commands.DeletePromotionHandler,
+	getPromotionHandler *queries.GetPromotionHandler,
+	listPromotionsHandler *queries.ListPromotionsHandler,
+) *PromotionHandler {
+	return &PromotionHandler{
+		createPromotionHandler: createPromotionHandler,
+		updatePromotionHandler: updatePromotionHandler,
+		deletePromotionHandler: deletePromotionHandler,
+		getPromotionHandler:    getPromotionHandler,
+		listPromotionsHandler:  listPromotionsHandler,
+	}
+}
+
+// RegisterRoutes registers the promotion routes
+func (h *PromotionHandler) RegisterRoutes(app *fiber.App) {
+	promotions := app.Group("/api/promotions")
+
+	promotions.Post("/", h.CreatePromotion)
+	promotions.Get("/", h.ListPromotions)
+	promotions.Get("/:id", h.GetPromotion)
+	promotions.Put("/:id", h.UpdatePromotion)
+	promotions.Delete("/:id", h.DeletePromotion)
+}
+
+// CreatePromotion handles the creation of a new promotion
+func (h *PromotionHandler) CreatePromotion(c *fiber.Ctx) error {
+	var cmd commands.CreatePromotionCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	promotionID, err := h.createPromotionHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": promotionID,
+	})
+}
+
+// GetPromotion handles retrieving a promotion by ID
+func (h *PromotionHandler) GetPromotion(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Promotion ID is required",
+		})
+	}
+
+	query := queries.GetPromotionQuery{
+		ID: id,
+	}
+
+	promotion, err := h.getPromotionHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Promotion not found",
+		})
+	}
+
+	return c.JSON(promotion)
+}
+
+// UpdatePromotion handles updating a promotion
+func (h *PromotionHandler) UpdatePromotion(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Promotion ID is required",
+		})
+	}
+
+	var cmd commands.UpdatePromotionCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.updatePromotionHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Promotion updated successfully",
+	})
+}
+
+// DeletePromotion handles deleting a promotion
+func (h *PromotionHandler) DeletePromotion(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Promotion ID is required",
+		})
+	}
+
+	cmd := commands.DeletePromotionCommand{
+		ID: id,
+	}
+
+	if err := h.deletePromotionHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Promotion deleted successfully",
+	})
+}
+
+// ListPromotions handles listing promotions with pagination
+func (h *PromotionHandler) ListPromotions(c *fiber.Ctx) error {
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.ListPromotionsQuery{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	promotions, err := h.listPromotionsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(promotions)
+}
@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/review/commands"
+	"e-commerce/internal/application/review/queries"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReviewHandler handles HTTP requests related to product reviews
+type ReviewHandler struct {
+	createReviewHandler       *commands.CreateReviewHandler
+	updateReviewHandler       *commands.UpdateReviewHandler
+	deleteReviewHandler       *commands.DeleteReviewHandler
+	moderateReviewHandler     *commands.ModerateReviewHandler
+	voteReviewHandler         *commands.VoteReviewHandler
+	getReviewHandler          *queries.GetReviewHandler
+	listReviewsHandler        *queries.ListReviewsHandler
+	listProductReviewsHandler *queries.ListProductReviewsHandler
+}
+
+// NewReviewHandler creates a new ReviewHandler
+func NewReviewHandler(
+	createReviewHandler *commands.CreateReviewHandler,
+	updateReviewHandler *commands.UpdateReviewHandler,
+	deleteReviewHandler *commands.DeleteReviewHandler,
+	moderateReviewHandler *commands.ModerateReviewHandler,
+	voteReviewHandler *commands.VoteReviewHandler,
+	getReviewHandler *queries.GetReviewHandler,
+	listReviewsHandler *queries.ListReviewsHandler,
+	listProductReviewsHandler *queries.ListProductReviewsHandler,
+) *ReviewHandler {
+	return &ReviewHandler{
+		createReviewHandler:       createReviewHandler,
+		updateReviewHandler:       updateReviewHandler,
+		deleteReviewHandler:       deleteReviewHandler,
+		moderateReviewHandler:     moderateReviewHandler,
+		voteReviewHandler:         voteReviewHandler,
+		getReviewHandler:          getReviewHandler,
+		listReviewsHandler:        listReviewsHandler,
+		listProductReviewsHandler: listProductReviewsHandler,
+	}
+}
+
+// RegisterRoutes registers the review routes
+func (h *ReviewHandler) RegisterRoutes(app *fiber.App) {
+	app.Get("/api/products/:id/reviews", h.ListProductReviews)
+	app.Post("/api/products/:id/reviews", h.CreateReview)
+
+	reviews := app.Group("/api/reviews")
+
+	reviews.Get("/", h.ListReviews)
+	reviews.Get("/:id", h.GetReview)
+	reviews.Put("/:id", h.UpdateReview)
+	reviews.Delete("/:id", h.DeleteReview)
+	reviews.Put("/:id/moderation", h.ModerateReview)
+	reviews.Post("/:id/votes", h.VoteReview)
+}
+
+// CreateReview handles a user reviewing a product
+func (h *ReviewHandler) CreateReview(c *fiber.Ctx) error {
+	productID := c.Params("id")
+	if productID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	var cmd commands.CreateReviewCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ProductID = productID
+
+	reviewID, err := h.createReviewHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": reviewID,
+	})
+}
+
+// ListProductReviews handles listing the approved reviews of a product with its rating summary
+func (h *ReviewHandler) ListProductReviews(c *fiber.Ctx) error {
+	productID := c.Params("id")
+	if productID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Product ID is required",
+		})
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.ListProductReviewsQuery{
+		ProductID:    productID,
+		Sort:         c.Query("sort"),
+		VerifiedOnly: c.QueryBool("verified", false),
+		Limit:        limit,
+		Offset:       offset,
+	}
+
+	reviews, err := h.listProductReviewsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(reviews)
+}
+
+// ListReviews handles listing the reviews in a moderation state, pending by default
+func (h *ReviewHandler) ListReviews(c *fiber.Ctx) error {
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil {
+		limit = 10
+	}
+
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		offset = 0
+	}
+
+	query := queries.ListReviewsQuery{
+		Status: c.Query("status"),
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	reviews, err := h.listReviewsHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(reviews)
+}
+
+// GetReview handles retrieving a review by ID
+func (h *ReviewHandler) GetReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Review ID is required",
+		})
+	}
+
+	query := queries.GetReviewQuery{
+		ID: id,
+	}
+
+	review, err := h.getReviewHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Review not found",
+		})
+	}
+
+	return c.JSON(review)
+}
+
+// UpdateReview handles the author of a review changing it
+func (h *ReviewHandler) UpdateReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Review ID is required",
+		})
+	}
+
+	var cmd commands.UpdateReviewCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.updateReviewHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Review updated successfully",
+	})
+}
+
+// DeleteReview handles deleting a review
+func (h *ReviewHandler) DeleteReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Review ID is required",
+		})
+	}
+
+	cmd := commands.DeleteReviewCommand{
+		ID: id,
+	}
+
+	if err := h.deleteReviewHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Review deleted successfully",
+	})
+}
+
+// ModerateReview handles approving or rejecting a review
+func (h *ReviewHandler) ModerateReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Review ID is required",
+		})
+	}
+
+	var cmd commands.ModerateReviewCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.moderateReviewHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Review moderated successfully",
+	})
+}
+
+// VoteReview handles a user voting on whether a review is helpful
+func (h *ReviewHandler) VoteReview(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Review ID is required",
+		})
+	}
+
+	var cmd commands.VoteReviewCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.voteReviewHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Vote recorded successfully",
+	})
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/shipping/commands"
+	"e-commerce/internal/application/shipping/queries"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ShippingHandler handles HTTP requests related to shipping
+type ShippingHandler struct {
+	createZoneHandler    *commands.CreateZoneHandler
+	deleteZoneHandler    *commands.DeleteZoneHandler
+	listZonesHandler     *queries.ListZonesHandler
+	quoteShippingHandler *queries.QuoteShippingHandler
+}
+
+// NewShippingHandler creates a new ShippingHandler
+func NewShippingHandler(
+	createZoneHandler *commands.CreateZoneHandler,
+	deleteZoneHandler *commands.DeleteZoneHandler,
+	listZonesHandler *queries.ListZonesHandler,
+	quoteShippingHandler *queries.QuoteShippingHandler,
+) *ShippingHandler {
+	return &ShippingHandler{
+		createZoneHandler:    createZoneHandler,
+		deleteZoneHandler:    deleteZoneHandler,
+		listZonesHandler:     listZonesHandler,
+		quoteShippingHandler: quoteShippingHandler,
+	}
+}
+
+// RegisterRoutes registers the shipping routes
+func (h *ShippingHandler) RegisterRoutes(app *fiber.App) {
+	shipping := app.Group("/api/shipping")
+
+	shipping.Get("/quote", h.QuoteShipping)
+	shipping.Post("/zones", h.CreateZone)
+	shipping.Get("/zones", h.ListZones)
+	shipping.Delete("/zones/:id", h.DeleteZone)
+}
+
+// CreateZone handles the creation of a shipping zone
+func (h *ShippingHandler) CreateZone(c *fiber.Ctx) error {
+	var cmd commands.CreateZoneCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	zoneID, err := h.createZoneHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": zoneID,
+	})
+}
+
+// ListZones handles listing the shipping zones
+func (h *ShippingHandler) ListZones(c *fiber.Ctx) error {
+	zones, err := h.listZonesHandler.Handle(c.Context(), queries.ListZonesQuery{})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(zones)
+}
+
+// DeleteZone handles deleting a shipping zone
+func (h *ShippingHandler) DeleteZone(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Zone ID is required",
+		})
+	}
+
+	cmd := commands.DeleteZoneCommand{
+		ID: id,
+	}
+
+	if err := h.deleteZoneHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Shipping zone deleted successfully",
+	})
+}
+
+// QuoteShipping handles quoting the shipping options for a cart
+func (h *ShippingHandler) QuoteShipping(c *fiber.Ctx) error {
+	query := queries.QuoteShippingQuery{
+		CartID:  c.Query("cart_id"),
+		Country: c.Query("country"),
+	}
+
+	if query.CartID == "" || query.Country == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Cart ID and country are required",
+		})
+	}
+
+	quote, err := h.quoteShippingHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(quote)
+}
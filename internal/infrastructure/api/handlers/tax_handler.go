@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/tax/commands"
+	"e-commerce/internal/application/tax/queries"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TaxHandler handles HTTP requests related to tax rules
+type TaxHandler struct {
+	createRuleHandler *commands.CreateRuleHandler
+	deleteRuleHandler *commands.DeleteRuleHandler
+	listRulesHandler  *queries.ListRulesHandler
+}
+
+// NewTaxHandler creates a new TaxHandler
+func NewTaxHandler(
+	createRuleHandler *commands.CreateRuleHandler,
+	deleteRuleHandler *commands.DeleteRuleHandler,
+	listRulesHandler *queries.ListRulesHandler,
+) *TaxHandler {
+	return &TaxHandler{
+		createRuleHandler: createRuleHandler,
+		deleteRuleHandler: deleteRuleHandler,
+		listRulesHandler:  listRulesHandler,
+	}
+}
+
+// RegisterRoutes registers the tax routes
+func (h *TaxHandler) RegisterRoutes(app *fiber.App) {
+	taxes := app.Group("/api/taxes")
+
+	taxes.Post("/rules", h.CreateRule)
+	taxes.Get("/rules", h.ListRules)
+	taxes.Delete("/rules/:id", h.DeleteRule)
+}
+
+// CreateRule handles the creation of a tax rule
+func (h *TaxHandler) CreateRule(c *fiber.Ctx) error {
+	var cmd commands.CreateRuleCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	ruleID, err := h.createRuleHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": ruleID,
+	})
+}
+
+// ListRules handles listing the tax rules
+func (h *TaxHandler) ListRules(c *fiber.Ctx) error {
+	rules, err := h.listRulesHandler.Handle(c.Context(), queries.ListRulesQuery{})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(rules)
+}
+
+// DeleteRule handles deleting a tax rule
+func (h *TaxHandler) DeleteRule(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Rule ID is required",
+		})
+	}
+
+	cmd := commands.DeleteRuleCommand{
+		ID: id,
+	}
+
+	if err := h.deleteRuleHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Tax rule deleted successfully",
+	})
+}
@@ -3,6 +3,8 @@ package handlers
 import (
 	"e-commerce/internal/application/user/commands"
 	"e-commerce/internal/application/user/queries"
+	"e-commerce/internal/domain/user"
+	"errors"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
@@ -10,11 +12,20 @@ import (
 
 // UserHandler handles HTTP requests related to users
 type UserHandler struct {
-	createUserHandler *commands.CreateUserHandler
-	updateUserHandler *commands.UpdateUserHandler
-	deleteUserHandler *commands.DeleteUserHandler
-	getUserHandler    *queries.GetUserHandler
-	listUsersHandler  *queries.ListUsersHandler
+	createUserHandler           *commands.CreateUserHandler
+	updateUserHandler           *commands.UpdateUserHandler
+	deleteUserHandler           *commands.DeleteUserHandler
+	addAddressHandler           *commands.AddAddressHandler
+	updateAddressHandler        *commands.UpdateAddressHandler
+	removeAddressHandler        *commands.RemoveAddressHandler
+	setDefaultAddressHandler    *commands.SetDefaultAddressHandler
+	requestPasswordResetHandler *commands.RequestPasswordResetHandler
+	resetPasswordHandler        *commands.ResetPasswordHandler
+	verifyEmailHandler          *commands.VerifyEmailHandler
+	resendVerificationHandler   *commands.ResendVerificationHandler
+	getUserHandler              *queries.GetUserHandler
+	listUsersHandler            *queries.ListUsersHandler
+	listAddressesHandler        *queries.ListAddressesHandler
 }
 
 // NewUserHandler creates a new UserHandler
@@ -22,15 +33,33 @@ func NewUserHandler(
 	createUserHandler *commands.CreateUserHandler,
 	updateUserHandler *commands.UpdateUserHandler,
 	deleteUserHandler *commands.DeleteUserHandler,
+	addAddressHandler *commands.AddAddressHandler,
+	updateAddressHandler *commands.UpdateAddressHandler,
+	removeAddressHandler *commands.RemoveAddressHandler,
+	setDefaultAddressHandler *commands.SetDefaultAddressHandler,
+	requestPasswordResetHandler *commands.RequestPasswordResetHandler,
+	resetPasswordHandler *commands.ResetPasswordHandler,
+	verifyEmailHandler *commands.VerifyEmailHandler,
+	resendVerificationHandler *commands.ResendVerificationHandler,
 	getUserHandler *queries.GetUserHandler,
 	listUsersHandler *queries.ListUsersHandler,
+	listAddressesHandler *queries.ListAddressesHandler,
 ) *UserHandler {
 	return &UserHandler{
-		createUserHandler: createUserHandler,
-		updateUserHandler: updateUserHandler,
-		deleteUserHandler: deleteUserHandler,
-		getUserHandler:    getUserHandler,
-		listUsersHandler:  listUsersHandler,
+		createUserHandler:           createUserHandler,
+		updateUserHandler:           updateUserHandler,
+		deleteUserHandler:           deleteUserHandler,
+		addAddressHandler:           addAddressHandler,
+		updateAddressHandler:        updateAddressHandler,
+		removeAddressHandler:        removeAddressHandler,
+		setDefaultAddressHandler:    setDefaultAddressHandler,
+		requestPasswordResetHandler: requestPasswordResetHandler,
+		resetPasswordHandler:        resetPasswordHandler,
+		verifyEmailHandler:          verifyEmailHandler,
+		resendVerificationHandler:   resendVerificationHandler,
+		getUserHandler:              getUserHandler,
+		listUsersHandler:            listUsersHandler,
+		listAddressesHandler:        listAddressesHandler,
 	}
 }
 
@@ -39,10 +68,19 @@ func (h *UserHandler) RegisterRoutes(app *fiber.App) {
 	users := app.Group("/api/users")
 
 	users.Post("/", h.CreateUser)
+	users.Post("/password-reset", h.RequestPasswordReset)
+	users.Post("/password-reset/confirm", h.ResetPassword)
+	users.Get("/verify-email/:token", h.VerifyEmail)
 	users.Get("/", h.ListUsers)
 	users.Get("/:id", h.GetUser)
 	users.Put("/:id", h.UpdateUser)
 	users.Delete("/:id", h.DeleteUser)
+	users.Post("/:id/verification", h.ResendVerification)
+	users.Get("/:id/addresses", h.ListAddresses)
+	users.Post("/:id/addresses", h.AddAddress)
+	users.Put("/:id/addresses/:addressId", h.UpdateAddress)
+	users.Delete("/:id/addresses/:addressId", h.RemoveAddress)
+	users.Put("/:id/addresses/:addressId/default", h.SetDefaultAddress)
 }
 
 // CreateUser handles the creation of a new user
@@ -66,6 +104,104 @@ func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 	})
 }
 
+// RequestPasswordReset handles sending a user the link to choose a new password
+func (h *UserHandler) RequestPasswordReset(c *fiber.Ctx) error {
+	var cmd commands.RequestPasswordResetCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := h.requestPasswordResetHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message": "If an account uses this email address, a password reset link was sent to it",
+	})
+}
+
+// ResetPassword handles choosing a new password with the token of a reset link
+func (h *UserHandler) ResetPassword(c *fiber.Ctx) error {
+	var cmd commands.ResetPasswordCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := h.resetPasswordHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Password reset successfully",
+	})
+}
+
+// VerifyEmail handles verifying a user's email address with the token of a verification link
+func (h *UserHandler) VerifyEmail(c *fiber.Ctx) error {
+	token := c.Params("token")
+	if token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Verification token is required",
+		})
+	}
+
+	userID, err := h.verifyEmailHandler.Handle(c.Context(), commands.VerifyEmailCommand{Token: token})
+	if err != nil {
+		return c.Status(verificationErrorStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"id":      userID,
+		"message": "Email address verified successfully",
+	})
+}
+
+// ResendVerification handles sending a user a new link to verify their email address
+func (h *UserHandler) ResendVerification(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID is required",
+		})
+	}
+
+	if err := h.resendVerificationHandler.Handle(c.Context(), commands.ResendVerificationCommand{UserID: id}); err != nil {
+		return c.Status(verificationErrorStatus(err)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message": "A new verification link was sent",
+	})
+}
+
+// verificationErrorStatus returns the HTTP status of an email verification error
+func verificationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, user.ErrInvalidVerificationToken):
+		return fiber.StatusBadRequest
+	case errors.Is(err, user.ErrVerificationTokenExpired):
+		return fiber.StatusGone
+	case errors.Is(err, user.ErrVerificationRateLimited):
+		return fiber.StatusTooManyRequests
+	case errors.Is(err, user.ErrEmailAlreadyVerified):
+		return fiber.StatusConflict
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
 // GetUser handles retrieving a user by ID
 func (h *UserHandler) GetUser(c *fiber.Ctx) error {
 	id := c.Params("id")
@@ -108,7 +244,7 @@ func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 	cmd.ID = id
 
 	if err := h.updateUserHandler.Handle(c.Context(), cmd); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(verificationErrorStatus(err)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -171,3 +307,144 @@ func (h *UserHandler) ListUsers(c *fiber.Ctx) error {
 
 	return c.JSON(users)
 }
+
+// ListAddresses handles listing the address book of a user
+func (h *UserHandler) ListAddresses(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID is required",
+		})
+	}
+
+	query := queries.ListAddressesQuery{
+		UserID: id,
+	}
+
+	addresses, err := h.listAddressesHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User not found",
+		})
+	}
+
+	return c.JSON(addresses)
+}
+
+// AddAddress handles saving an address in the address book of a user
+func (h *UserHandler) AddAddress(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID is required",
+		})
+	}
+
+	var cmd commands.AddAddressCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.UserID = id
+
+	addressID, err := h.addAddressHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": addressID,
+	})
+}
+
+// UpdateAddress handles replacing a saved address of a user
+func (h *UserHandler) UpdateAddress(c *fiber.Ctx) error {
+	id := c.Params("id")
+	addressID := c.Params("addressId")
+	if id == "" || addressID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID and address ID are required",
+		})
+	}
+
+	var cmd commands.UpdateAddressCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.UserID = id
+	cmd.AddressID = addressID
+
+	if err := h.updateAddressHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Address updated successfully",
+	})
+}
+
+// RemoveAddress handles removing a saved address of a user
+func (h *UserHandler) RemoveAddress(c *fiber.Ctx) error {
+	id := c.Params("id")
+	addressID := c.Params("addressId")
+	if id == "" || addressID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID and address ID are required",
+		})
+	}
+
+	cmd := commands.RemoveAddressCommand{
+		UserID:    id,
+		AddressID: addressID,
+	}
+
+	if err := h.removeAddressHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Address removed successfully",
+	})
+}
+
+// SetDefaultAddress handles making a saved address the default shipping and/or billing address
+func (h *UserHandler) SetDefaultAddress(c *fiber.Ctx) error {
+	id := c.Params("id")
+	addressID := c.Params("addressId")
+	if id == "" || addressID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID and address ID are required",
+		})
+	}
+
+	var cmd commands.SetDefaultAddressCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.UserID = id
+	cmd.AddressID = addressID
+
+	if err := h.setDefaultAddressHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Default address updated successfully",
+	})
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/warehouse/commands"
+	"e-commerce/internal/application/warehouse/queries"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WarehouseHandler handles HTTP requests related to warehouses
+type WarehouseHandler struct {
+	createWarehouseHandler *commands.CreateWarehouseHandler
+	updateWarehouseHandler *commands.UpdateWarehouseHandler
+	deleteWarehouseHandler *commands.DeleteWarehouseHandler
+	getWarehouseHandler    *queries.GetWarehouseHandler
+	listWarehousesHandler  *queries.ListWarehousesHandler
+}
+
+// NewWarehouseHandler creates a new WarehouseHandler
+func NewWarehouseHandler(
+	createWarehouseHandler *commands.CreateWarehouseHandler,
+	updateWarehouseHandler *commands.UpdateWarehouseHandler,
+	deleteWarehouseHandler *commands.DeleteWarehouseHandler,
+	getWarehouseHandler *queries.GetWarehouseHandler,
+	listWarehousesHandler *queries.ListWarehousesHandler,
+) *WarehouseHandler {
+	return &WarehouseHandler{
+		createWarehouseHandler: createWarehouseHandler,
+		updateWarehouseHandler: updateWarehouseHandler,
+		deleteWarehouseHandler: deleteWarehouseHandler,
+		getWarehouseHandler:    getWarehouseHandler,
+		listWarehousesHandler:  listWarehousesHandler,
+	}
+}
+
+// RegisterRoutes registers the warehouse routes
+func (h *WarehouseHandler) RegisterRoutes(app *fiber.App) {
+	warehouses := app.Group("/api/warehouses")
+
+	warehouses.Post("/", h.CreateWarehouse)
+	warehouses.Get("/", h.ListWarehouses)
+	warehouses.Get("/:id", h.GetWarehouse)
+	warehouses.Put("/:id", h.UpdateWarehouse)
+	warehouses.Delete("/:id", h.DeleteWarehouse)
+}
+
+// CreateWarehouse handles the creation of a new warehouse
+func (h *WarehouseHandler) CreateWarehouse(c *fiber.Ctx) error {
+	var cmd commands.CreateWarehouseCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	warehouseID, err := h.createWarehouseHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": warehouseID,
+	})
+}
+
+// ListWarehouses handles listing all warehouses by priority
+func (h *WarehouseHandler) ListWarehouses(c *fiber.Ctx) error {
+	warehouses, err := h.listWarehousesHandler.Handle(c.Context(), queries.ListWarehousesQuery{})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(warehouses)
+}
+
+// GetWarehouse handles retrieving a warehouse by ID
+func (h *WarehouseHandler) GetWarehouse(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Warehouse ID is required",
+		})
+	}
+
+	query := queries.GetWarehouseQuery{
+		ID: id,
+	}
+
+	warehouse, err := h.getWarehouseHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Warehouse not found",
+		})
+	}
+
+	return c.JSON(warehouse)
+}
+
+// UpdateWarehouse handles updating a warehouse
+func (h *WarehouseHandler) UpdateWarehouse(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Warehouse ID is required",
+		})
+	}
+
+	var cmd commands.UpdateWarehouseCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.ID = id
+
+	if err := h.updateWarehouseHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Warehouse updated successfully",
+	})
+}
+
+// DeleteWarehouse handles deleting a warehouse that holds no stock
+func (h *WarehouseHandler) DeleteWarehouse(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Warehouse ID is required",
+		})
+	}
+
+	cmd := commands.DeleteWarehouseCommand{
+		ID: id,
+	}
+
+	if err := h.deleteWarehouseHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Warehouse deleted successfully",
+	})
+}
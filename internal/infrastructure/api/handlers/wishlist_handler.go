@@ -0,0 +1,374 @@
+package handlers
+
+import (
+	"e-commerce/internal/application/wishlist/commands"
+	"e-commerce/internal/application/wishlist/queries"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WishlistHandler handles HTTP requests related to wishlists
+type WishlistHandler struct {
+	createListHandler         *commands.CreateListHandler
+	renameListHandler         *commands.RenameListHandler
+	removeListHandler         *commands.RemoveListHandler
+	addWishlistItemHandler    *commands.AddWishlistItemHandler
+	removeWishlistItemHandler *commands.RemoveWishlistItemHandler
+	moveToCartHandler         *commands.MoveToCartHandler
+	saveForLaterHandler       *commands.SaveForLaterHandler
+	shareListHandler          *commands.ShareListHandler
+	unshareListHandler        *commands.UnshareListHandler
+	getWishlistHandler        *queries.GetWishlistHandler
+	getSharedListHandler      *queries.GetSharedListHandler
+}
+
+// NewWishlistHandler creates a new WishlistHandler
+func NewWishlistHandler(
+	createListHandler *commands.CreateListHandler,
+	renameListHandler *commands.RenameListHandler,
+	removeListHandler *commands.RemoveListHandler,
+	addWishlistItemHandler *commands.AddWishlistItemHandler,
+	removeWishlistItemHandler *commands.RemoveWishlistItemHandler,
+	moveToCartHandler *commands.MoveToCartHandler,
+	saveForLaterHandler *commands.SaveForLaterHandler,
+	shareListHandler *commands.ShareListHandler,
+	unshareListHandler *commands.UnshareListHandler,
+	getWishlistHandler *queries.GetWishlistHandler,
+	getSharedListHandler *queries.GetSharedListHandler,
+) *WishlistHandler {
+	return &WishlistHandler{
+		createListHandler:         createListHandler,
+		renameListHandler:         renameListHandler,
+		removeListHandler:         removeListHandler,
+		addWishlistItemHandler:    addWishlistItemHandler,
+		removeWishlistItemHandler: removeWishlistItemHandler,
+		moveToCartHandler:         moveToCartHandler,
+		saveForLaterHandler:       saveForLaterHandler,
+		shareListHandler:          shareListHandler,
+		unshareListHandler:        unshareListHandler,
+		getWishlistHandler:        getWishlistHandler,
+		getSharedListHandler:      getSharedListHandler,
+	}
+}
+
+// RegisterRoutes registers the wishlist routes
+func (h *WishlistHandler) RegisterRoutes(app *fiber.App) {
+	wishlists := app.Group("/api/wishlists")
+
+	wishlists.Get("/shared/:token", h.GetSharedList)
+	wishlists.Get("/user/:userId", h.GetWishlist)
+	wishlists.Post("/user/:userId/lists", h.CreateList)
+	wishlists.Put("/user/:userId/lists/:listId", h.RenameList)
+	wishlists.Delete("/user/:userId/lists/:listId", h.RemoveList)
+	wishlists.Put("/user/:userId/lists/:listId/items", h.AddWishlistItem)
+	wishlists.Delete("/user/:userId/lists/:listId/items/:productId", h.RemoveWishlistItem)
+	wishlists.Post("/user/:userId/lists/:listId/items/:productId/cart", h.MoveToCart)
+	wishlists.Post("/user/:userId/lists/:listId/share", h.ShareList)
+	wishlists.Delete("/user/:userId/lists/:listId/share", h.UnshareList)
+	wishlists.Post("/user/:userId/saved", h.SaveForLater)
+}
+
+// GetWishlist handles retrieving the wishlist of a user
+func (h *WishlistHandler) GetWishlist(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	if userID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID is required",
+		})
+	}
+
+	query := queries.GetWishlistQuery{
+		UserID: userID,
+	}
+
+	wishlist, err := h.getWishlistHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Wishlist not found",
+		})
+	}
+
+	return c.JSON(wishlist)
+}
+
+// GetSharedList handles retrieving a list through its public link
+func (h *WishlistHandler) GetSharedList(c *fiber.Ctx) error {
+	query := queries.GetSharedListQuery{
+		Token: c.Params("token"),
+	}
+
+	list, err := h.getSharedListHandler.Handle(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "List not found",
+		})
+	}
+
+	return c.JSON(list)
+}
+
+// CreateList handles adding a named list to the wishlist of a user
+func (h *WishlistHandler) CreateList(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	if userID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID is required",
+		})
+	}
+
+	var cmd commands.CreateListCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.UserID = userID
+
+	listID, err := h.createListHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": listID,
+	})
+}
+
+// RenameList handles renaming a list of the wishlist of a user
+func (h *WishlistHandler) RenameList(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	listID := c.Params("listId")
+	if userID == "" || listID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID and list ID are required",
+		})
+	}
+
+	var cmd commands.RenameListCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.UserID = userID
+	cmd.ListID = listID
+
+	if err := h.renameListHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "List renamed successfully",
+	})
+}
+
+// RemoveList handles removing a list from the wishlist of a user
+func (h *WishlistHandler) RemoveList(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	listID := c.Params("listId")
+	if userID == "" || listID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID and list ID are required",
+		})
+	}
+
+	cmd := commands.RemoveListCommand{
+		UserID: userID,
+		ListID: listID,
+	}
+
+	if err := h.removeListHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "List removed successfully",
+	})
+}
+
+// AddWishlistItem handles saving a product to a list of the wishlist of a user
+func (h *WishlistHandler) AddWishlistItem(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	listID := c.Params("listId")
+	if userID == "" || listID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID and list ID are required",
+		})
+	}
+
+	var cmd commands.AddWishlistItemCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.UserID = userID
+	cmd.ListID = listID
+
+	if err := h.addWishlistItemHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Item added to list successfully",
+	})
+}
+
+// RemoveWishlistItem handles removing a product from a list of the wishlist of a user
+func (h *WishlistHandler) RemoveWishlistItem(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	listID := c.Params("listId")
+	productID := c.Params("productId")
+	if userID == "" || listID == "" || productID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID, list ID and product ID are required",
+		})
+	}
+
+	cmd := commands.RemoveWishlistItemCommand{
+		UserID:    userID,
+		ListID:    listID,
+		ProductID: productID,
+		VariantID: c.Query("variant_id"),
+	}
+
+	if err := h.removeWishlistItemHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Item removed from list successfully",
+	})
+}
+
+// MoveToCart handles moving a product from a list of the wishlist of a user to the cart of the user
+func (h *WishlistHandler) MoveToCart(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	listID := c.Params("listId")
+	productID := c.Params("productId")
+	if userID == "" || listID == "" || productID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID, list ID and product ID are required",
+		})
+	}
+
+	var cmd commands.MoveToCartCommand
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&cmd); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+			})
+		}
+	}
+
+	cmd.UserID = userID
+	cmd.ListID = listID
+	cmd.ProductID = productID
+
+	if err := h.moveToCartHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Item moved to cart successfully",
+	})
+}
+
+// SaveForLater handles moving a product out of the cart of a user to a list of the wishlist of the user
+func (h *WishlistHandler) SaveForLater(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	if userID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID is required",
+		})
+	}
+
+	var cmd commands.SaveForLaterCommand
+	if err := c.BodyParser(&cmd); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	cmd.UserID = userID
+
+	listID, err := h.saveForLaterHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"list_id": listID,
+	})
+}
+
+// ShareList handles making a list of the wishlist of a user viewable through a public link
+func (h *WishlistHandler) ShareList(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	listID := c.Params("listId")
+	if userID == "" || listID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID and list ID are required",
+		})
+	}
+
+	cmd := commands.ShareListCommand{
+		UserID: userID,
+		ListID: listID,
+	}
+
+	token, err := h.shareListHandler.Handle(c.Context(), cmd)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"share_token": token,
+		"url":         "/api/wishlists/shared/" + token,
+	})
+}
+
+// UnshareList handles making a shared list of the wishlist of a user private again
+func (h *WishlistHandler) UnshareList(c *fiber.Ctx) error {
+	userID := c.Params("userId")
+	listID := c.Params("listId")
+	if userID == "" || listID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "User ID and list ID are required",
+		})
+	}
+
+	cmd := commands.UnshareListCommand{
+		UserID: userID,
+		ListID: listID,
+	}
+
+	if err := h.unshareListHandler.Handle(c.Context(), cmd); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "List is no longer shared",
+	})
+}
@@ -0,0 +1,116 @@
+package blobstore
+
+import (
+	"context"
+	"e-commerce/internal/application/storage"
+	"errors"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStore implements the storage.BlobStore interface on the local filesystem.
+// Content types are derived from the key extension.
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore creates a new LocalStore keeping blobs under the root directory
+func NewLocalStore(root string) (*LocalStore, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &LocalStore{
+		root: root,
+	}, nil
+}
+
+// Put stores data under a key, replacing any existing blob
+func (s *LocalStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	filename, err := s.filename(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial blob
+	file, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), filename)
+}
+
+// Get opens the blob stored under a key
+func (s *LocalStore) Get(ctx context.Context, key string) (*storage.Blob, error) {
+	filename, err := s.filename(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, storage.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if info.IsDir() {
+		file.Close()
+		return nil, storage.ErrBlobNotFound
+	}
+
+	return &storage.Blob{
+		Body:        file,
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+		Size:        info.Size(),
+	}, nil
+}
+
+// Delete removes the blob stored under a key
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	filename, err := s.filename(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filename); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// filename maps a key to a path under the root, rejecting keys that would escape it
+func (s *LocalStore) filename(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if key == "" || cleaned == "/" || strings.Contains(key, "..") || strings.HasPrefix(key, "/") {
+		return "", storage.ErrInvalidKey
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(cleaned[1:])), nil
+}
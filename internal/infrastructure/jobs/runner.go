@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job represents a task run periodically in the background
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Runner runs jobs periodically until it is stopped
+type Runner struct {
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRunner creates a new Runner
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+// Add adds a job to run every interval once the runner is started
+func (r *Runner) Add(name string, interval time.Duration, run func(ctx context.Context) error) {
+	r.jobs = append(r.jobs, Job{Name: name, Interval: interval, Run: run})
+}
+
+// Start runs every job once and then at its interval, each in its own goroutine.
+// Errors are logged and the job runs again at its next interval.
+func (r *Runner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	for _, job := range r.jobs {
+		r.wg.Add(1)
+		go func(job Job) {
+			defer r.wg.Done()
+
+			ticker := time.NewTicker(job.Interval)
+			defer ticker.Stop()
+
+			for {
+				if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+					log.Printf("Job %s failed: %v", job.Name, err)
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(job)
+	}
+}
+
+// Stop stops the jobs and waits for running ones to return
+func (r *Runner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
@@ -0,0 +1,27 @@
+package notifier
+
+import (
+	"context"
+	"e-commerce/internal/application/notification"
+	"log"
+	"strings"
+)
+
+// LogNotifier implements the notification.Notifier interface by writing messages to the log,
+// for development and deployments without a delivery backend
+type LogNotifier struct{}
+
+// NewLogNotifier creates a new LogNotifier
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+// Send writes a message to the log
+func (n *LogNotifier) Send(ctx context.Context, msg notification.Message) error {
+	if len(msg.To) == 0 {
+		return notification.ErrNoRecipients
+	}
+
+	log.Printf("Notification to %s: %s\n%s", strings.Join(msg.To, ", "), msg.Subject, msg.Body)
+	return nil
+}
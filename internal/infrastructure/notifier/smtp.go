@@ -0,0 +1,226 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"e-commerce/internal/application/notification"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// smtpTimeout bounds a delivery when the context has no deadline
+const smtpTimeout = 30 * time.Second
+
+// SMTPNotifier implements the notification.Notifier interface by sending email through an SMTP server.
+// Connections are upgraded with STARTTLS when the server offers it, and authenticated when a username
+// is set. Any SMTP sink, such as MailHog or Mailpit listening on localhost, can be used to test it.
+type SMTPNotifier struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     mail.Address
+}
+
+// NewSMTPNotifier creates a new SMTPNotifier sending email from the given address
+func NewSMTPNotifier(host, port, username, password, from string) (*SMTPNotifier, error) {
+	if host == "" {
+		return nil, errors.New("SMTP host is required")
+	}
+
+	fromAddress, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	return &SMTPNotifier{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     *fromAddress,
+	}, nil
+}
+
+// Send sends a message as an email, with its HTML alternative when it has one. Failures that may
+// succeed later, such as the server being unreachable or answering with a 4xx reply, wrap
+// notification.ErrTransient; 5xx replies are permanent.
+func (n *SMTPNotifier) Send(ctx context.Context, msg notification.Message) error {
+	if len(msg.To) == 0 {
+		return notification.ErrNoRecipients
+	}
+
+	email, err := n.compose(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := n.send(ctx, msg.To, email); err != nil {
+		return classify(err)
+	}
+	return nil
+}
+
+// send delivers an email to its recipients over a single SMTP session
+func (n *SMTPNotifier) send(ctx context.Context, to []string, email []byte) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(smtpTimeout)
+	}
+
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(n.host, n.port))
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return err
+		}
+	}
+
+	if n.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.username, n.password, n.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.from.Address); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(email); err != nil {
+		w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// compose builds the MIME email of a message: plain text, or multipart/alternative with its HTML
+func (n *SMTPNotifier) compose(msg notification.Message) ([]byte, error) {
+	messageID, err := n.messageID()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+
+	header("From", n.from.String())
+	header("To", strings.Join(msg.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", messageID)
+	header("MIME-Version", "1.0")
+
+	if msg.HTML == "" {
+		header("Content-Type", `text/plain; charset="utf-8"`)
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, msg.Body); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	parts := multipart.NewWriter(&buf)
+	header("Content-Type", `multipart/alternative; boundary="`+parts.Boundary()+`"`)
+	buf.WriteString("\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{`text/plain; charset="utf-8"`, msg.Body},
+		{`text/html; charset="utf-8"`, msg.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.body); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// messageID generates a unique Message-ID in the domain of the sender
+func (n *SMTPNotifier) messageID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	domain := n.host
+	if at := strings.LastIndex(n.from.Address, "@"); at >= 0 {
+		domain = n.from.Address[at+1:]
+	}
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">", nil
+}
+
+// writeQuotedPrintable writes a body encoded as quoted-printable
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// classify marks the errors worth retrying as transient: everything but 5xx SMTP replies,
+// which tell the message will never be accepted as it is
+func classify(err error) error {
+	var reply *textproto.Error
+	if errors.As(err, &reply) && reply.Code >= 500 {
+		return err
+	}
+	return fmt.Errorf("%w: %v", notification.ErrTransient, err)
+}
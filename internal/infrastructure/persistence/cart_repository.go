@@ -0,0 +1,452 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/cart"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"errors"
+	"time"
+)
+
+// CartRepository implements the cart.Repository interface
+type CartRepository struct {
+	db *sql.DB
+}
+
+// NewCartRepository creates a new CartRepository
+func NewCartRepository(db *sql.DB) *CartRepository {
+	return &CartRepository{
+		db: db,
+	}
+}
+
+const cartColumns = `id, user_id, session_token, coupon_code, created_at, updated_at,
+	reminders_sent, last_reminded_at, recovery_token`
+
+// Save persists a cart and its items to the database
+func (r *CartRepository) Save(ctx context.Context, c *cart.Cart) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO carts (` + cartColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		c.ID().String(),
+		nullString(c.UserID().String()),
+		nullString(c.SessionToken().String()),
+		nullString(c.CouponCode()),
+		c.CreatedAt(),
+		c.UpdatedAt(),
+		c.RemindersSent(),
+		c.LastRemindedAt(),
+		nullString(c.RecoveryToken().String()),
+	); err != nil {
+		return err
+	}
+
+	if err := r.insertItems(ctx, tx, c); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindByID retrieves a cart by ID
+func (r *CartRepository) FindByID(ctx context.Context, id cart.ID) (*cart.Cart, error) {
+	query := `
+		SELECT ` + cartColumns + `
+		FROM carts
+		WHERE id = $1
+	`
+
+	return r.findOne(ctx, query, id.String())
+}
+
+// FindByUserID retrieves a cart by user ID
+func (r *CartRepository) FindByUserID(ctx context.Context, userID user.ID) (*cart.Cart, error) {
+	query := `
+		SELECT ` + cartColumns + `
+		FROM carts
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, userID.String())
+}
+
+// FindBySessionToken retrieves the guest cart identified by a session token
+func (r *CartRepository) FindBySessionToken(ctx context.Context, token cart.SessionToken) (*cart.Cart, error) {
+	query := `
+		SELECT ` + cartColumns + `
+		FROM carts
+		WHERE session_token = $1
+	`
+
+	return r.findOne(ctx, query, token.String())
+}
+
+// Update updates an existing cart, replacing its items
+func (r *CartRepository) Update(ctx context.Context, c *cart.Cart) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE carts
+		SET user_id = $1, session_token = $2, coupon_code = $3, updated_at = $4,
+			reminders_sent = $5, last_reminded_at = $6, recovery_token = $7
+		WHERE id = $8
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		nullString(c.UserID().String()),
+		nullString(c.SessionToken().String()),
+		nullString(c.CouponCode()),
+		c.UpdatedAt(),
+		c.RemindersSent(),
+		c.LastRemindedAt(),
+		nullString(c.RecoveryToken().String()),
+		c.ID().String(),
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM cart_items WHERE cart_id = $1`, c.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertItems(ctx, tx, c); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes a cart from the database
+func (r *CartRepository) Delete(ctx context.Context, id cart.ID) error {
+	query := `
+		DELETE FROM carts
+		WHERE id = $1
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id.String())
+	return err
+}
+
+// FindByRecoveryToken retrieves the cart restored by the link of a reminder
+func (r *CartRepository) FindByRecoveryToken(ctx context.Context, token cart.RecoveryToken) (*cart.Cart, error) {
+	query := `
+		SELECT ` + cartColumns + `
+		FROM carts
+		WHERE recovery_token = $1
+	`
+
+	return r.findOne(ctx, query, token.String())
+}
+
+// FindAbandoned retrieves the user carts with items left untouched since a time, that were not reminded
+// since then nor maxReminders times already; a cart changed after its last reminder is due again
+func (r *CartRepository) FindAbandoned(ctx context.Context, inactiveSince time.Time, maxReminders int) ([]*cart.Cart, error) {
+	query := `
+		SELECT ` + cartColumns + `
+		FROM carts c
+		WHERE user_id IS NOT NULL
+			AND updated_at < $1
+			AND EXISTS (SELECT 1 FROM cart_items i WHERE i.cart_id = c.id)
+			AND (
+				last_reminded_at IS NULL
+				OR last_reminded_at <= updated_at
+				OR (last_reminded_at < $1 AND reminders_sent < $2)
+			)
+		ORDER BY updated_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, inactiveSince, maxReminders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	carts := []*cart.Cart{}
+	for rows.Next() {
+		c, err := r.scanCart(rows)
+		if err != nil {
+			return nil, err
+		}
+		carts = append(carts, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Load the items once the rows are closed
+	for i, c := range carts {
+		if carts[i], err = r.withItems(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+
+	return carts, nil
+}
+
+// DeleteInactive removes the carts left untouched since a time, returning how many were removed
+func (r *CartRepository) DeleteInactive(ctx context.Context, inactiveSince time.Time) (int, error) {
+	query := `
+		DELETE FROM carts
+		WHERE updated_at < $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, inactiveSince)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
+
+// RecordReminder records that a reminder about a cart was sent to a recipient, updating only the
+// reminder columns of the cart so that changes made to its items meanwhile are kept
+func (r *CartRepository) RecordReminder(ctx context.Context, c *cart.Cart, recipient string, sentAt time.Time) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE carts
+		SET reminders_sent = $1, last_reminded_at = $2, recovery_token = $3
+		WHERE id = $4
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		c.RemindersSent(),
+		c.LastRemindedAt(),
+		nullString(c.RecoveryToken().String()),
+		c.ID().String(),
+	); err != nil {
+		return err
+	}
+
+	query = `
+		INSERT INTO cart_reminders (cart_id, recipient, sent_at)
+		VALUES ($1, $2, $3)
+	`
+
+	if _, err := tx.ExecContext(ctx, query, c.ID().String(), recipient, sentAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RecordRestore records that a cart was restored through the link of a reminder
+func (r *CartRepository) RecordRestore(ctx context.Context, id cart.ID, restoredAt time.Time) error {
+	query := `
+		UPDATE cart_reminders
+		SET restored_at = $1
+		WHERE cart_id = $2 AND restored_at IS NULL AND recovered_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query, restoredAt, id.String())
+	return err
+}
+
+// RecordRecovery records that a reminded cart was checked out as an order
+func (r *CartRepository) RecordRecovery(ctx context.Context, id cart.ID, orderID string, recoveredAt time.Time) error {
+	query := `
+		UPDATE cart_reminders
+		SET recovered_at = $1, order_id = $2
+		WHERE cart_id = $3 AND recovered_at IS NULL
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, recoveredAt, orderID, id.String())
+	return err
+}
+
+// RecoveryStats counts the carts reminded since a time, and how many of them were restored and recovered
+func (r *CartRepository) RecoveryStats(ctx context.Context, since time.Time) (cart.RecoveryStats, error) {
+	query := `
+		SELECT
+			COUNT(*),
+			COUNT(DISTINCT cart_id),
+			COUNT(DISTINCT cart_id) FILTER (WHERE restored_at IS NOT NULL),
+			COUNT(DISTINCT cart_id) FILTER (WHERE recovered_at IS NOT NULL)
+		FROM cart_reminders
+		WHERE sent_at >= $1
+	`
+
+	var remindersSent, reminded, restored, recovered int
+	if err := r.db.QueryRowContext(ctx, query, since).Scan(&remindersSent, &reminded, &restored, &recovered); err != nil {
+		return cart.RecoveryStats{}, err
+	}
+
+	return cart.ReconstructRecoveryStats(remindersSent, reminded, restored, recovered), nil
+}
+
+// insertItems inserts the items of a cart
+func (r *CartRepository) insertItems(ctx context.Context, tx executor, c *cart.Cart) error {
+	query := `
+		INSERT INTO cart_items (id, cart_id, product_id, variant_id, quantity, price, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	for _, item := range c.Items() {
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			item.ID().String(),
+			c.ID().String(),
+			item.ProductID().String(),
+			item.VariantID().String(),
+			item.Quantity(),
+			item.Price().Value(),
+			item.CreatedAt(),
+			item.UpdatedAt(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findOne retrieves a single cart with its items
+func (r *CartRepository) findOne(ctx context.Context, query string, args ...interface{}) (*cart.Cart, error) {
+	c, err := r.scanCart(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, cart.ErrCartNotFound
+		}
+		return nil, err
+	}
+
+	return r.withItems(ctx, c)
+}
+
+// scanCart scans a cart without its items from a row
+func (r *CartRepository) scanCart(row rowScanner) (*cart.Cart, error) {
+	var id string
+	var userID, sessionToken, couponCode, recoveryToken sql.NullString
+	var createdAt, updatedAt time.Time
+	var remindersSent int
+	var lastRemindedAt sql.NullTime
+
+	if err := row.Scan(
+		&id, &userID, &sessionToken, &couponCode, &createdAt, &updatedAt,
+		&remindersSent, &lastRemindedAt, &recoveryToken,
+	); err != nil {
+		return nil, err
+	}
+
+	var lastReminded *time.Time
+	if lastRemindedAt.Valid {
+		lastReminded = &lastRemindedAt.Time
+	}
+
+	return cart.Reconstruct(
+		cart.ID(id),
+		user.ID(userID.String),
+		cart.SessionToken(sessionToken.String),
+		nil,
+		couponCode.String,
+		createdAt,
+		updatedAt,
+		remindersSent,
+		lastReminded,
+		cart.RecoveryToken(recoveryToken.String),
+	), nil
+}
+
+// withItems loads the items of a cart
+func (r *CartRepository) withItems(ctx context.Context, c *cart.Cart) (*cart.Cart, error) {
+	items, err := r.findItems(ctx, c.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	return cart.Reconstruct(
+		c.ID(),
+		c.UserID(),
+		c.SessionToken(),
+		items,
+		c.CouponCode(),
+		c.CreatedAt(),
+		c.UpdatedAt(),
+		c.RemindersSent(),
+		c.LastRemindedAt(),
+		c.RecoveryToken(),
+	), nil
+}
+
+// findItems retrieves the items of a cart
+func (r *CartRepository) findItems(ctx context.Context, cartID string) ([]*cart.CartItem, error) {
+	query := `
+		SELECT id, product_id, variant_id, quantity, price, created_at, updated_at
+		FROM cart_items
+		WHERE cart_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, cartID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*cart.CartItem{}
+	for rows.Next() {
+		var id, productID, variantID string
+		var quantity int
+		var price float64
+		var createdAt, updatedAt time.Time
+
+		if err := rows.Scan(&id, &productID, &variantID, &quantity, &price, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+
+		items = append(items, cart.ReconstructCartItem(
+			cart.ID(id),
+			product.ID(productID),
+			product.VariantID(variantID),
+			quantity,
+			product.Price(price),
+			createdAt,
+			updatedAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// nullString maps an empty string to a NULL column value
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,159 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/category"
+	"errors"
+	"time"
+)
+
+// CategoryRepository implements the category.Repository interface
+type CategoryRepository struct {
+	db *sql.DB
+}
+
+// NewCategoryRepository creates a new CategoryRepository
+func NewCategoryRepository(db *sql.DB) *CategoryRepository {
+	return &CategoryRepository{
+		db: db,
+	}
+}
+
+const categoryColumns = `id, name, slug, description, parent_id, created_at, updated_at`
+
+// Save persists a category to the database
+func (r *CategoryRepository) Save(ctx context.Context, c *category.Category) error {
+	query := `
+		INSERT INTO categories (` + categoryColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		c.ID().String(),
+		c.Name(),
+		c.Slug().String(),
+		c.Description(),
+		nullString(c.ParentID().String()),
+		c.CreatedAt(),
+		c.UpdatedAt(),
+	)
+
+	return err
+}
+
+// FindByID retrieves a category by ID
+func (r *CategoryRepository) FindByID(ctx context.Context, id category.ID) (*category.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE id = $1
+	`
+
+	c, err := r.scanCategory(r.db.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, category.ErrCategoryNotFound
+	}
+	return c, err
+}
+
+// FindBySlug retrieves a category by slug
+func (r *CategoryRepository) FindBySlug(ctx context.Context, slug category.Slug) (*category.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE slug = $1
+	`
+
+	c, err := r.scanCategory(r.db.QueryRowContext(ctx, query, slug.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, category.ErrCategoryNotFound
+	}
+	return c, err
+}
+
+// Update updates an existing category
+func (r *CategoryRepository) Update(ctx context.Context, c *category.Category) error {
+	query := `
+		UPDATE categories
+		SET name = $1, slug = $2, description = $3, parent_id = $4, updated_at = $5
+		WHERE id = $6
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		c.Name(),
+		c.Slug().String(),
+		c.Description(),
+		nullString(c.ParentID().String()),
+		c.UpdatedAt(),
+		c.ID().String(),
+	)
+
+	return err
+}
+
+// Delete removes a category from the database
+func (r *CategoryRepository) Delete(ctx context.Context, id category.ID) error {
+	query := `
+		DELETE FROM categories
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id.String())
+	return err
+}
+
+// List retrieves every category
+func (r *CategoryRepository) List(ctx context.Context) ([]*category.Category, error) {
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		ORDER BY name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*category.Category
+	for rows.Next() {
+		c, err := r.scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// scanCategory scans a category from a row
+func (r *CategoryRepository) scanCategory(row rowScanner) (*category.Category, error) {
+	var id, name, slug, description string
+	var parentID sql.NullString
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(&id, &name, &slug, &description, &parentID, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	return category.Reconstruct(
+		category.ID(id),
+		name,
+		category.Slug(slug),
+		description,
+		category.ID(parentID.String),
+		createdAt,
+		updatedAt,
+	), nil
+}
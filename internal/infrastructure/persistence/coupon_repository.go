@@ -0,0 +1,246 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/coupon"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// CouponRepository implements the coupon.Repository interface
+type CouponRepository struct {
+	db *sql.DB
+}
+
+// NewCouponRepository creates a new CouponRepository
+func NewCouponRepository(db *sql.DB) *CouponRepository {
+	return &CouponRepository{
+		db: db,
+	}
+}
+
+const couponColumns = `id, code, type, value, buy_quantity, get_quantity, min_order_value, valid_from, valid_until,
+	usage_limit, per_user_limit, times_used, product_ids, category_ids, active, created_at, updated_at`
+
+// Save persists a coupon to the database
+func (r *CouponRepository) Save(ctx context.Context, c *coupon.Coupon) error {
+	query := `
+		INSERT INTO coupons (` + couponColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		c.ID().String(),
+		c.Code().String(),
+		string(c.Type()),
+		c.Value(),
+		c.BuyQuantity(),
+		c.GetQuantity(),
+		c.MinOrderValue(),
+		nullTime(c.ValidFrom()),
+		nullTime(c.ValidUntil()),
+		c.UsageLimit(),
+		c.PerUserLimit(),
+		c.TimesUsed(),
+		pq.Array(productIDStrings(c.ProductIDs())),
+		pq.Array(c.CategoryIDs()),
+		c.IsActive(),
+		c.CreatedAt(),
+		c.UpdatedAt(),
+	)
+
+	return err
+}
+
+// FindByID retrieves a coupon by ID
+func (r *CouponRepository) FindByID(ctx context.Context, id coupon.ID) (*coupon.Coupon, error) {
+	query := `
+		SELECT ` + couponColumns + `
+		FROM coupons
+		WHERE id = $1
+	`
+
+	c, err := r.scanCoupon(r.db.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("coupon not found")
+	}
+	return c, err
+}
+
+// FindByCode retrieves a coupon by code
+func (r *CouponRepository) FindByCode(ctx context.Context, code coupon.Code) (*coupon.Coupon, error) {
+	query := `
+		SELECT ` + couponColumns + `
+		FROM coupons
+		WHERE code = $1
+	`
+
+	c, err := r.scanCoupon(r.db.QueryRowContext(ctx, query, code.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("coupon not found")
+	}
+	return c, err
+}
+
+// Update updates an existing coupon
+func (r *CouponRepository) Update(ctx context.Context, c *coupon.Coupon) error {
+	query := `
+		UPDATE coupons
+		SET min_order_value = $1, valid_from = $2, valid_until = $3, usage_limit = $4, per_user_limit = $5,
+			times_used = $6, product_ids = $7, category_ids = $8, active = $9, updated_at = $10
+		WHERE id = $11
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		c.MinOrderValue(),
+		nullTime(c.ValidFrom()),
+		nullTime(c.ValidUntil()),
+		c.UsageLimit(),
+		c.PerUserLimit(),
+		c.TimesUsed(),
+		pq.Array(productIDStrings(c.ProductIDs())),
+		pq.Array(c.CategoryIDs()),
+		c.IsActive(),
+		c.UpdatedAt(),
+		c.ID().String(),
+	)
+
+	return err
+}
+
+// Delete removes a coupon from the database
+func (r *CouponRepository) Delete(ctx context.Context, id coupon.ID) error {
+	query := `
+		DELETE FROM coupons
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id.String())
+	return err
+}
+
+// List retrieves all coupons with pagination
+func (r *CouponRepository) List(ctx context.Context, limit, offset int) ([]*coupon.Coupon, error) {
+	query := `
+		SELECT ` + couponColumns + `
+		FROM coupons
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var coupons []*coupon.Coupon
+	for rows.Next() {
+		c, err := r.scanCoupon(rows)
+		if err != nil {
+			return nil, err
+		}
+		coupons = append(coupons, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return coupons, nil
+}
+
+// CountRedemptionsByUser counts how many times a user redeemed a coupon
+func (r *CouponRepository) CountRedemptionsByUser(ctx context.Context, id coupon.ID, userID user.ID) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM coupon_redemptions
+		WHERE coupon_id = $1 AND user_id = $2
+	`
+
+	var count int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, id.String(), userID.String()).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// RecordRedemption records that a user, or a guest when userID is empty, redeemed a coupon on an order
+func (r *CouponRepository) RecordRedemption(ctx context.Context, id coupon.ID, userID user.ID, orderID order.ID) error {
+	query := `
+		INSERT INTO coupon_redemptions (coupon_id, user_id, order_id, redeemed_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id.String(), nullString(userID.String()), orderID.String(), time.Now())
+	return err
+}
+
+// scanCoupon scans a coupon from a row
+func (r *CouponRepository) scanCoupon(row rowScanner) (*coupon.Coupon, error) {
+	var id, code, couponType string
+	var value, minOrderValue float64
+	var buyQuantity, getQuantity, usageLimit, perUserLimit, timesUsed int
+	var validFrom, validUntil sql.NullTime
+	var productIDs, categoryIDs []string
+	var active bool
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(
+		&id, &code, &couponType, &value, &buyQuantity, &getQuantity, &minOrderValue, &validFrom, &validUntil,
+		&usageLimit, &perUserLimit, &timesUsed, pq.Array(&productIDs), pq.Array(&categoryIDs), &active,
+		&createdAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	products := make([]product.ID, len(productIDs))
+	for i, productID := range productIDs {
+		products[i] = product.ID(productID)
+	}
+
+	return coupon.Reconstruct(
+		coupon.ID(id),
+		coupon.Code(code),
+		coupon.Type(couponType),
+		value,
+		buyQuantity,
+		getQuantity,
+		minOrderValue,
+		validFrom.Time,
+		validUntil.Time,
+		usageLimit,
+		perUserLimit,
+		timesUsed,
+		products,
+		categoryIDs,
+		active,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// productIDStrings converts product IDs to their string form
+func productIDStrings(ids []product.ID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
+
+// nullTime maps a zero time to a NULL column value
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -0,0 +1,194 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/importjob"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ImportJobRepository implements the importjob.Repository interface
+type ImportJobRepository struct {
+	db *sql.DB
+}
+
+// NewImportJobRepository creates a new ImportJobRepository
+func NewImportJobRepository(db *sql.DB) *ImportJobRepository {
+	return &ImportJobRepository{
+		db: db,
+	}
+}
+
+// rowErrorRecord is the JSON representation of an import row error
+type rowErrorRecord struct {
+	Row     int    `json:"row"`
+	SKU     string `json:"sku"`
+	Message string `json:"message"`
+}
+
+const importJobColumns = `id, format, file_name, status, created, updated, failed, row_errors, failure,
+	created_at, started_at, finished_at, updated_at`
+
+// Save persists an import job to the database
+func (r *ImportJobRepository) Save(ctx context.Context, job *importjob.Job) error {
+	rowErrors, err := marshalRowErrors(job.RowErrors())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO import_jobs (` + importJobColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err = r.db.ExecContext(
+		ctx,
+		query,
+		job.ID().String(),
+		job.Format().String(),
+		job.FileName(),
+		job.Status().String(),
+		job.Created(),
+		job.Updated(),
+		job.Failed(),
+		rowErrors,
+		job.Failure(),
+		job.CreatedAt(),
+		job.StartedAt(),
+		job.FinishedAt(),
+		job.UpdatedAt(),
+	)
+
+	return err
+}
+
+// FindByID retrieves an import job by ID
+func (r *ImportJobRepository) FindByID(ctx context.Context, id importjob.ID) (*importjob.Job, error) {
+	query := `
+		SELECT ` + importJobColumns + `
+		FROM import_jobs
+		WHERE id = $1
+	`
+
+	job, err := r.scanImportJob(r.db.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, importjob.ErrJobNotFound
+	}
+	return job, err
+}
+
+// Update updates the status and progress of an existing import job
+func (r *ImportJobRepository) Update(ctx context.Context, job *importjob.Job) error {
+	rowErrors, err := marshalRowErrors(job.RowErrors())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE import_jobs
+		SET status = $1, created = $2, updated = $3, failed = $4, row_errors = $5, failure = $6,
+			started_at = $7, finished_at = $8, updated_at = $9
+		WHERE id = $10
+	`
+
+	_, err = r.db.ExecContext(
+		ctx,
+		query,
+		job.Status().String(),
+		job.Created(),
+		job.Updated(),
+		job.Failed(),
+		rowErrors,
+		job.Failure(),
+		job.StartedAt(),
+		job.FinishedAt(),
+		job.UpdatedAt(),
+		job.ID().String(),
+	)
+
+	return err
+}
+
+// ClaimNext marks the oldest pending or stale running job as running and returns it.
+// Rows locked by a concurrent claim are skipped, so each job is claimed by a single worker.
+func (r *ImportJobRepository) ClaimNext(ctx context.Context, now, staleBefore time.Time) (*importjob.Job, error) {
+	query := `
+		UPDATE import_jobs
+		SET status = 'running', updated_at = $1
+		WHERE id = (
+			SELECT id
+			FROM import_jobs
+			WHERE status = 'pending' OR (status = 'running' AND updated_at < $2)
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + importJobColumns
+
+	job, err := r.scanImportJob(r.db.QueryRowContext(ctx, query, now, staleBefore))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, importjob.ErrNoPendingJob
+	}
+	return job, err
+}
+
+// scanImportJob scans an import job from a row
+func (r *ImportJobRepository) scanImportJob(row rowScanner) (*importjob.Job, error) {
+	var id, format, fileName, status, failure string
+	var created, updated, failed int
+	var rowErrorsJSON []byte
+	var createdAt, updatedAt time.Time
+	var startedAt, finishedAt sql.NullTime
+
+	if err := row.Scan(
+		&id, &format, &fileName, &status, &created, &updated, &failed, &rowErrorsJSON, &failure,
+		&createdAt, &startedAt, &finishedAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var records []rowErrorRecord
+	if err := json.Unmarshal(rowErrorsJSON, &records); err != nil {
+		return nil, err
+	}
+
+	rowErrors := make([]importjob.RowError, len(records))
+	for i, record := range records {
+		rowErrors[i] = importjob.ReconstructRowError(record.Row, record.SKU, record.Message)
+	}
+
+	var started, finished *time.Time
+	if startedAt.Valid {
+		started = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		finished = &finishedAt.Time
+	}
+
+	return importjob.Reconstruct(
+		importjob.ID(id),
+		importjob.Format(format),
+		fileName,
+		importjob.Status(status),
+		created,
+		updated,
+		failed,
+		rowErrors,
+		failure,
+		createdAt,
+		started,
+		finished,
+		updatedAt,
+	), nil
+}
+
+// marshalRowErrors encodes import row errors as JSON
+func marshalRowErrors(rowErrors []importjob.RowError) ([]byte, error) {
+	records := make([]rowErrorRecord, len(rowErrors))
+	for i, rowError := range rowErrors {
+		records[i] = rowErrorRecord{Row: rowError.Row(), SKU: rowError.SKU(), Message: rowError.Message()}
+	}
+	return json.Marshal(records)
+}
@@ -0,0 +1,161 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/application/notification"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// NotificationDeliveryRepository implements the notification.DeliveryRepository interface
+type NotificationDeliveryRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationDeliveryRepository creates a new NotificationDeliveryRepository
+func NewNotificationDeliveryRepository(db *sql.DB) *NotificationDeliveryRepository {
+	return &NotificationDeliveryRepository{
+		db: db,
+	}
+}
+
+// deliveryColumns are the columns of the notification_deliveries table, in the order scanDelivery scans them
+const deliveryColumns = `id, template, locale, recipients, subject, body, html, redacted, status, attempts,
+	last_error, next_attempt_at, created_at, updated_at, sent_at`
+
+// Save adds a delivery to the log
+func (r *NotificationDeliveryRepository) Save(ctx context.Context, d *notification.Delivery) error {
+	query := `
+		INSERT INTO notification_deliveries (` + deliveryColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		d.ID,
+		d.Template,
+		d.Locale,
+		pq.Array(d.Message.To),
+		d.Message.Subject,
+		d.Message.Body,
+		d.Message.HTML,
+		d.Redacted,
+		string(d.Status),
+		d.Attempts,
+		d.LastError,
+		d.NextAttemptAt,
+		d.CreatedAt,
+		d.UpdatedAt,
+		d.SentAt,
+	)
+	return err
+}
+
+// Update updates a delivery after an attempt
+func (r *NotificationDeliveryRepository) Update(ctx context.Context, d *notification.Delivery) error {
+	query := `
+		UPDATE notification_deliveries
+		SET status = $1, attempts = $2, last_error = $3, next_attempt_at = $4, updated_at = $5, sent_at = $6
+		WHERE id = $7
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		string(d.Status),
+		d.Attempts,
+		d.LastError,
+		d.NextAttemptAt,
+		d.UpdatedAt,
+		d.SentAt,
+		d.ID,
+	)
+	return err
+}
+
+// FindDue retrieves the pending deliveries whose next attempt is due, oldest first
+func (r *NotificationDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*notification.Delivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM notification_deliveries
+		WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at ASC
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, string(notification.DeliveryPending), now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanDeliveries(rows)
+}
+
+// List retrieves the deliveries with a status, or all of them with an empty status, newest first
+func (r *NotificationDeliveryRepository) List(
+	ctx context.Context,
+	status notification.DeliveryStatus,
+	limit, offset int,
+) ([]*notification.Delivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM notification_deliveries
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, string(status), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanDeliveries(rows)
+}
+
+// scanDeliveries scans the deliveries of rows
+func (r *NotificationDeliveryRepository) scanDeliveries(rows *sql.Rows) ([]*notification.Delivery, error) {
+	deliveries := []*notification.Delivery{}
+	for rows.Next() {
+		d, err := r.scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// scanDelivery scans a delivery from a row
+func (r *NotificationDeliveryRepository) scanDelivery(row rowScanner) (*notification.Delivery, error) {
+	var d notification.Delivery
+	var status string
+	var nextAttemptAt, sentAt sql.NullTime
+
+	if err := row.Scan(
+		&d.ID, &d.Template, &d.Locale, pq.Array(&d.Message.To), &d.Message.Subject, &d.Message.Body, &d.Message.HTML,
+		&d.Redacted, &status, &d.Attempts, &d.LastError, &nextAttemptAt, &d.CreatedAt, &d.UpdatedAt, &sentAt,
+	); err != nil {
+		return nil, err
+	}
+
+	d.Status = notification.DeliveryStatus(status)
+	if nextAttemptAt.Valid {
+		d.NextAttemptAt = &nextAttemptAt.Time
+	}
+	if sentAt.Valid {
+		d.SentAt = &sentAt.Time
+	}
+
+	return &d, nil
+}
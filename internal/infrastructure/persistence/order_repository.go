@@ -0,0 +1,631 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/order"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// OrderRepository implements the order.Repository interface
+type OrderRepository struct {
+	db *sql.DB
+}
+
+// NewOrderRepository creates a new OrderRepository
+func NewOrderRepository(db *sql.DB) *OrderRepository {
+	return &OrderRepository{
+		db: db,
+	}
+}
+
+// addressRecord is the JSON representation of an address snapshot
+type addressRecord struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code"`
+	Region     string `json:"region"`
+	Country    string `json:"country"`
+}
+
+// allocationRecord is the JSON representation of the quantity of an order item shipped from a warehouse
+type allocationRecord struct {
+	WarehouseID string `json:"warehouse_id"`
+	Quantity    int    `json:"quantity"`
+}
+
+const orderColumns = `id, user_id, guest_email, status, total_amount, shipping_address, billing_address,
+	payment_method, shipping_method, shipping_cost, created_at, updated_at`
+
+// Save persists an order and its items to the database
+func (r *OrderRepository) Save(ctx context.Context, o *order.Order) error {
+	shippingAddress, billingAddress, err := marshalOrderAddresses(o)
+	if err != nil {
+		return err
+	}
+
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO orders (` + orderColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		o.ID().String(),
+		nullString(o.UserID().String()),
+		nullString(o.GuestEmail().String()),
+		string(o.Status()),
+		o.TotalAmount(),
+		shippingAddress,
+		billingAddress,
+		o.PaymentMethod(),
+		o.ShippingMethod(),
+		o.ShippingCost(),
+		o.CreatedAt(),
+		o.UpdatedAt(),
+	); err != nil {
+		return err
+	}
+
+	if err := r.insertItems(ctx, tx, o); err != nil {
+		return err
+	}
+
+	if err := r.insertDiscounts(ctx, tx, o); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindByID retrieves an order by ID
+func (r *OrderRepository) FindByID(ctx context.Context, id order.ID) (*order.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE id = $1
+	`
+
+	row, err := r.scanOrderRow(r.db.QueryRowContext(ctx, query, id.String()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("order not found")
+		}
+		return nil, err
+	}
+
+	return r.toOrder(ctx, row)
+}
+
+// FindByUserID retrieves orders by user ID
+func (r *OrderRepository) FindByUserID(ctx context.Context, userID user.ID, limit, offset int) ([]*order.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	return r.queryOrders(ctx, query, userID.String(), limit, offset)
+}
+
+// Update updates an existing order, replacing its items
+func (r *OrderRepository) Update(ctx context.Context, o *order.Order) error {
+	shippingAddress, billingAddress, err := marshalOrderAddresses(o)
+	if err != nil {
+		return err
+	}
+
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE orders
+		SET status = $1, total_amount = $2, shipping_address = $3, billing_address = $4,
+			payment_method = $5, shipping_method = $6, shipping_cost = $7, updated_at = $8
+		WHERE id = $9
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		string(o.Status()),
+		o.TotalAmount(),
+		shippingAddress,
+		billingAddress,
+		o.PaymentMethod(),
+		o.ShippingMethod(),
+		o.ShippingCost(),
+		o.UpdatedAt(),
+		o.ID().String(),
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM order_items WHERE order_id = $1`, o.ID().String()); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM order_discounts WHERE order_id = $1`, o.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertItems(ctx, tx, o); err != nil {
+		return err
+	}
+
+	if err := r.insertDiscounts(ctx, tx, o); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes an order from the database
+func (r *OrderRepository) Delete(ctx context.Context, id order.ID) error {
+	query := `
+		DELETE FROM orders
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id.String())
+	return err
+}
+
+// List retrieves all orders with pagination
+func (r *OrderRepository) List(ctx context.Context, limit, offset int) ([]*order.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	return r.queryOrders(ctx, query, limit, offset)
+}
+
+// FindByStatus retrieves orders by status
+func (r *OrderRepository) FindByStatus(ctx context.Context, status order.Status, limit, offset int) ([]*order.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE status = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	return r.queryOrders(ctx, query, string(status), limit, offset)
+}
+
+// HasDelivered checks if a user has a delivered order with a product or any of its variants
+func (r *OrderRepository) HasDelivered(ctx context.Context, userID user.ID, productID product.ID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM orders o
+			JOIN order_items i ON i.order_id = o.id
+			WHERE o.user_id = $1 AND o.status = $2 AND i.product_id = $3
+		)
+	`
+
+	var delivered bool
+	if err := r.db.QueryRowContext(ctx, query, userID.String(), string(order.StatusDelivered), productID.String()).Scan(&delivered); err != nil {
+		return false, err
+	}
+
+	return delivered, nil
+}
+
+// insertItems inserts the items of an order
+func (r *OrderRepository) insertItems(ctx context.Context, tx executor, o *order.Order) error {
+	query := `
+		INSERT INTO order_items (
+			id, order_id, product_id, variant_id, sku, quantity, price, discount, allocations, backordered, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	taxQuery := `
+		INSERT INTO order_item_taxes (order_item_id, name, rate, amount, inclusive)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	for _, item := range o.Items() {
+		allocations, err := marshalAllocations(item.Allocations())
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			item.ID().String(),
+			o.ID().String(),
+			item.ProductID().String(),
+			item.VariantID().String(),
+			item.SKU(),
+			item.Quantity(),
+			item.Price(),
+			item.Discount(),
+			allocations,
+			item.Backordered(),
+			item.CreatedAt(),
+			item.UpdatedAt(),
+		); err != nil {
+			return err
+		}
+
+		for _, line := range item.TaxLines() {
+			if _, err := tx.ExecContext(
+				ctx,
+				taxQuery,
+				item.ID().String(),
+				line.Name(),
+				line.Rate(),
+				line.Amount(),
+				line.Inclusive(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertDiscounts inserts the discounts applied to an order
+func (r *OrderRepository) insertDiscounts(ctx context.Context, tx executor, o *order.Order) error {
+	query := `
+		INSERT INTO order_discounts (order_id, code, description, amount)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	for _, discount := range o.Discounts() {
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			o.ID().String(),
+			discount.Code(),
+			discount.Description(),
+			discount.Amount(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryOrders runs a query returning order rows and loads their items
+func (r *OrderRepository) queryOrders(ctx context.Context, query string, args ...interface{}) ([]*order.Order, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var orderRows []*orderRow
+	for rows.Next() {
+		row, err := r.scanOrderRow(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		orderRows = append(orderRows, row)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	orders := make([]*order.Order, 0, len(orderRows))
+	for _, row := range orderRows {
+		o, err := r.toOrder(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+
+	return orders, nil
+}
+
+// orderRow holds the columns of an orders row
+type orderRow struct {
+	id              string
+	userID          sql.NullString
+	guestEmail      sql.NullString
+	status          string
+	totalAmount     float64
+	shippingAddress []byte
+	billingAddress  []byte
+	paymentMethod   string
+	shippingMethod  string
+	shippingCost    float64
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+// scanOrderRow scans the columns of an order from a row
+func (r *OrderRepository) scanOrderRow(row rowScanner) (*orderRow, error) {
+	var o orderRow
+	if err := row.Scan(
+		&o.id, &o.userID, &o.guestEmail, &o.status, &o.totalAmount, &o.shippingAddress, &o.billingAddress,
+		&o.paymentMethod, &o.shippingMethod, &o.shippingCost, &o.createdAt, &o.updatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// toOrder loads the items and discounts of an order row and rebuilds the order
+func (r *OrderRepository) toOrder(ctx context.Context, row *orderRow) (*order.Order, error) {
+	items, err := r.findItems(ctx, row.id)
+	if err != nil {
+		return nil, err
+	}
+
+	discounts, err := r.findDiscounts(ctx, row.id)
+	if err != nil {
+		return nil, err
+	}
+
+	shippingAddress, err := unmarshalAddress(row.shippingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	billingAddress, err := unmarshalAddress(row.billingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return order.Reconstruct(
+		order.ID(row.id),
+		user.ID(row.userID.String),
+		user.Email(row.guestEmail.String),
+		order.Status(row.status),
+		row.totalAmount,
+		shippingAddress,
+		billingAddress,
+		row.paymentMethod,
+		row.shippingMethod,
+		row.shippingCost,
+		discounts,
+		items,
+		row.createdAt,
+		row.updatedAt,
+	), nil
+}
+
+// findItems retrieves the items of an order with their tax lines
+func (r *OrderRepository) findItems(ctx context.Context, orderID string) ([]*order.OrderItem, error) {
+	taxLines, err := r.findTaxLines(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, product_id, variant_id, sku, quantity, price, discount, allocations, backordered, created_at, updated_at
+		FROM order_items
+		WHERE order_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*order.OrderItem{}
+	for rows.Next() {
+		var id, productID, variantID, sku string
+		var quantity, backordered int
+		var price, discount float64
+		var allocationsJSON []byte
+		var createdAt, updatedAt time.Time
+
+		if err := rows.Scan(&id, &productID, &variantID, &sku, &quantity, &price, &discount, &allocationsJSON, &backordered, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+
+		allocations, err := unmarshalAllocations(allocationsJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, order.ReconstructOrderItem(
+			order.ID(id),
+			product.ID(productID),
+			product.VariantID(variantID),
+			sku,
+			quantity,
+			price,
+			discount,
+			taxLines[id],
+			allocations,
+			backordered,
+			createdAt,
+			updatedAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// findTaxLines retrieves the tax lines of an order keyed by order item ID
+func (r *OrderRepository) findTaxLines(ctx context.Context, orderID string) (map[string][]order.TaxLine, error) {
+	query := `
+		SELECT t.order_item_id, t.name, t.rate, t.amount, t.inclusive
+		FROM order_item_taxes t
+		JOIN order_items i ON i.id = t.order_item_id
+		WHERE i.order_id = $1
+		ORDER BY t.id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lines := make(map[string][]order.TaxLine)
+	for rows.Next() {
+		var itemID, name string
+		var rate, amount float64
+		var inclusive bool
+
+		if err := rows.Scan(&itemID, &name, &rate, &amount, &inclusive); err != nil {
+			return nil, err
+		}
+
+		line, err := order.NewTaxLine(name, rate, amount, inclusive)
+		if err != nil {
+			return nil, err
+		}
+		lines[itemID] = append(lines[itemID], line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// findDiscounts retrieves the discounts applied to an order
+func (r *OrderRepository) findDiscounts(ctx context.Context, orderID string) ([]order.Discount, error) {
+	query := `
+		SELECT code, description, amount
+		FROM order_discounts
+		WHERE order_id = $1
+		ORDER BY id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	discounts := []order.Discount{}
+	for rows.Next() {
+		var code, description string
+		var amount float64
+
+		if err := rows.Scan(&code, &description, &amount); err != nil {
+			return nil, err
+		}
+
+		discount, err := order.NewDiscount(code, description, amount)
+		if err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, discount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return discounts, nil
+}
+
+// marshalOrderAddresses encodes the address snapshots of an order as JSON
+func marshalOrderAddresses(o *order.Order) ([]byte, []byte, error) {
+	shippingAddress, err := marshalAddress(o.ShippingAddress())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	billingAddress, err := marshalAddress(o.BillingAddress())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return shippingAddress, billingAddress, nil
+}
+
+// marshalAddress encodes an address as JSON
+func marshalAddress(a address.Address) ([]byte, error) {
+	return json.Marshal(addressRecord{
+		Name:       a.Name(),
+		Line1:      a.Line1(),
+		Line2:      a.Line2(),
+		City:       a.City(),
+		PostalCode: a.PostalCode(),
+		Region:     a.Region(),
+		Country:    a.Country(),
+	})
+}
+
+// unmarshalAddress decodes an address from JSON
+func unmarshalAddress(data []byte) (address.Address, error) {
+	var record addressRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return address.Address{}, err
+	}
+
+	return address.Reconstruct(
+		record.Name,
+		record.Line1,
+		record.Line2,
+		record.City,
+		record.PostalCode,
+		record.Region,
+		record.Country,
+	), nil
+}
+
+// marshalAllocations encodes the warehouse allocations of an order item as JSON
+func marshalAllocations(allocations []order.Allocation) ([]byte, error) {
+	records := make([]allocationRecord, len(allocations))
+	for i, allocation := range allocations {
+		records[i] = allocationRecord{
+			WarehouseID: allocation.WarehouseID().String(),
+			Quantity:    allocation.Quantity(),
+		}
+	}
+	return json.Marshal(records)
+}
+
+// unmarshalAllocations decodes the warehouse allocations of an order item from JSON
+func unmarshalAllocations(data []byte) ([]order.Allocation, error) {
+	var records []allocationRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+
+	allocations := make([]order.Allocation, 0, len(records))
+	for _, record := range records {
+		allocation, err := order.NewAllocation(record.WarehouseID, record.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	}
+	return allocations, nil
+}
@@ -0,0 +1,1483 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/category"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/warehouse"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// ProductRepository implements the product.Repository interface
+type ProductRepository struct {
+	db *sql.DB
+}
+
+// NewProductRepository creates a new ProductRepository
+func NewProductRepository(db *sql.DB) *ProductRepository {
+	return &ProductRepository{
+		db: db,
+	}
+}
+
+// optionRecord is the JSON representation of a product option
+type optionRecord struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// renditionRecord is the JSON representation of a product image rendition
+type renditionRecord struct {
+	Name   string `json:"name"`
+	Key    string `json:"key"`
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+const productColumns = `id, sku, name, description, price, compare_at_price, stock, weight, length, width, height, tax_category,
+	language, options, reorder_threshold, backorder_policy, backorder_limit, available_at, status, published_at,
+	version, created_at, updated_at`
+
+// availableCondition matches the products published and live at the time of the given placeholder
+const availableCondition = `products.status = 'published' AND products.published_at <= %s`
+
+// productSelectColumns adds the assigned categories to the product columns
+const productSelectColumns = productColumns + `,
+	ARRAY(SELECT category_id FROM product_categories WHERE product_id = products.id ORDER BY category_id)`
+
+// Save persists a product with its variants, stock levels, backorders, attributes, images, category assignments,
+// price schedules and initial price history to the database
+func (r *ProductRepository) Save(ctx context.Context, p *product.Product) error {
+	options, err := marshalOptions(p.Options())
+	if err != nil {
+		return err
+	}
+
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO products (` + productColumns + `)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+			$21, $22, $23)
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		p.ID().String(),
+		p.SKU().String(),
+		p.Name().String(),
+		p.Description().String(),
+		p.Price().Value(),
+		p.CompareAtPrice().Value(),
+		p.Stock().Value(),
+		p.Weight().Value(),
+		p.Dimensions().Length(),
+		p.Dimensions().Width(),
+		p.Dimensions().Height(),
+		p.TaxCategory().String(),
+		p.Language().String(),
+		options,
+		p.ReorderThreshold().Value(),
+		p.BackorderPolicy().String(),
+		p.BackorderLimit(),
+		p.AvailableAt(),
+		p.Status().String(),
+		p.PublishedAt(),
+		p.Version(),
+		p.CreatedAt(),
+		p.UpdatedAt(),
+	); err != nil {
+		return err
+	}
+
+	if err := r.insertVariants(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertStockLevels(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertBackorders(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertAttributes(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertImages(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertCategories(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertPriceSchedules(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertPriceChanges(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertStockMovements(ctx, tx, p, map[stockLevelKey]int{}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindByID retrieves a product by ID
+func (r *ProductRepository) FindByID(ctx context.Context, id product.ID) (*product.Product, error) {
+	query := `
+		SELECT ` + productSelectColumns + `
+		FROM products
+		WHERE id = $1
+	`
+
+	p, err := r.scanProduct(r.db.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, product.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := r.withDetails(ctx, []*product.Product{p})
+	if err != nil {
+		return nil, err
+	}
+	return products[0], nil
+}
+
+// FindBySKU retrieves the product with the given SKU or with a variant with it
+func (r *ProductRepository) FindBySKU(ctx context.Context, sku product.SKU) (*product.Product, error) {
+	query := `
+		SELECT ` + productSelectColumns + `
+		FROM products
+		WHERE sku = $1 OR id = (SELECT product_id FROM product_variants WHERE sku = $1)
+	`
+
+	p, err := r.scanProduct(r.db.QueryRowContext(ctx, query, sku.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, product.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := r.withDetails(ctx, []*product.Product{p})
+	if err != nil {
+		return nil, err
+	}
+	return products[0], nil
+}
+
+// Update updates an existing product, replacing its variants, stock levels, attributes, images, category assignments
+// and price schedules, and appends its price changes to the price history. The product is saved as its next version,
+// and product.ErrConcurrentUpdate is returned when it was updated since it was read.
+func (r *ProductRepository) Update(ctx context.Context, p *product.Product) error {
+	options, err := marshalOptions(p.Options())
+	if err != nil {
+		return err
+	}
+
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE products
+		SET sku = NULLIF($1, ''), name = $2, description = $3, price = $4, compare_at_price = $5, stock = $6,
+			weight = $7, length = $8, width = $9, height = $10, tax_category = $11, language = $12, options = $13,
+			reorder_threshold = $14, backorder_policy = $15, backorder_limit = $16, available_at = $17, status = $18,
+			published_at = $19, updated_at = $20, version = version + 1
+		WHERE id = $21 AND version = $22
+	`
+
+	result, err := tx.ExecContext(
+		ctx,
+		query,
+		p.SKU().String(),
+		p.Name().String(),
+		p.Description().String(),
+		p.Price().Value(),
+		p.CompareAtPrice().Value(),
+		p.Stock().Value(),
+		p.Weight().Value(),
+		p.Dimensions().Length(),
+		p.Dimensions().Width(),
+		p.Dimensions().Height(),
+		p.TaxCategory().String(),
+		p.Language().String(),
+		options,
+		p.ReorderThreshold().Value(),
+		p.BackorderPolicy().String(),
+		p.BackorderLimit(),
+		p.AvailableAt(),
+		p.Status().String(),
+		p.PublishedAt(),
+		p.UpdatedAt(),
+		p.ID().String(),
+		p.Version(),
+	)
+	if err != nil {
+		return err
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return product.ErrConcurrentUpdate
+	}
+
+	storedLevels, err := r.lockStockLevels(ctx, tx, p.ID())
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_variants WHERE product_id = $1`, p.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertVariants(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_stock_levels WHERE product_id = $1`, p.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertStockLevels(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_backorders WHERE product_id = $1`, p.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertBackorders(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_attributes WHERE product_id = $1`, p.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertAttributes(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_images WHERE product_id = $1`, p.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertImages(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_categories WHERE product_id = $1`, p.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertCategories(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_price_schedules WHERE product_id = $1`, p.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertPriceSchedules(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertPriceChanges(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := r.insertStockMovements(ctx, tx, p, storedLevels); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	p.IncrementVersion()
+	return nil
+}
+
+// List retrieves the products matching a filter with pagination
+func (r *ProductRepository) List(ctx context.Context, filter product.Filter, limit, offset int) ([]*product.Product, error) {
+	where, args := productFilterClause(filter)
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`
+		SELECT `+productSelectColumns+`
+		FROM products
+		%s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)-1, len(args))
+
+	return r.queryProducts(ctx, query, args...)
+}
+
+// Count counts the products matching a filter
+func (r *ProductRepository) Count(ctx context.Context, filter product.Filter) (int, error) {
+	where, args := productFilterClause(filter)
+
+	query := `
+		SELECT COUNT(*)
+		FROM products
+		` + where
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Facets counts the products matching a filter per attribute value
+func (r *ProductRepository) Facets(ctx context.Context, filter product.Filter) ([]product.Facet, error) {
+	where, args := productFilterClause(filter)
+
+	query := `
+		SELECT a.name, a.type, a.value, COUNT(*)
+		FROM product_attributes a
+		JOIN products ON products.id = a.product_id
+		` + where + `
+		GROUP BY a.name, a.type, a.value
+		ORDER BY a.name, COUNT(*) DESC, a.value
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var facets []product.Facet
+	for rows.Next() {
+		var name, attributeType, value string
+		var count int
+
+		if err := rows.Scan(&name, &attributeType, &value, &count); err != nil {
+			return nil, err
+		}
+
+		if len(facets) == 0 || facets[len(facets)-1].Name != name {
+			facets = append(facets, product.Facet{Name: name, Type: product.AttributeType(attributeType)})
+		}
+
+		last := &facets[len(facets)-1]
+		last.Values = append(last.Values, product.FacetValue{Value: value, Count: count})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+// FindByIDs retrieves the products with the given IDs, skipping missing ones
+func (r *ProductRepository) FindByIDs(ctx context.Context, ids []product.ID) ([]*product.Product, error) {
+	productIDs := make([]string, len(ids))
+	for i, id := range ids {
+		productIDs[i] = id.String()
+	}
+
+	query := `
+		SELECT ` + productSelectColumns + `
+		FROM products
+		WHERE id = ANY($1)
+	`
+
+	return r.queryProducts(ctx, query, pq.Array(productIDs))
+}
+
+// FindByCategories retrieves the available products assigned to any of the given categories with pagination
+func (r *ProductRepository) FindByCategories(ctx context.Context, categoryIDs []category.ID, limit, offset int) ([]*product.Product, error) {
+	ids := make([]string, len(categoryIDs))
+	for i, id := range categoryIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+		SELECT ` + productSelectColumns + `
+		FROM products
+		WHERE id IN (SELECT product_id FROM product_categories WHERE category_id = ANY($1))
+			AND ` + fmt.Sprintf(availableCondition, "$2") + `
+		ORDER BY created_at DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	return r.queryProducts(ctx, query, pq.Array(ids), time.Now(), limit, offset)
+}
+
+// FindPriceHistory retrieves the price changes of a product, most recent first, with pagination
+func (r *ProductRepository) FindPriceHistory(ctx context.Context, id product.ID, limit, offset int) ([]product.PriceChange, error) {
+	query := `
+		SELECT price, previous_price, changed_at
+		FROM product_price_history
+		WHERE product_id = $1
+		ORDER BY changed_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, id.String(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []product.PriceChange{}
+	for rows.Next() {
+		var price, previousPrice float64
+		var changedAt time.Time
+
+		if err := rows.Scan(&price, &previousPrice, &changedAt); err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, product.ReconstructPriceChange(product.Price(price), product.Price(previousPrice), changedAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// FindStockMovements retrieves the stock movements of a product, most recent first, with pagination
+func (r *ProductRepository) FindStockMovements(ctx context.Context, id product.ID, limit, offset int) ([]product.StockMovement, error) {
+	query := `
+		SELECT id, warehouse_id, variant_id, reason, reference_type, reference_id, quantity_before, quantity_after, occurred_at
+		FROM stock_movements
+		WHERE product_id = $1
+		ORDER BY occurred_at DESC, seq DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, id.String(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	movements := []product.StockMovement{}
+	for rows.Next() {
+		var movementID, warehouseID, variantID, reason, referenceType, referenceID string
+		var before, after int
+		var occurredAt time.Time
+
+		if err := rows.Scan(
+			&movementID, &warehouseID, &variantID, &reason, &referenceType, &referenceID, &before, &after, &occurredAt,
+		); err != nil {
+			return nil, err
+		}
+
+		movements = append(movements, product.ReconstructStockMovement(
+			movementID,
+			warehouse.ID(warehouseID),
+			product.VariantID(variantID),
+			product.MovementReason(reason),
+			product.ReconstructReference(referenceType, referenceID),
+			product.Stock(before),
+			product.Stock(after),
+			occurredAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+// ReconcileStock recomputes the stock held in each warehouse from the stock ledger and returns
+// the stock levels that differ from it, including ledger stock left without a stock level
+func (r *ProductRepository) ReconcileStock(ctx context.Context) ([]product.StockDrift, error) {
+	query := `
+		WITH ledger AS (
+			SELECT product_id, variant_id, warehouse_id, SUM(quantity_after - quantity_before) AS quantity
+			FROM stock_movements
+			GROUP BY product_id, variant_id, warehouse_id
+		)
+		SELECT
+			COALESCE(l.product_id, m.product_id),
+			COALESCE(l.variant_id, m.variant_id),
+			COALESCE(l.warehouse_id, m.warehouse_id),
+			COALESCE(l.quantity, 0),
+			COALESCE(m.quantity, 0)
+		FROM product_stock_levels l
+		FULL OUTER JOIN ledger m
+			ON m.product_id = l.product_id AND m.variant_id = l.variant_id AND m.warehouse_id = l.warehouse_id
+		WHERE COALESCE(l.quantity, 0) <> COALESCE(m.quantity, 0)
+		ORDER BY 1, 2, 3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	drifts := []product.StockDrift{}
+	for rows.Next() {
+		var productID, variantID, warehouseID string
+		var recorded, ledger int
+
+		if err := rows.Scan(&productID, &variantID, &warehouseID, &recorded, &ledger); err != nil {
+			return nil, err
+		}
+
+		drifts = append(drifts, product.ReconstructStockDrift(
+			product.ID(productID),
+			product.VariantID(variantID),
+			warehouse.ID(warehouseID),
+			recorded,
+			ledger,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return drifts, nil
+}
+
+// FindStockSales retrieves the stock of each product without variants and of each variant that has a reorder
+// threshold or was sold since the given time, with the units sold since then. Archived products and
+// cancelled orders are left out.
+func (r *ProductRepository) FindStockSales(ctx context.Context, since time.Time) ([]product.StockSales, error) {
+	query := `
+		WITH sold AS (
+			SELECT order_items.product_id, order_items.variant_id, SUM(order_items.quantity) AS quantity
+			FROM order_items
+			JOIN orders ON orders.id = order_items.order_id
+			WHERE orders.status <> 'cancelled' AND orders.created_at >= $1
+			GROUP BY order_items.product_id, order_items.variant_id
+		),
+		stock AS (
+			SELECT products.id AS product_id, '' AS variant_id, products.name, COALESCE(products.sku, '') AS sku,
+				products.stock, products.reorder_threshold
+			FROM products
+			WHERE products.status <> 'archived'
+				AND NOT EXISTS (SELECT 1 FROM product_variants WHERE product_variants.product_id = products.id)
+			UNION ALL
+			SELECT products.id, product_variants.id, products.name, product_variants.sku,
+				product_variants.stock, products.reorder_threshold
+			FROM product_variants
+			JOIN products ON products.id = product_variants.product_id
+			WHERE products.status <> 'archived'
+		)
+		SELECT stock.product_id, stock.variant_id, stock.name, stock.sku, stock.stock, stock.reorder_threshold,
+			COALESCE(sold.quantity, 0)
+		FROM stock
+		LEFT JOIN sold ON sold.product_id = stock.product_id AND sold.variant_id = stock.variant_id
+		WHERE stock.reorder_threshold > 0 OR sold.quantity > 0
+		ORDER BY stock.name, stock.sku
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sales := []product.StockSales{}
+	for rows.Next() {
+		var productID, variantID, name, sku string
+		var stock, threshold, unitsSold int
+
+		if err := rows.Scan(&productID, &variantID, &name, &sku, &stock, &threshold, &unitsSold); err != nil {
+			return nil, err
+		}
+
+		sales = append(sales, product.ReconstructStockSales(
+			product.ID(productID),
+			product.VariantID(variantID),
+			product.Name(name),
+			product.SKU(sku),
+			product.Stock(stock),
+			product.Stock(threshold),
+			unitsSold,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sales, nil
+}
+
+// FindDuePriceSchedules retrieves the IDs of the products with a price schedule to start or end by the given time
+func (r *ProductRepository) FindDuePriceSchedules(ctx context.Context, now time.Time) ([]product.ID, error) {
+	query := `
+		SELECT DISTINCT product_id
+		FROM product_price_schedules
+		WHERE (NOT active AND starts_at <= $1) OR (active AND ends_at <= $1)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []product.ID
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, product.ID(id))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// insertVariants inserts the variants of a product within a transaction
+func (r *ProductRepository) insertVariants(ctx context.Context, tx executor, p *product.Product) error {
+	query := `
+		INSERT INTO product_variants (id, product_id, sku, option_values, price, stock, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	for _, v := range p.Variants() {
+		optionValues, err := json.Marshal(v.OptionValues())
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			v.ID().String(),
+			p.ID().String(),
+			v.SKU().String(),
+			optionValues,
+			v.PriceOverride().Value(),
+			v.Stock().Value(),
+			v.CreatedAt(),
+			v.UpdatedAt(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stockLevelKey identifies the stock level of a product, or of one of its variants, in a warehouse
+type stockLevelKey struct {
+	variantID   product.VariantID
+	warehouseID warehouse.ID
+}
+
+// lockStockLevels retrieves the stock levels of a product as stored, locking them until the end of a transaction
+func (r *ProductRepository) lockStockLevels(ctx context.Context, tx executor, id product.ID) (map[stockLevelKey]int, error) {
+	query := `
+		SELECT variant_id, warehouse_id, quantity
+		FROM product_stock_levels
+		WHERE product_id = $1
+		FOR UPDATE
+	`
+
+	rows, err := tx.QueryContext(ctx, query, id.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	levels := make(map[stockLevelKey]int)
+	for rows.Next() {
+		var variantID, warehouseID string
+		var quantity int
+
+		if err := rows.Scan(&variantID, &warehouseID, &quantity); err != nil {
+			return nil, err
+		}
+
+		levels[stockLevelKey{variantID: product.VariantID(variantID), warehouseID: warehouse.ID(warehouseID)}] = quantity
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return levels, nil
+}
+
+// insertStockLevels inserts the stock levels of a product and its variants within a transaction
+func (r *ProductRepository) insertStockLevels(ctx context.Context, tx executor, p *product.Product) error {
+	query := `
+		INSERT INTO product_stock_levels (product_id, variant_id, warehouse_id, quantity)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	for _, level := range p.StockLevels() {
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			p.ID().String(),
+			level.VariantID().String(),
+			level.WarehouseID().String(),
+			level.Quantity().Value(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertBackorders inserts the order items waiting for the stock of a product within a transaction
+func (r *ProductRepository) insertBackorders(ctx context.Context, tx executor, p *product.Product) error {
+	query := `
+		INSERT INTO product_backorders (item_id, product_id, variant_id, order_id, quantity, placed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	for _, b := range p.Backorders() {
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			b.ItemID(),
+			p.ID().String(),
+			b.VariantID().String(),
+			b.OrderID(),
+			b.Quantity(),
+			b.PlacedAt(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertAttributes inserts the attributes of a product within a transaction
+func (r *ProductRepository) insertAttributes(ctx context.Context, tx executor, p *product.Product) error {
+	query := `
+		INSERT INTO product_attributes (product_id, name, type, value)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	for _, attribute := range p.Attributes() {
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			p.ID().String(),
+			attribute.Name(),
+			attribute.Type().String(),
+			attribute.Value(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertImages inserts the images of a product in display order within a transaction
+func (r *ProductRepository) insertImages(ctx context.Context, tx executor, p *product.Product) error {
+	query := `
+		INSERT INTO product_images (id, product_id, alt_text, position, renditions, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	for position, image := range p.Images() {
+		records := make([]renditionRecord, len(image.Renditions()))
+		for i, rendition := range image.Renditions() {
+			records[i] = renditionRecord{
+				Name:   rendition.Name(),
+				Key:    rendition.Key(),
+				URL:    rendition.URL(),
+				Width:  rendition.Width(),
+				Height: rendition.Height(),
+			}
+		}
+
+		renditions, err := json.Marshal(records)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			image.ID().String(),
+			p.ID().String(),
+			image.AltText(),
+			position,
+			renditions,
+			image.CreatedAt(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertCategories inserts the category assignments of a product within a transaction
+func (r *ProductRepository) insertCategories(ctx context.Context, tx executor, p *product.Product) error {
+	query := `
+		INSERT INTO product_categories (product_id, category_id)
+		VALUES ($1, $2)
+	`
+
+	for _, categoryID := range p.CategoryIDs() {
+		if _, err := tx.ExecContext(ctx, query, p.ID().String(), categoryID.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertPriceSchedules inserts the price schedules of a product within a transaction
+func (r *ProductRepository) insertPriceSchedules(ctx context.Context, tx executor, p *product.Product) error {
+	query := `
+		INSERT INTO product_price_schedules (id, product_id, price, starts_at, ends_at, active, original_price)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	for _, s := range p.PriceSchedules() {
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			s.ID().String(),
+			p.ID().String(),
+			s.Price().Value(),
+			s.StartsAt(),
+			s.EndsAt(),
+			s.IsActive(),
+			s.OriginalPrice().Value(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertPriceChanges appends the price changes of a product to its price history within a transaction
+func (r *ProductRepository) insertPriceChanges(ctx context.Context, tx executor, p *product.Product) error {
+	query := `
+		INSERT INTO product_price_history (product_id, price, previous_price, changed_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	for _, change := range p.PriceChanges() {
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			p.ID().String(),
+			change.Price().Value(),
+			change.PreviousPrice().Value(),
+			change.ChangedAt(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertStockMovements appends the stock movements of a product to the stock ledger within a transaction.
+// Movements already recorded by an earlier save of the same product are skipped. Each new movement has to start
+// from the stock level as stored before the save, advanced by the movements before it, so the ledger always adds
+// up to the stock levels; a movement computed from a stale copy of the product returns product.ErrConcurrentUpdate.
+func (r *ProductRepository) insertStockMovements(
+	ctx context.Context,
+	tx executor,
+	p *product.Product,
+	storedLevels map[stockLevelKey]int,
+) error {
+	query := `
+		INSERT INTO stock_movements (
+			id, product_id, variant_id, warehouse_id, reason, reference_type, reference_id,
+			quantity_before, quantity_after, occurred_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO NOTHING
+		RETURNING id
+	`
+
+	for _, movement := range p.StockMovements() {
+		var id string
+		err := tx.QueryRowContext(
+			ctx,
+			query,
+			movement.ID(),
+			p.ID().String(),
+			movement.VariantID().String(),
+			movement.WarehouseID().String(),
+			movement.Reason().String(),
+			movement.Reference().Type(),
+			movement.Reference().ID(),
+			movement.Before().Value(),
+			movement.After().Value(),
+			movement.OccurredAt(),
+		).Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		key := stockLevelKey{variantID: movement.VariantID(), warehouseID: movement.WarehouseID()}
+		if storedLevels[key] != movement.Before().Value() {
+			return product.ErrConcurrentUpdate
+		}
+		storedLevels[key] = movement.After().Value()
+	}
+
+	return nil
+}
+
+// queryProducts runs a query returning product rows
+func (r *ProductRepository) queryProducts(ctx context.Context, query string, args ...interface{}) ([]*product.Product, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var products []*product.Product
+	for rows.Next() {
+		p, err := r.scanProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return r.withDetails(ctx, products)
+}
+
+// withDetails loads the variants, attributes, images, stock levels, backorders and price schedules of products and rebuilds the products with them
+func (r *ProductRepository) withDetails(ctx context.Context, products []*product.Product) ([]*product.Product, error) {
+	if len(products) == 0 {
+		return products, nil
+	}
+
+	ids := make([]string, len(products))
+	for i, p := range products {
+		ids[i] = p.ID().String()
+	}
+
+	query := `
+		SELECT id, product_id, sku, option_values, price, stock, created_at, updated_at
+		FROM product_variants
+		WHERE product_id = ANY($1)
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	variants := make(map[string][]*product.Variant)
+	for rows.Next() {
+		var id, productID, sku string
+		var optionValuesJSON []byte
+		var price float64
+		var stock int
+		var createdAt, updatedAt time.Time
+
+		if err := rows.Scan(&id, &productID, &sku, &optionValuesJSON, &price, &stock, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+
+		var optionValues map[string]string
+		if err := json.Unmarshal(optionValuesJSON, &optionValues); err != nil {
+			return nil, err
+		}
+
+		variants[productID] = append(variants[productID], product.ReconstructVariant(
+			product.VariantID(id),
+			product.SKU(sku),
+			optionValues,
+			product.Price(price),
+			product.Stock(stock),
+			createdAt,
+			updatedAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	attributes, err := r.findAttributes(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := r.findImages(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := r.findPriceSchedules(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	stockLevels, err := r.findStockLevels(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	backorders, err := r.findBackorders(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*product.Product, len(products))
+	for i, p := range products {
+		result[i] = product.Reconstruct(
+			p.ID(),
+			p.SKU(),
+			p.Name(),
+			p.Description(),
+			p.Price(),
+			p.CompareAtPrice(),
+			p.Stock(),
+			p.Weight(),
+			p.Dimensions(),
+			p.TaxCategory(),
+			p.Language(),
+			p.CategoryIDs(),
+			p.Options(),
+			variants[p.ID().String()],
+			attributes[p.ID().String()],
+			images[p.ID().String()],
+			stockLevels[p.ID().String()],
+			backorders[p.ID().String()],
+			p.ReorderThreshold(),
+			p.BackorderPolicy(),
+			p.BackorderLimit(),
+			p.AvailableAt(),
+			p.Status(),
+			p.PublishedAt(),
+			schedules[p.ID().String()],
+			p.Version(),
+			p.CreatedAt(),
+			p.UpdatedAt(),
+		)
+	}
+
+	return result, nil
+}
+
+// findStockLevels retrieves the stock levels of products and their variants, keyed by product ID
+func (r *ProductRepository) findStockLevels(ctx context.Context, productIDs []string) (map[string][]product.StockLevel, error) {
+	query := `
+		SELECT l.product_id, l.warehouse_id, l.variant_id, l.quantity
+		FROM product_stock_levels l
+		JOIN warehouses w ON w.id = l.warehouse_id
+		WHERE l.product_id = ANY($1)
+		ORDER BY w.priority ASC, w.code ASC, l.variant_id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(productIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	levels := make(map[string][]product.StockLevel)
+	for rows.Next() {
+		var productID, warehouseID, variantID string
+		var quantity int
+
+		if err := rows.Scan(&productID, &warehouseID, &variantID, &quantity); err != nil {
+			return nil, err
+		}
+
+		levels[productID] = append(levels[productID], product.ReconstructStockLevel(
+			warehouse.ID(warehouseID),
+			product.VariantID(variantID),
+			product.Stock(quantity),
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return levels, nil
+}
+
+// findBackorders retrieves the order items waiting for the stock of products, oldest first, keyed by product ID
+func (r *ProductRepository) findBackorders(ctx context.Context, productIDs []string) (map[string][]*product.Backorder, error) {
+	query := `
+		SELECT product_id, order_id, item_id, variant_id, quantity, placed_at
+		FROM product_backorders
+		WHERE product_id = ANY($1)
+		ORDER BY placed_at ASC, item_id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(productIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	backorders := make(map[string][]*product.Backorder)
+	for rows.Next() {
+		var productID, orderID, itemID, variantID string
+		var quantity int
+		var placedAt time.Time
+
+		if err := rows.Scan(&productID, &orderID, &itemID, &variantID, &quantity, &placedAt); err != nil {
+			return nil, err
+		}
+
+		backorders[productID] = append(backorders[productID], product.ReconstructBackorder(
+			orderID,
+			itemID,
+			product.VariantID(variantID),
+			quantity,
+			placedAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return backorders, nil
+}
+
+// findAttributes retrieves the attributes of products, keyed by product ID
+func (r *ProductRepository) findAttributes(ctx context.Context, productIDs []string) (map[string][]product.Attribute, error) {
+	query := `
+		SELECT product_id, name, type, value
+		FROM product_attributes
+		WHERE product_id = ANY($1)
+		ORDER BY name ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(productIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attributes := make(map[string][]product.Attribute)
+	for rows.Next() {
+		var productID, name, attributeType, value string
+
+		if err := rows.Scan(&productID, &name, &attributeType, &value); err != nil {
+			return nil, err
+		}
+
+		attributes[productID] = append(attributes[productID], product.ReconstructAttribute(
+			name,
+			product.AttributeType(attributeType),
+			value,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+// findImages retrieves the images of products in display order, keyed by product ID
+func (r *ProductRepository) findImages(ctx context.Context, productIDs []string) (map[string][]*product.Image, error) {
+	query := `
+		SELECT id, product_id, alt_text, renditions, created_at
+		FROM product_images
+		WHERE product_id = ANY($1)
+		ORDER BY position ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(productIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	images := make(map[string][]*product.Image)
+	for rows.Next() {
+		var id, productID, altText string
+		var renditionsJSON []byte
+		var createdAt time.Time
+
+		if err := rows.Scan(&id, &productID, &altText, &renditionsJSON, &createdAt); err != nil {
+			return nil, err
+		}
+
+		var records []renditionRecord
+		if err := json.Unmarshal(renditionsJSON, &records); err != nil {
+			return nil, err
+		}
+
+		renditions := make([]product.Rendition, len(records))
+		for i, record := range records {
+			renditions[i] = product.ReconstructRendition(record.Name, record.Key, record.URL, record.Width, record.Height)
+		}
+
+		images[productID] = append(images[productID], product.ReconstructImage(
+			product.ImageID(id),
+			altText,
+			renditions,
+			createdAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// findPriceSchedules retrieves the price schedules of products, keyed by product ID
+func (r *ProductRepository) findPriceSchedules(ctx context.Context, productIDs []string) (map[string][]*product.PriceSchedule, error) {
+	query := `
+		SELECT id, product_id, price, starts_at, ends_at, active, original_price
+		FROM product_price_schedules
+		WHERE product_id = ANY($1)
+		ORDER BY starts_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(productIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := make(map[string][]*product.PriceSchedule)
+	for rows.Next() {
+		var id, productID string
+		var price, originalPrice float64
+		var startsAt time.Time
+		var endsAt sql.NullTime
+		var active bool
+
+		if err := rows.Scan(&id, &productID, &price, &startsAt, &endsAt, &active, &originalPrice); err != nil {
+			return nil, err
+		}
+
+		var end *time.Time
+		if endsAt.Valid {
+			end = &endsAt.Time
+		}
+
+		schedules[productID] = append(schedules[productID], product.ReconstructPriceSchedule(
+			product.PriceScheduleID(id),
+			product.Price(price),
+			startsAt,
+			end,
+			active,
+			product.Price(originalPrice),
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// scanProduct scans a product from a row, without its variants, stock levels, attributes and images
+func (r *ProductRepository) scanProduct(row rowScanner) (*product.Product, error) {
+	var id, name, taxCategory, language, status string
+	var sku, description sql.NullString
+	var publishedAt sql.NullTime
+	var price, compareAtPrice, weight, length, width, height float64
+	var stock, threshold, backorderLimit, version int
+	var backorderPolicy string
+	var availableAt sql.NullTime
+	var optionsJSON []byte
+	var createdAt, updatedAt time.Time
+	var categoryIDs []string
+
+	if err := row.Scan(
+		&id, &sku, &name, &description, &price, &compareAtPrice, &stock,
+		&weight, &length, &width, &height, &taxCategory,
+		&language, &optionsJSON, &threshold, &backorderPolicy, &backorderLimit, &availableAt, &status, &publishedAt, &version, &createdAt, &updatedAt, pq.Array(&categoryIDs),
+	); err != nil {
+		return nil, err
+	}
+
+	var records []optionRecord
+	if err := json.Unmarshal(optionsJSON, &records); err != nil {
+		return nil, err
+	}
+
+	options := make([]product.Option, len(records))
+	for i, record := range records {
+		options[i] = product.ReconstructOption(record.Name, record.Values)
+	}
+
+	dimensions, err := product.NewDimensions(length, width, height)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]category.ID, len(categoryIDs))
+	for i, categoryID := range categoryIDs {
+		categories[i] = category.ID(categoryID)
+	}
+
+	var published *time.Time
+	if publishedAt.Valid {
+		published = &publishedAt.Time
+	}
+
+	var available *time.Time
+	if availableAt.Valid {
+		available = &availableAt.Time
+	}
+
+	return product.Reconstruct(
+		product.ID(id),
+		product.SKU(sku.String),
+		product.Name(name),
+		product.Description(description.String),
+		product.Price(price),
+		product.Price(compareAtPrice),
+		product.Stock(stock),
+		product.Weight(weight),
+		dimensions,
+		product.TaxCategory(taxCategory),
+		product.Language(language),
+		categories,
+		options,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		product.Stock(threshold),
+		product.BackorderPolicy(backorderPolicy),
+		backorderLimit,
+		available,
+		product.Status(status),
+		published,
+		nil,
+		version,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// productFilterClause builds the WHERE clause and arguments matching a product filter
+func productFilterClause(filter product.Filter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	// Without a status, only the products customers can see are listed
+	if filter.Status != "" {
+		conditions = append(conditions, "products.status = "+arg(filter.Status.String()))
+	} else {
+		conditions = append(conditions, fmt.Sprintf(availableCondition, arg(time.Now())))
+	}
+
+	names := make([]string, 0, len(filter.Attributes))
+	for name := range filter.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		conditions = append(conditions, fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM product_attributes fa WHERE fa.product_id = products.id AND fa.name = %s AND fa.value = ANY(%s))`,
+			arg(name), arg(pq.Array(filter.Attributes[name])),
+		))
+	}
+
+	// Products with variants are priced by each variant, at its override or the product price
+	var priceBounds []string
+	if filter.MinPrice > 0 {
+		priceBounds = append(priceBounds, "%[1]s >= "+arg(filter.MinPrice))
+	}
+	if filter.MaxPrice > 0 {
+		priceBounds = append(priceBounds, "%[1]s <= "+arg(filter.MaxPrice))
+	}
+	if len(priceBounds) > 0 {
+		bounds := strings.Join(priceBounds, " AND ")
+		conditions = append(conditions, `((`+fmt.Sprintf(bounds, "products.price")+`
+			AND NOT EXISTS (SELECT 1 FROM product_variants fv WHERE fv.product_id = products.id))
+			OR EXISTS (SELECT 1 FROM product_variants fv WHERE fv.product_id = products.id
+				AND `+fmt.Sprintf(bounds, "COALESCE(NULLIF(fv.price, 0), products.price)")+`))`)
+	}
+
+	// Products with variants are in stock when one of their variants is
+	if filter.InStock {
+		conditions = append(conditions, `(EXISTS (SELECT 1 FROM product_variants fv WHERE fv.product_id = products.id AND fv.stock > 0)
+			OR (products.stock > 0 AND NOT EXISTS (SELECT 1 FROM product_variants fv WHERE fv.product_id = products.id)))`)
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// marshalOptions encodes product options as JSON
+func marshalOptions(options []product.Option) ([]byte, error) {
+	records := make([]optionRecord, len(options))
+	for i, option := range options {
+		records[i] = optionRecord{Name: option.Name(), Values: option.Values()}
+	}
+	return json.Marshal(records)
+}
@@ -0,0 +1,154 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/application/product/search"
+	"e-commerce/internal/domain/product"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+// Control characters ts_headline surrounds the matched words with, replaced by <mark> tags once
+// the product text is HTML-escaped
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+// highlightTags replaces the highlight selectors with <mark> tags
+var highlightTags = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// ProductSearchIndex implements the search.SearchIndex interface with PostgreSQL full-text search
+// over the products table. The search vectors are maintained by a database trigger, so the
+// index needs no syncing and its write methods do nothing.
+type ProductSearchIndex struct {
+	db *sql.DB
+}
+
+// NewProductSearchIndex creates a new ProductSearchIndex
+func NewProductSearchIndex(db *sql.DB) *ProductSearchIndex {
+	return &ProductSearchIndex{
+		db: db,
+	}
+}
+
+// likeEscaper escapes the LIKE wildcards of a search prefix
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Index does nothing; search vectors are updated by the database as products are written
+func (i *ProductSearchIndex) Index(ctx context.Context, documents ...search.Document) error {
+	return nil
+}
+
+// Remove does nothing; products that are not available are filtered out when searching
+func (i *ProductSearchIndex) Remove(ctx context.Context, productIDs ...string) error {
+	return nil
+}
+
+// Clear does nothing; the index is the products table itself
+func (i *ProductSearchIndex) Clear(ctx context.Context) error {
+	return nil
+}
+
+// Search searches the available products by name and description. Products whose search vector
+// matches the query come first by full-text rank; products whose name is only similar
+// to the search text, such as a misspelling, follow by trigram similarity.
+func (i *ProductSearchIndex) Search(ctx context.Context, query product.SearchQuery, limit, offset int) ([]search.Hit, error) {
+	sqlQuery := `
+		WITH search AS (
+			SELECT websearch_to_tsquery($2::regconfig, $1) AS tsq
+		), matches AS (
+			SELECT products.id AS match_id,
+				products.search_vector @@ search.tsq AS full_text,
+				CASE WHEN products.search_vector @@ search.tsq
+					THEN ts_rank_cd(products.search_vector, search.tsq, 32)
+					ELSE word_similarity($1, products.name)
+				END AS rank
+			FROM products, search
+			WHERE (products.search_vector @@ search.tsq OR $1 <% products.name)
+				AND ` + fmt.Sprintf(availableCondition, "$5") + `
+			ORDER BY full_text DESC, rank DESC, products.created_at DESC
+			LIMIT $3 OFFSET $4
+		)
+		SELECT products.id, matches.rank, matches.full_text,
+			ts_headline($2::regconfig, products.name, search.tsq, 'HighlightAll=true, ' || $6),
+			ts_headline($2::regconfig, COALESCE(products.description, ''), search.tsq,
+				'MinWords=15, MaxWords=35, MaxFragments=2, ' || $6)
+		FROM matches
+		JOIN products ON products.id = matches.match_id
+		CROSS JOIN search
+		ORDER BY matches.full_text DESC, matches.rank DESC, products.created_at DESC
+	`
+
+	selectors := "StartSel=" + highlightStart + ", StopSel=" + highlightStop
+	rows, err := i.db.QueryContext(ctx, sqlQuery, query.Text, query.Language.String(), limit, offset, time.Now(), selectors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hits []search.Hit
+	for rows.Next() {
+		var hit search.Hit
+		var fullText bool
+
+		if err := rows.Scan(&hit.ProductID, &hit.Rank, &fullText, &hit.NameHighlight, &hit.Snippet); err != nil {
+			return nil, err
+		}
+
+		hit.NameHighlight = markHighlights(hit.NameHighlight)
+		hit.Snippet = markHighlights(hit.Snippet)
+		hit.Fuzzy = !fullText
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+// Suggest returns the names of available products with a word starting with the prefix,
+// names starting with it first
+func (i *ProductSearchIndex) Suggest(ctx context.Context, prefix string, limit int) ([]search.Suggestion, error) {
+	query := `
+		SELECT id, name
+		FROM products
+		WHERE (name ILIKE $1 || '%' OR name ILIKE '% ' || $1 || '%')
+			AND ` + fmt.Sprintf(availableCondition, "$3") + `
+		ORDER BY name ILIKE $1 || '%' DESC, LENGTH(name), name
+		LIMIT $2
+	`
+
+	rows, err := i.db.QueryContext(ctx, query, likeEscaper.Replace(strings.TrimSpace(prefix)), limit, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var suggestions []search.Suggestion
+	for rows.Next() {
+		var suggestion search.Suggestion
+
+		if err := rows.Scan(&suggestion.ProductID, &suggestion.Text); err != nil {
+			return nil, err
+		}
+
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return suggestions, nil
+}
+
+// markHighlights HTML-escapes a headline of ts_headline and wraps its matched words in <mark> tags
+func markHighlights(headline string) string {
+	return highlightTags.Replace(html.EscapeString(headline))
+}
@@ -0,0 +1,247 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/promotion"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PromotionRepository implements the promotion.Repository interface
+type PromotionRepository struct {
+	db *sql.DB
+}
+
+// NewPromotionRepository creates a new PromotionRepository
+func NewPromotionRepository(db *sql.DB) *PromotionRepository {
+	return &PromotionRepository{
+		db: db,
+	}
+}
+
+const promotionColumns = `id, name, description, type, value, tiers, priority, stackable, min_order_value,
+	valid_from, valid_until, product_ids, category_ids, active, created_at, updated_at`
+
+// promotionTierRecord is the JSON representation of a promotion tier
+type promotionTierRecord struct {
+	MinQuantity int     `json:"min_quantity"`
+	Percentage  float64 `json:"percentage"`
+}
+
+// Save persists a promotion to the database
+func (r *PromotionRepository) Save(ctx context.Context, p *promotion.Promotion) error {
+	tiersJSON, err := marshalPromotionTiers(p.Tiers())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO promotions (` + promotionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	`
+
+	_, err = r.db.ExecContext(
+		ctx,
+		query,
+		p.ID().String(),
+		p.Name(),
+		p.Description(),
+		p.Type().String(),
+		p.Value(),
+		tiersJSON,
+		p.Priority(),
+		p.IsStackable(),
+		p.MinOrderValue(),
+		nullTime(p.ValidFrom()),
+		nullTime(p.ValidUntil()),
+		pq.Array(productIDStrings(p.ProductIDs())),
+		pq.Array(p.CategoryIDs()),
+		p.IsActive(),
+		p.CreatedAt(),
+		p.UpdatedAt(),
+	)
+
+	return err
+}
+
+// FindByID retrieves a promotion by ID
+func (r *PromotionRepository) FindByID(ctx context.Context, id promotion.ID) (*promotion.Promotion, error) {
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM promotions
+		WHERE id = $1
+	`
+
+	p, err := r.scanPromotion(r.db.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("promotion not found")
+	}
+	return p, err
+}
+
+// FindActive retrieves all active promotions, whatever their validity window
+func (r *PromotionRepository) FindActive(ctx context.Context) ([]*promotion.Promotion, error) {
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM promotions
+		WHERE active = TRUE
+		ORDER BY priority DESC, created_at ASC
+	`
+
+	return r.queryPromotions(ctx, query)
+}
+
+// Update updates an existing promotion
+func (r *PromotionRepository) Update(ctx context.Context, p *promotion.Promotion) error {
+	tiersJSON, err := marshalPromotionTiers(p.Tiers())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE promotions
+		SET name = $1, description = $2, value = $3, tiers = $4, priority = $5, stackable = $6,
+			min_order_value = $7, valid_from = $8, valid_until = $9, product_ids = $10, category_ids = $11,
+			active = $12, updated_at = $13
+		WHERE id = $14
+	`
+
+	_, err = r.db.ExecContext(
+		ctx,
+		query,
+		p.Name(),
+		p.Description(),
+		p.Value(),
+		tiersJSON,
+		p.Priority(),
+		p.IsStackable(),
+		p.MinOrderValue(),
+		nullTime(p.ValidFrom()),
+		nullTime(p.ValidUntil()),
+		pq.Array(productIDStrings(p.ProductIDs())),
+		pq.Array(p.CategoryIDs()),
+		p.IsActive(),
+		p.UpdatedAt(),
+		p.ID().String(),
+	)
+
+	return err
+}
+
+// Delete removes a promotion from the database
+func (r *PromotionRepository) Delete(ctx context.Context, id promotion.ID) error {
+	query := `
+		DELETE FROM promotions
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id.String())
+	return err
+}
+
+// List retrieves all promotions with pagination
+func (r *PromotionRepository) List(ctx context.Context, limit, offset int) ([]*promotion.Promotion, error) {
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM promotions
+		ORDER BY priority DESC, created_at ASC
+		LIMIT $1 OFFSET $2
+	`
+
+	return r.queryPromotions(ctx, query, limit, offset)
+}
+
+// queryPromotions runs a query returning promotion rows
+func (r *PromotionRepository) queryPromotions(ctx context.Context, query string, args ...interface{}) ([]*promotion.Promotion, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var promotions []*promotion.Promotion
+	for rows.Next() {
+		p, err := r.scanPromotion(rows)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+// scanPromotion scans a promotion from a row
+func (r *PromotionRepository) scanPromotion(row rowScanner) (*promotion.Promotion, error) {
+	var id, name, description, promotionType string
+	var value, minOrderValue float64
+	var tiersJSON []byte
+	var priority int
+	var stackable, active bool
+	var validFrom, validUntil sql.NullTime
+	var productIDs, categoryIDs []string
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(
+		&id, &name, &description, &promotionType, &value, &tiersJSON, &priority, &stackable, &minOrderValue,
+		&validFrom, &validUntil, pq.Array(&productIDs), pq.Array(&categoryIDs), &active, &createdAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var records []promotionTierRecord
+	if err := json.Unmarshal(tiersJSON, &records); err != nil {
+		return nil, err
+	}
+
+	tiers := make([]promotion.Tier, 0, len(records))
+	for _, record := range records {
+		tier, err := promotion.NewTier(record.MinQuantity, record.Percentage)
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+
+	products := make([]product.ID, len(productIDs))
+	for i, productID := range productIDs {
+		products[i] = product.ID(productID)
+	}
+
+	return promotion.Reconstruct(
+		promotion.ID(id),
+		name,
+		description,
+		promotion.Type(promotionType),
+		value,
+		tiers,
+		priority,
+		stackable,
+		minOrderValue,
+		validFrom.Time,
+		validUntil.Time,
+		products,
+		categoryIDs,
+		active,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// marshalPromotionTiers encodes promotion tiers as JSON
+func marshalPromotionTiers(tiers []promotion.Tier) ([]byte, error) {
+	records := make([]promotionTierRecord, len(tiers))
+	for i, tier := range tiers {
+		records[i] = promotionTierRecord{MinQuantity: tier.MinQuantity(), Percentage: tier.Percentage()}
+	}
+	return json.Marshal(records)
+}
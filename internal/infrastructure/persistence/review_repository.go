@@ -0,0 +1,361 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/review"
+	"e-commerce/internal/domain/user"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ReviewRepository implements the review.Repository interface
+type ReviewRepository struct {
+	db *sql.DB
+}
+
+// NewReviewRepository creates a new ReviewRepository
+func NewReviewRepository(db *sql.DB) *ReviewRepository {
+	return &ReviewRepository{
+		db: db,
+	}
+}
+
+const reviewColumns = `id, product_id, user_id, rating, title, body, verified, status, moderation_note, created_at, updated_at`
+
+// reviewSorts maps review sorts to ORDER BY clauses; helpfulness counts the helpful votes of each review
+var reviewSorts = map[review.Sort]string{
+	review.SortNewest: `created_at DESC`,
+	review.SortHelpful: `(SELECT COUNT(*) FROM review_votes v WHERE v.review_id = reviews.id AND v.helpful) DESC,
+		created_at DESC`,
+	review.SortHighest: `rating DESC, created_at DESC`,
+	review.SortLowest:  `rating ASC, created_at DESC`,
+}
+
+// Save persists a review with its votes to the database
+func (r *ReviewRepository) Save(ctx context.Context, rv *review.Review) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO reviews (` + reviewColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		rv.ID().String(),
+		rv.ProductID().String(),
+		rv.UserID().String(),
+		rv.Rating().Value(),
+		rv.Title().String(),
+		rv.Body().String(),
+		rv.IsVerified(),
+		rv.Status().String(),
+		rv.ModerationNote(),
+		rv.CreatedAt(),
+		rv.UpdatedAt(),
+	); err != nil {
+		return err
+	}
+
+	if err := r.insertVotes(ctx, tx, rv); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindByID retrieves a review by ID
+func (r *ReviewRepository) FindByID(ctx context.Context, id review.ID) (*review.Review, error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM reviews
+		WHERE id = $1
+	`
+
+	return r.findOne(ctx, query, id.String())
+}
+
+// FindByProductAndUser retrieves the review of a product by a user
+func (r *ReviewRepository) FindByProductAndUser(ctx context.Context, productID product.ID, userID user.ID) (*review.Review, error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM reviews
+		WHERE product_id = $1 AND user_id = $2
+	`
+
+	return r.findOne(ctx, query, productID.String(), userID.String())
+}
+
+// Update updates an existing review, replacing its votes
+func (r *ReviewRepository) Update(ctx context.Context, rv *review.Review) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE reviews
+		SET rating = $1, title = $2, body = $3, verified = $4, status = $5, moderation_note = $6, updated_at = $7
+		WHERE id = $8
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		rv.Rating().Value(),
+		rv.Title().String(),
+		rv.Body().String(),
+		rv.IsVerified(),
+		rv.Status().String(),
+		rv.ModerationNote(),
+		rv.UpdatedAt(),
+		rv.ID().String(),
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM review_votes WHERE review_id = $1`, rv.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertVotes(ctx, tx, rv); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes a review and its votes from the database
+func (r *ReviewRepository) Delete(ctx context.Context, id review.ID) error {
+	query := `
+		DELETE FROM reviews
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id.String())
+	return err
+}
+
+// ListByProduct retrieves the reviews of a product in a moderation state, optionally only verified ones,
+// in the given order with pagination
+func (r *ReviewRepository) ListByProduct(
+	ctx context.Context,
+	productID product.ID,
+	status review.Status,
+	verifiedOnly bool,
+	sort review.Sort,
+	limit, offset int,
+) ([]*review.Review, error) {
+	orderBy, ok := reviewSorts[sort]
+	if !ok {
+		return nil, review.ErrInvalidSort
+	}
+
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM reviews
+		WHERE product_id = $1 AND status = $2 AND (verified OR NOT $3)
+		ORDER BY ` + orderBy + `
+		LIMIT $4 OFFSET $5
+	`
+
+	return r.queryReviews(ctx, query, productID.String(), status.String(), verifiedOnly, limit, offset)
+}
+
+// ListByStatus retrieves the reviews in a moderation state, oldest first, with pagination
+func (r *ReviewRepository) ListByStatus(ctx context.Context, status review.Status, limit, offset int) ([]*review.Review, error) {
+	query := `
+		SELECT ` + reviewColumns + `
+		FROM reviews
+		WHERE status = $1
+		ORDER BY created_at ASC
+		LIMIT $2 OFFSET $3
+	`
+
+	return r.queryReviews(ctx, query, status.String(), limit, offset)
+}
+
+// Summarize counts the approved reviews of the given products per rating
+func (r *ReviewRepository) Summarize(ctx context.Context, productIDs []product.ID) (map[product.ID]review.RatingSummary, error) {
+	query := `
+		SELECT product_id, rating, COUNT(*)
+		FROM reviews
+		WHERE product_id = ANY($1) AND status = $2
+		GROUP BY product_id, rating
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(productIDStrings(productIDs)), review.StatusApproved.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[product.ID]map[review.Rating]int{}
+	for rows.Next() {
+		var productID string
+		var rating, count int
+
+		if err := rows.Scan(&productID, &rating, &count); err != nil {
+			return nil, err
+		}
+
+		id := product.ID(productID)
+		if counts[id] == nil {
+			counts[id] = map[review.Rating]int{}
+		}
+		counts[id][review.Rating(rating)] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[product.ID]review.RatingSummary, len(counts))
+	for id, c := range counts {
+		summaries[id] = review.ReconstructRatingSummary(c)
+	}
+
+	return summaries, nil
+}
+
+// insertVotes inserts the votes of a review
+func (r *ReviewRepository) insertVotes(ctx context.Context, tx *sql.Tx, rv *review.Review) error {
+	query := `
+		INSERT INTO review_votes (review_id, user_id, helpful, voted_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	for _, v := range rv.Votes() {
+		if _, err := tx.ExecContext(ctx, query, rv.ID().String(), v.UserID().String(), v.Helpful(), v.VotedAt()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findOne retrieves a single review with its votes
+func (r *ReviewRepository) findOne(ctx context.Context, query string, args ...interface{}) (*review.Review, error) {
+	reviews, err := r.queryReviews(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reviews) == 0 {
+		return nil, review.ErrReviewNotFound
+	}
+
+	return reviews[0], nil
+}
+
+// queryReviews retrieves the reviews returned by a query with their votes
+func (r *ReviewRepository) queryReviews(ctx context.Context, query string, args ...interface{}) ([]*review.Review, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type reviewRow struct {
+		id, productID, userID, title, body, status, moderationNote string
+		rating                                                     int
+		verified                                                   bool
+		createdAt, updatedAt                                       time.Time
+	}
+
+	reviewRows := []reviewRow{}
+	ids := []string{}
+	for rows.Next() {
+		var row reviewRow
+		if err := rows.Scan(
+			&row.id, &row.productID, &row.userID, &row.rating, &row.title, &row.body, &row.verified,
+			&row.status, &row.moderationNote, &row.createdAt, &row.updatedAt,
+		); err != nil {
+			return nil, err
+		}
+		reviewRows = append(reviewRows, row)
+		ids = append(ids, row.id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	votes, err := r.findVotes(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]*review.Review, len(reviewRows))
+	for i, row := range reviewRows {
+		reviewVotes := votes[row.id]
+		if reviewVotes == nil {
+			reviewVotes = []*review.Vote{}
+		}
+
+		reviews[i] = review.Reconstruct(
+			review.ID(row.id),
+			product.ID(row.productID),
+			user.ID(row.userID),
+			review.Rating(row.rating),
+			review.Title(row.title),
+			review.Body(row.body),
+			row.verified,
+			review.Status(row.status),
+			row.moderationNote,
+			reviewVotes,
+			row.createdAt,
+			row.updatedAt,
+		)
+	}
+
+	return reviews, nil
+}
+
+// findVotes retrieves the votes of the given reviews, keyed by review ID
+func (r *ReviewRepository) findVotes(ctx context.Context, reviewIDs []string) (map[string][]*review.Vote, error) {
+	votes := map[string][]*review.Vote{}
+	if len(reviewIDs) == 0 {
+		return votes, nil
+	}
+
+	query := `
+		SELECT review_id, user_id, helpful, voted_at
+		FROM review_votes
+		WHERE review_id = ANY($1)
+		ORDER BY voted_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(reviewIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewID, userID string
+		var helpful bool
+		var votedAt time.Time
+
+		if err := rows.Scan(&reviewID, &userID, &helpful, &votedAt); err != nil {
+			return nil, err
+		}
+
+		votes[reviewID] = append(votes[reviewID], review.ReconstructVote(user.ID(userID), helpful, votedAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return votes, nil
+}
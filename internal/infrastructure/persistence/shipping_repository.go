@@ -0,0 +1,319 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/shipping"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ShippingRepository implements the shipping.Repository interface
+type ShippingRepository struct {
+	db *sql.DB
+}
+
+// NewShippingRepository creates a new ShippingRepository
+func NewShippingRepository(db *sql.DB) *ShippingRepository {
+	return &ShippingRepository{
+		db: db,
+	}
+}
+
+// weightTierRecord is the JSON representation of a weight tier
+type weightTierRecord struct {
+	MaxWeight float64 `json:"max_weight"`
+	Price     float64 `json:"price"`
+}
+
+// Save persists a zone and its rates to the database
+func (r *ShippingRepository) Save(ctx context.Context, zone *shipping.Zone) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO shipping_zones (id, name, countries, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		zone.ID().String(),
+		zone.Name(),
+		pq.Array(countryStrings(zone.Countries())),
+		zone.CreatedAt(),
+		zone.UpdatedAt(),
+	); err != nil {
+		return err
+	}
+
+	if err := r.insertRates(ctx, tx, zone); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindByID retrieves a zone by ID
+func (r *ShippingRepository) FindByID(ctx context.Context, id shipping.ID) (*shipping.Zone, error) {
+	query := `
+		SELECT id, name, countries, created_at, updated_at
+		FROM shipping_zones
+		WHERE id = $1
+	`
+
+	return r.findOne(ctx, query, id.String())
+}
+
+// FindByCountry retrieves the most specific zone covering a country
+func (r *ShippingRepository) FindByCountry(ctx context.Context, country shipping.CountryCode) (*shipping.Zone, error) {
+	query := `
+		SELECT id, name, countries, created_at, updated_at
+		FROM shipping_zones
+		WHERE $1 = ANY(countries) OR cardinality(countries) = 0
+		ORDER BY cardinality(countries) DESC
+		LIMIT 1
+	`
+
+	zone, err := r.scanZone(r.db.QueryRowContext(ctx, query, country.String()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, shipping.ErrZoneNotFound
+		}
+		return nil, err
+	}
+
+	return r.withRates(ctx, zone)
+}
+
+// Update updates an existing zone, replacing its rates
+func (r *ShippingRepository) Update(ctx context.Context, zone *shipping.Zone) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE shipping_zones
+		SET name = $1, countries = $2, updated_at = $3
+		WHERE id = $4
+	`
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		zone.Name(),
+		pq.Array(countryStrings(zone.Countries())),
+		zone.UpdatedAt(),
+		zone.ID().String(),
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM shipping_rates WHERE zone_id = $1`, zone.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertRates(ctx, tx, zone); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes a zone from the database
+func (r *ShippingRepository) Delete(ctx context.Context, id shipping.ID) error {
+	query := `
+		DELETE FROM shipping_zones
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id.String())
+	return err
+}
+
+// List retrieves all zones
+func (r *ShippingRepository) List(ctx context.Context) ([]*shipping.Zone, error) {
+	query := `
+		SELECT id, name, countries, created_at, updated_at
+		FROM shipping_zones
+		ORDER BY name ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []*shipping.Zone
+	for rows.Next() {
+		zone, err := r.scanZone(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		zones = append(zones, zone)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, zone := range zones {
+		withRates, err := r.withRates(ctx, zone)
+		if err != nil {
+			return nil, err
+		}
+		zones[i] = withRates
+	}
+
+	return zones, nil
+}
+
+// insertRates inserts the rates of a zone
+func (r *ShippingRepository) insertRates(ctx context.Context, tx *sql.Tx, zone *shipping.Zone) error {
+	query := `
+		INSERT INTO shipping_rates (id, zone_id, method, rate_type, amount, free_threshold, tiers)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	for _, rate := range zone.Rates() {
+		tiers := make([]weightTierRecord, len(rate.Tiers()))
+		for i, tier := range rate.Tiers() {
+			tiers[i] = weightTierRecord{MaxWeight: tier.MaxWeight(), Price: tier.Price()}
+		}
+
+		tiersJSON, err := json.Marshal(tiers)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			rate.ID().String(),
+			zone.ID().String(),
+			rate.Method().String(),
+			rate.Type().String(),
+			rate.Amount(),
+			rate.FreeThreshold(),
+			tiersJSON,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findOne retrieves a single zone with its rates
+func (r *ShippingRepository) findOne(ctx context.Context, query string, args ...interface{}) (*shipping.Zone, error) {
+	zone, err := r.scanZone(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("shipping zone not found")
+		}
+		return nil, err
+	}
+
+	return r.withRates(ctx, zone)
+}
+
+// scanZone scans a zone without its rates from a row
+func (r *ShippingRepository) scanZone(row rowScanner) (*shipping.Zone, error) {
+	var id, name string
+	var countries []string
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(&id, &name, pq.Array(&countries), &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	codes := make([]shipping.CountryCode, len(countries))
+	for i, country := range countries {
+		codes[i] = shipping.CountryCode(country)
+	}
+
+	return shipping.ReconstructZone(shipping.ID(id), name, codes, nil, createdAt, updatedAt), nil
+}
+
+// withRates returns the zone rebuilt with its persisted rates
+func (r *ShippingRepository) withRates(ctx context.Context, zone *shipping.Zone) (*shipping.Zone, error) {
+	query := `
+		SELECT id, method, rate_type, amount, free_threshold, tiers
+		FROM shipping_rates
+		WHERE zone_id = $1
+		ORDER BY method ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, zone.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := []*shipping.Rate{}
+	for rows.Next() {
+		var id, method, rateType string
+		var amount, freeThreshold float64
+		var tiersJSON []byte
+
+		if err := rows.Scan(&id, &method, &rateType, &amount, &freeThreshold, &tiersJSON); err != nil {
+			return nil, err
+		}
+
+		var records []weightTierRecord
+		if err := json.Unmarshal(tiersJSON, &records); err != nil {
+			return nil, err
+		}
+
+		tiers := make([]shipping.WeightTier, 0, len(records))
+		for _, record := range records {
+			tier, err := shipping.NewWeightTier(record.MaxWeight, record.Price)
+			if err != nil {
+				return nil, err
+			}
+			tiers = append(tiers, tier)
+		}
+
+		rates = append(rates, shipping.ReconstructRate(
+			shipping.ID(id),
+			shipping.Method(method),
+			shipping.RateType(rateType),
+			amount,
+			freeThreshold,
+			tiers,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return shipping.ReconstructZone(
+		zone.ID(),
+		zone.Name(),
+		zone.Countries(),
+		rates,
+		zone.CreatedAt(),
+		zone.UpdatedAt(),
+	), nil
+}
+
+// countryStrings converts country codes to strings
+func countryStrings(codes []shipping.CountryCode) []string {
+	result := make([]string, len(codes))
+	for i, code := range codes {
+		result[i] = code.String()
+	}
+	return result
+}
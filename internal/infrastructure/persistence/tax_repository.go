@@ -0,0 +1,146 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/shipping"
+	"e-commerce/internal/domain/tax"
+	"errors"
+	"time"
+)
+
+// TaxRepository implements the tax.Repository interface
+type TaxRepository struct {
+	db *sql.DB
+}
+
+// NewTaxRepository creates a new TaxRepository
+func NewTaxRepository(db *sql.DB) *TaxRepository {
+	return &TaxRepository{
+		db: db,
+	}
+}
+
+const taxRuleColumns = `id, name, country, region, category, rate, inclusive, created_at, updated_at`
+
+// Save persists a rule to the database
+func (r *TaxRepository) Save(ctx context.Context, rule *tax.Rule) error {
+	query := `
+		INSERT INTO tax_rules (` + taxRuleColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		rule.ID().String(),
+		rule.Name(),
+		rule.Country().String(),
+		rule.Region(),
+		rule.Category().String(),
+		rule.Rate().Value(),
+		rule.Inclusive(),
+		rule.CreatedAt(),
+		rule.UpdatedAt(),
+	)
+
+	return err
+}
+
+// FindByID retrieves a rule by ID
+func (r *TaxRepository) FindByID(ctx context.Context, id tax.ID) (*tax.Rule, error) {
+	query := `
+		SELECT ` + taxRuleColumns + `
+		FROM tax_rules
+		WHERE id = $1
+	`
+
+	rule, err := r.scanRule(r.db.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("tax rule not found")
+	}
+	return rule, err
+}
+
+// FindByCountry retrieves all rules of a country
+func (r *TaxRepository) FindByCountry(ctx context.Context, country shipping.CountryCode) ([]*tax.Rule, error) {
+	query := `
+		SELECT ` + taxRuleColumns + `
+		FROM tax_rules
+		WHERE country = $1
+		ORDER BY region ASC, name ASC
+	`
+
+	return r.queryRules(ctx, query, country.String())
+}
+
+// Delete removes a rule from the database
+func (r *TaxRepository) Delete(ctx context.Context, id tax.ID) error {
+	query := `
+		DELETE FROM tax_rules
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id.String())
+	return err
+}
+
+// List retrieves all rules
+func (r *TaxRepository) List(ctx context.Context) ([]*tax.Rule, error) {
+	query := `
+		SELECT ` + taxRuleColumns + `
+		FROM tax_rules
+		ORDER BY country ASC, region ASC, name ASC
+	`
+
+	return r.queryRules(ctx, query)
+}
+
+// queryRules runs a query returning rule rows
+func (r *TaxRepository) queryRules(ctx context.Context, query string, args ...interface{}) ([]*tax.Rule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*tax.Rule
+	for rows.Next() {
+		rule, err := r.scanRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// scanRule scans a rule from a row
+func (r *TaxRepository) scanRule(row rowScanner) (*tax.Rule, error) {
+	var id, name, country, region, category string
+	var rate float64
+	var inclusive bool
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(&id, &name, &country, &region, &category, &rate, &inclusive, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	return tax.ReconstructRule(
+		tax.ID(id),
+		name,
+		shipping.CountryCode(country),
+		region,
+		product.TaxCategory(category),
+		tax.Rate(rate),
+		inclusive,
+		createdAt,
+		updatedAt,
+	), nil
+}
@@ -0,0 +1,91 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+)
+
+// txKey is the context key of the transaction of a running unit of work
+type txKey struct{}
+
+// executor is implemented by *sql.DB, *sql.Tx and writeTx
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// UnitOfWork implements the transaction.UnitOfWork interface with a database transaction
+type UnitOfWork struct {
+	db *sql.DB
+}
+
+// NewUnitOfWork creates a new UnitOfWork
+func NewUnitOfWork(db *sql.DB) *UnitOfWork {
+	return &UnitOfWork{
+		db: db,
+	}
+}
+
+// Do runs work in a database transaction that the repositories join, committing it when the work succeeds
+func (u *UnitOfWork) Do(ctx context.Context, work func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return work(ctx)
+	}
+
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := work(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// conn returns the transaction of the unit of work running in ctx, otherwise the database
+func conn(ctx context.Context, db *sql.DB) executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// writeTx is the transaction of a repository write: a transaction of its own, or the transaction
+// of the unit of work running, which the unit of work commits or rolls back
+type writeTx struct {
+	*sql.Tx
+	joined bool
+}
+
+// beginTx starts the transaction of a repository write, joining the unit of work running in ctx if any
+func beginTx(ctx context.Context, db *sql.DB) (*writeTx, error) {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return &writeTx{Tx: tx, joined: true}, nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &writeTx{Tx: tx}, nil
+}
+
+// Commit commits the transaction unless it belongs to a unit of work
+func (t *writeTx) Commit() error {
+	if t.joined {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+// Rollback rolls back the transaction unless it belongs to a unit of work
+func (t *writeTx) Rollback() error {
+	if t.joined {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
@@ -3,11 +3,16 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"e-commerce/internal/domain/address"
 	"e-commerce/internal/domain/user"
 	"errors"
 	"time"
 )
 
+// userColumns are the columns of the users table, in the order scanUserFromRows scans them
+const userColumns = `id, email, password, name, locale, password_reset_token_hash, password_reset_expires_at,
+	password_reset_requested_at, email_verified_at, verification_nonce, verification_sent_at, created_at, updated_at`
+
 // UserRepository implements the user.Repository interface
 type UserRepository struct {
 	db *sql.DB
@@ -20,70 +25,127 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 	}
 }
 
-// Save persists a user to the database
+// Save persists a user and their address book to the database
 func (r *UserRepository) Save(ctx context.Context, user *user.User) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO users (id, email, password, name, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO users (id, email, password, name, locale, password_reset_token_hash, password_reset_expires_at,
+			password_reset_requested_at, email_verified_at, verification_nonce, verification_sent_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 	`
 
-	_, err := r.db.ExecContext(
+	if _, err := tx.ExecContext(
 		ctx,
 		query,
 		user.ID().String(),
 		user.Email().String(),
 		user.Password().String(),
 		user.Name().String(),
+		user.Locale().String(),
+		nullString(user.PasswordResetTokenHash().String()),
+		user.PasswordResetExpiresAt(),
+		user.PasswordResetRequestedAt(),
+		user.EmailVerifiedAt(),
+		nullString(user.VerificationNonce().String()),
+		user.VerificationSentAt(),
 		user.CreatedAt(),
 		user.UpdatedAt(),
-	)
+	); err != nil {
+		return err
+	}
 
-	return err
+	if err := r.insertAddresses(ctx, tx, user); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // FindByID retrieves a user by ID
 func (r *UserRepository) FindByID(ctx context.Context, id user.ID) (*user.User, error) {
 	query := `
-		SELECT id, email, password, name, created_at, updated_at
+		SELECT ` + userColumns + `
 		FROM users
 		WHERE id = $1
 	`
 
 	row := r.db.QueryRowContext(ctx, query, id.String())
-	return r.scanUser(row)
+	return r.scanUser(ctx, row)
 }
 
 // FindByEmail retrieves a user by email
 func (r *UserRepository) FindByEmail(ctx context.Context, email user.Email) (*user.User, error) {
 	query := `
-		SELECT id, email, password, name, created_at, updated_at
+		SELECT ` + userColumns + `
 		FROM users
 		WHERE email = $1
 	`
 
 	row := r.db.QueryRowContext(ctx, query, email.String())
-	return r.scanUser(row)
+	return r.scanUser(ctx, row)
 }
 
-// Update updates an existing user
+// FindByPasswordResetTokenHash retrieves the user with a pending password reset by the hash of its token
+func (r *UserRepository) FindByPasswordResetTokenHash(ctx context.Context, hash user.PasswordResetTokenHash) (*user.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE password_reset_token_hash = $1
+	`
+
+	row := r.db.QueryRowContext(ctx, query, hash.String())
+	return r.scanUser(ctx, row)
+}
+
+// Update updates an existing user, replacing their address book
 func (r *UserRepository) Update(ctx context.Context, user *user.User) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE users
-		SET email = $1, password = $2, name = $3, updated_at = $4
-		WHERE id = $5
+		SET email = $1, password = $2, name = $3, locale = $4, password_reset_token_hash = $5,
+			password_reset_expires_at = $6, password_reset_requested_at = $7, email_verified_at = $8,
+			verification_nonce = $9, verification_sent_at = $10, updated_at = $11
+		WHERE id = $12
 	`
 
-	_, err := r.db.ExecContext(
+	if _, err := tx.ExecContext(
 		ctx,
 		query,
 		user.Email().String(),
 		user.Password().String(),
 		user.Name().String(),
+		user.Locale().String(),
+		nullString(user.PasswordResetTokenHash().String()),
+		user.PasswordResetExpiresAt(),
+		user.PasswordResetRequestedAt(),
+		user.EmailVerifiedAt(),
+		nullString(user.VerificationNonce().String()),
+		user.VerificationSentAt(),
 		user.UpdatedAt(),
 		user.ID().String(),
-	)
+	); err != nil {
+		return err
+	}
 
-	return err
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_addresses WHERE user_id = $1`, user.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertAddresses(ctx, tx, user); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // Delete removes a user from the database
@@ -100,7 +162,7 @@ func (r *UserRepository) Delete(ctx context.Context, id user.ID) error {
 // List retrieves all users with pagination
 func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*user.User, error) {
 	query := `
-		SELECT id, email, password, name, created_at, updated_at
+		SELECT ` + userColumns + `
 		FROM users
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
@@ -110,64 +172,195 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*user.U
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
 	var users []*user.User
 	for rows.Next() {
 		user, err := r.scanUserFromRows(rows)
 		if err != nil {
+			rows.Close()
 			return nil, err
 		}
 		users = append(users, user)
 	}
+	rows.Close()
 
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
+	// Load the address books once the user rows are released
+	for i, u := range users {
+		users[i], err = r.withAddresses(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return users, nil
 }
 
-// scanUser scans a user from a row
-func (r *UserRepository) scanUser(row *sql.Row) (*user.User, error) {
-	var id, email, password, name string
-	var createdAt, updatedAt time.Time
+// insertAddresses inserts the address book of a user
+func (r *UserRepository) insertAddresses(ctx context.Context, tx executor, u *user.User) error {
+	query := `
+		INSERT INTO user_addresses (id, user_id, label, name, line1, line2, city, postal_code, region, country,
+			is_default_shipping, is_default_billing, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
 
-	if err := row.Scan(&id, &email, &password, &name, &createdAt, &updatedAt); err != nil {
+	for _, saved := range u.Addresses() {
+		addr := saved.Address()
+		if _, err := tx.ExecContext(
+			ctx,
+			query,
+			saved.ID().String(),
+			u.ID().String(),
+			saved.Label(),
+			addr.Name(),
+			addr.Line1(),
+			addr.Line2(),
+			addr.City(),
+			addr.PostalCode(),
+			addr.Region(),
+			addr.Country(),
+			saved.ID() == u.DefaultShippingAddressID(),
+			saved.ID() == u.DefaultBillingAddressID(),
+			saved.CreatedAt(),
+			saved.UpdatedAt(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanUser scans a user from a row and loads their address book
+func (r *UserRepository) scanUser(ctx context.Context, row *sql.Row) (*user.User, error) {
+	u, err := r.scanUserFromRows(row)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.New("user not found")
 		}
 		return nil, err
 	}
 
-	// Reconstruct the user from database values
-	// In a real implementation, we would have a method to reconstruct a user
-	// from persistence with the exact same ID and timestamps
-	u, err := user.NewUser(email, password, name)
-	if err != nil {
+	return r.withAddresses(ctx, u)
+}
+
+// scanUserFromRows scans a user from rows without their address book
+func (r *UserRepository) scanUserFromRows(row rowScanner) (*user.User, error) {
+	var id, email, password, name, locale string
+	var passwordResetTokenHash, verificationNonce sql.NullString
+	var passwordResetExpiresAt, passwordResetRequestedAt, emailVerifiedAt, verificationSentAt sql.NullTime
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(
+		&id, &email, &password, &name, &locale, &passwordResetTokenHash, &passwordResetExpiresAt,
+		&passwordResetRequestedAt, &emailVerifiedAt, &verificationNonce, &verificationSentAt, &createdAt, &updatedAt,
+	); err != nil {
 		return nil, err
 	}
 
-	// Note: In a real implementation, you would have a method like:
-	// user.Reconstruct(id, email, password, name, createdAt, updatedAt)
+	var resetExpiresAt, resetRequestedAt, verifiedAt, sentAt *time.Time
+	if passwordResetExpiresAt.Valid {
+		resetExpiresAt = &passwordResetExpiresAt.Time
+	}
+	if passwordResetRequestedAt.Valid {
+		resetRequestedAt = &passwordResetRequestedAt.Time
+	}
+	if emailVerifiedAt.Valid {
+		verifiedAt = &emailVerifiedAt.Time
+	}
+	if verificationSentAt.Valid {
+		sentAt = &verificationSentAt.Time
+	}
 
-	return u, nil
+	return user.Reconstruct(
+		user.ID(id),
+		user.Email(email),
+		user.Password(password),
+		user.Name(name),
+		user.Locale(locale),
+		nil,
+		"",
+		"",
+		user.PasswordResetTokenHash(passwordResetTokenHash.String),
+		resetExpiresAt,
+		resetRequestedAt,
+		verifiedAt,
+		user.VerificationNonce(verificationNonce.String),
+		sentAt,
+		createdAt,
+		updatedAt,
+	), nil
 }
 
-// scanUserFromRows scans a user from rows
-func (r *UserRepository) scanUserFromRows(rows *sql.Rows) (*user.User, error) {
-	var id, email, password, name string
-	var createdAt, updatedAt time.Time
+// withAddresses loads the address book of a user and rebuilds the user with it
+func (r *UserRepository) withAddresses(ctx context.Context, u *user.User) (*user.User, error) {
+	query := `
+		SELECT id, label, name, line1, line2, city, postal_code, region, country,
+			is_default_shipping, is_default_billing, created_at, updated_at
+		FROM user_addresses
+		WHERE user_id = $1
+		ORDER BY created_at ASC
+	`
 
-	if err := rows.Scan(&id, &email, &password, &name, &createdAt, &updatedAt); err != nil {
+	rows, err := r.db.QueryContext(ctx, query, u.ID().String())
+	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	// Reconstruct the user from database values
-	u, err := user.NewUser(email, password, name)
-	if err != nil {
+	addresses := []*user.SavedAddress{}
+	var defaultShippingID, defaultBillingID user.AddressID
+	for rows.Next() {
+		var id, label, name, line1, line2, city, postalCode, region, country string
+		var isDefaultShipping, isDefaultBilling bool
+		var createdAt, updatedAt time.Time
+
+		if err := rows.Scan(
+			&id, &label, &name, &line1, &line2, &city, &postalCode, &region, &country,
+			&isDefaultShipping, &isDefaultBilling, &createdAt, &updatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, user.ReconstructSavedAddress(
+			user.AddressID(id),
+			label,
+			address.Reconstruct(name, line1, line2, city, postalCode, region, country),
+			createdAt,
+			updatedAt,
+		))
+
+		if isDefaultShipping {
+			defaultShippingID = user.AddressID(id)
+		}
+		if isDefaultBilling {
+			defaultBillingID = user.AddressID(id)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return u, nil
+	return user.Reconstruct(
+		u.ID(),
+		u.Email(),
+		u.Password(),
+		u.Name(),
+		u.Locale(),
+		addresses,
+		defaultShippingID,
+		defaultBillingID,
+		u.PasswordResetTokenHash(),
+		u.PasswordResetExpiresAt(),
+		u.PasswordResetRequestedAt(),
+		u.EmailVerifiedAt(),
+		u.VerificationNonce(),
+		u.VerificationSentAt(),
+		u.CreatedAt(),
+		u.UpdatedAt(),
+	), nil
 }
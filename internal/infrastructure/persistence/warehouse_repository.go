@@ -0,0 +1,210 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/address"
+	"e-commerce/internal/domain/warehouse"
+	"errors"
+	"time"
+)
+
+// WarehouseRepository implements the warehouse.Repository interface
+type WarehouseRepository struct {
+	db *sql.DB
+}
+
+// NewWarehouseRepository creates a new WarehouseRepository
+func NewWarehouseRepository(db *sql.DB) *WarehouseRepository {
+	return &WarehouseRepository{
+		db: db,
+	}
+}
+
+const warehouseColumns = `id, code, name, line1, line2, city, postal_code, region, country, priority, created_at, updated_at`
+
+// Save persists a warehouse to the database
+func (r *WarehouseRepository) Save(ctx context.Context, w *warehouse.Warehouse) error {
+	query := `
+		INSERT INTO warehouses (` + warehouseColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		w.ID().String(),
+		w.Code().String(),
+		w.Name(),
+		w.Address().Line1(),
+		w.Address().Line2(),
+		w.Address().City(),
+		w.Address().PostalCode(),
+		w.Address().Region(),
+		w.Address().Country(),
+		w.Priority(),
+		w.CreatedAt(),
+		w.UpdatedAt(),
+	)
+
+	return err
+}
+
+// FindByID retrieves a warehouse by ID
+func (r *WarehouseRepository) FindByID(ctx context.Context, id warehouse.ID) (*warehouse.Warehouse, error) {
+	query := `
+		SELECT ` + warehouseColumns + `
+		FROM warehouses
+		WHERE id = $1
+	`
+
+	w, err := r.scanWarehouse(r.db.QueryRowContext(ctx, query, id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, warehouse.ErrWarehouseNotFound
+	}
+	return w, err
+}
+
+// FindByCode retrieves a warehouse by code
+func (r *WarehouseRepository) FindByCode(ctx context.Context, code warehouse.Code) (*warehouse.Warehouse, error) {
+	query := `
+		SELECT ` + warehouseColumns + `
+		FROM warehouses
+		WHERE code = $1
+	`
+
+	w, err := r.scanWarehouse(r.db.QueryRowContext(ctx, query, code.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, warehouse.ErrWarehouseNotFound
+	}
+	return w, err
+}
+
+// FindDefault retrieves the warehouse with the lowest priority
+func (r *WarehouseRepository) FindDefault(ctx context.Context) (*warehouse.Warehouse, error) {
+	query := `
+		SELECT ` + warehouseColumns + `
+		FROM warehouses
+		ORDER BY priority ASC, code ASC
+		LIMIT 1
+	`
+
+	w, err := r.scanWarehouse(r.db.QueryRowContext(ctx, query))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, warehouse.ErrNoWarehouse
+	}
+	return w, err
+}
+
+// Update updates an existing warehouse
+func (r *WarehouseRepository) Update(ctx context.Context, w *warehouse.Warehouse) error {
+	query := `
+		UPDATE warehouses
+		SET name = $1, line1 = $2, line2 = $3, city = $4, postal_code = $5, region = $6, country = $7,
+			priority = $8, updated_at = $9
+		WHERE id = $10
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		w.Name(),
+		w.Address().Line1(),
+		w.Address().Line2(),
+		w.Address().City(),
+		w.Address().PostalCode(),
+		w.Address().Region(),
+		w.Address().Country(),
+		w.Priority(),
+		w.UpdatedAt(),
+		w.ID().String(),
+	)
+
+	return err
+}
+
+// Delete removes a warehouse and its empty stock levels from the database
+func (r *WarehouseRepository) Delete(ctx context.Context, id warehouse.ID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_stock_levels WHERE warehouse_id = $1 AND quantity = 0`, id.String()); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM warehouses WHERE id = $1`, id.String()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// List retrieves all warehouses by priority
+func (r *WarehouseRepository) List(ctx context.Context) ([]*warehouse.Warehouse, error) {
+	query := `
+		SELECT ` + warehouseColumns + `
+		FROM warehouses
+		ORDER BY priority ASC, code ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	warehouses := []*warehouse.Warehouse{}
+	for rows.Next() {
+		w, err := r.scanWarehouse(rows)
+		if err != nil {
+			return nil, err
+		}
+		warehouses = append(warehouses, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return warehouses, nil
+}
+
+// HasStock checks if any product or variant has stock in a warehouse
+func (r *WarehouseRepository) HasStock(ctx context.Context, id warehouse.ID) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM product_stock_levels WHERE warehouse_id = $1 AND quantity > 0)
+	`
+
+	var hasStock bool
+	if err := r.db.QueryRowContext(ctx, query, id.String()).Scan(&hasStock); err != nil {
+		return false, err
+	}
+
+	return hasStock, nil
+}
+
+// scanWarehouse scans a warehouse from a row; the warehouse name doubles as the name on its address
+func (r *WarehouseRepository) scanWarehouse(row rowScanner) (*warehouse.Warehouse, error) {
+	var id, code, name, line1, line2, city, postalCode, region, country string
+	var priority int
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(
+		&id, &code, &name, &line1, &line2, &city, &postalCode, &region, &country, &priority, &createdAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return warehouse.Reconstruct(
+		warehouse.ID(id),
+		warehouse.Code(code),
+		name,
+		address.Reconstruct(name, line1, line2, city, postalCode, region, country),
+		priority,
+		createdAt,
+		updatedAt,
+	), nil
+}
@@ -0,0 +1,298 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"e-commerce/internal/domain/product"
+	"e-commerce/internal/domain/user"
+	"e-commerce/internal/domain/wishlist"
+	"errors"
+	"time"
+)
+
+// WishlistRepository implements the wishlist.Repository interface
+type WishlistRepository struct {
+	db *sql.DB
+}
+
+// NewWishlistRepository creates a new WishlistRepository
+func NewWishlistRepository(db *sql.DB) *WishlistRepository {
+	return &WishlistRepository{
+		db: db,
+	}
+}
+
+// Save persists a wishlist with its lists and items to the database
+func (r *WishlistRepository) Save(ctx context.Context, w *wishlist.Wishlist) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO wishlists (id, user_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	if _, err := tx.ExecContext(ctx, query, w.ID().String(), w.UserID().String(), w.CreatedAt(), w.UpdatedAt()); err != nil {
+		return err
+	}
+
+	if err := r.insertLists(ctx, tx, w); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindByUserID retrieves the wishlist of a user
+func (r *WishlistRepository) FindByUserID(ctx context.Context, userID user.ID) (*wishlist.Wishlist, error) {
+	query := `
+		SELECT id, user_id, created_at, updated_at
+		FROM wishlists
+		WHERE user_id = $1
+	`
+
+	return r.findOne(ctx, query, userID.String())
+}
+
+// FindByShareToken retrieves the wishlist holding the list shared under a token
+func (r *WishlistRepository) FindByShareToken(ctx context.Context, token wishlist.ShareToken) (*wishlist.Wishlist, error) {
+	query := `
+		SELECT w.id, w.user_id, w.created_at, w.updated_at
+		FROM wishlists w
+		JOIN wishlist_lists l ON l.wishlist_id = w.id
+		WHERE l.share_token = $1
+	`
+
+	return r.findOne(ctx, query, token.String())
+}
+
+// FindByProduct retrieves the wishlists holding a product or any of its variants
+func (r *WishlistRepository) FindByProduct(ctx context.Context, productID product.ID) ([]*wishlist.Wishlist, error) {
+	query := `
+		SELECT w.id, w.user_id, w.created_at, w.updated_at
+		FROM wishlists w
+		WHERE EXISTS (
+			SELECT 1
+			FROM wishlist_lists l
+			JOIN wishlist_items i ON i.list_id = l.id
+			WHERE l.wishlist_id = w.id AND i.product_id = $1
+		)
+		ORDER BY w.created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, productID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	wishlists := []*wishlist.Wishlist{}
+	for rows.Next() {
+		w, err := r.scanWishlist(rows)
+		if err != nil {
+			return nil, err
+		}
+		wishlists = append(wishlists, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Load the lists once the rows are closed
+	for i, w := range wishlists {
+		if wishlists[i], err = r.withLists(ctx, w); err != nil {
+			return nil, err
+		}
+	}
+
+	return wishlists, nil
+}
+
+// Update updates an existing wishlist, replacing its lists and items
+func (r *WishlistRepository) Update(ctx context.Context, w *wishlist.Wishlist) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE wishlists SET updated_at = $1 WHERE id = $2`, w.UpdatedAt(), w.ID().String()); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM wishlist_lists WHERE wishlist_id = $1`, w.ID().String()); err != nil {
+		return err
+	}
+
+	if err := r.insertLists(ctx, tx, w); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertLists inserts the lists of a wishlist with their items
+func (r *WishlistRepository) insertLists(ctx context.Context, tx *sql.Tx, w *wishlist.Wishlist) error {
+	listQuery := `
+		INSERT INTO wishlist_lists (id, wishlist_id, name, share_token, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	itemQuery := `
+		INSERT INTO wishlist_items (list_id, product_id, variant_id, added_price, added_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	for _, l := range w.Lists() {
+		if _, err := tx.ExecContext(
+			ctx,
+			listQuery,
+			l.ID().String(),
+			w.ID().String(),
+			l.Name().String(),
+			nullString(l.ShareToken().String()),
+			l.CreatedAt(),
+			l.UpdatedAt(),
+		); err != nil {
+			return err
+		}
+
+		for _, item := range l.Items() {
+			if _, err := tx.ExecContext(
+				ctx,
+				itemQuery,
+				l.ID().String(),
+				item.ProductID().String(),
+				item.VariantID().String(),
+				item.AddedPrice().Value(),
+				item.AddedAt(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// findOne retrieves a single wishlist with its lists
+func (r *WishlistRepository) findOne(ctx context.Context, query string, args ...interface{}) (*wishlist.Wishlist, error) {
+	w, err := r.scanWishlist(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, wishlist.ErrWishlistNotFound
+		}
+		return nil, err
+	}
+
+	return r.withLists(ctx, w)
+}
+
+// scanWishlist scans a wishlist without its lists from a row
+func (r *WishlistRepository) scanWishlist(row rowScanner) (*wishlist.Wishlist, error) {
+	var id, userID string
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(&id, &userID, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	return wishlist.Reconstruct(wishlist.ID(id), user.ID(userID), nil, createdAt, updatedAt), nil
+}
+
+// withLists loads the lists of a wishlist with their items
+func (r *WishlistRepository) withLists(ctx context.Context, w *wishlist.Wishlist) (*wishlist.Wishlist, error) {
+	items, err := r.findItems(ctx, w.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, name, share_token, created_at, updated_at
+		FROM wishlist_lists
+		WHERE wishlist_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, w.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lists := []*wishlist.List{}
+	for rows.Next() {
+		var id, name string
+		var shareToken sql.NullString
+		var createdAt, updatedAt time.Time
+
+		if err := rows.Scan(&id, &name, &shareToken, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+
+		listItems := items[id]
+		if listItems == nil {
+			listItems = []*wishlist.Item{}
+		}
+
+		lists = append(lists, wishlist.ReconstructList(
+			wishlist.ListID(id),
+			wishlist.ListName(name),
+			wishlist.ShareToken(shareToken.String),
+			listItems,
+			createdAt,
+			updatedAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return wishlist.Reconstruct(w.ID(), w.UserID(), lists, w.CreatedAt(), w.UpdatedAt()), nil
+}
+
+// findItems retrieves the items of the lists of a wishlist, keyed by list ID
+func (r *WishlistRepository) findItems(ctx context.Context, wishlistID wishlist.ID) (map[string][]*wishlist.Item, error) {
+	query := `
+		SELECT i.list_id, i.product_id, i.variant_id, i.added_price, i.added_at
+		FROM wishlist_items i
+		JOIN wishlist_lists l ON l.id = i.list_id
+		WHERE l.wishlist_id = $1
+		ORDER BY i.added_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, wishlistID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := map[string][]*wishlist.Item{}
+	for rows.Next() {
+		var listID, productID, variantID string
+		var addedPrice float64
+		var addedAt time.Time
+
+		if err := rows.Scan(&listID, &productID, &variantID, &addedPrice, &addedAt); err != nil {
+			return nil, err
+		}
+
+		items[listID] = append(items[listID], wishlist.ReconstructItem(
+			product.ID(productID),
+			product.VariantID(variantID),
+			product.Price(addedPrice),
+			addedAt,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,130 @@
+package searchindex
+
+import (
+	"strings"
+	"unicode"
+)
+
+// englishStopWords are the words left out of English documents and queries
+var englishStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"by": true, "for": true, "from": true, "in": true, "is": true, "it": true, "of": true,
+	"on": true, "or": true, "the": true, "to": true, "with": true,
+}
+
+// tokenize splits text into lowercased words of letters and digits
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), isSeparator)
+}
+
+// isSeparator reports whether a rune separates words
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// analyze turns text into index terms. English words are stemmed and stop words are
+// dropped; words in other languages are matched whole.
+func analyze(text, language string) []string {
+	words := tokenize(text)
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if term, ok := analyzeWord(word, language); ok {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// analyzeWord turns a lowercased word into its index term, reporting false for stop words
+func analyzeWord(word, language string) (string, bool) {
+	if language != "english" {
+		return word, true
+	}
+	if englishStopWords[word] {
+		return "", false
+	}
+	return stemEnglish(word), true
+}
+
+// stemEnglish strips common English inflections, so "boxes" and "box" or
+// "running" and "run" share a term
+func stemEnglish(word string) string {
+	n := len(word)
+	switch {
+	case n > 4 && strings.HasSuffix(word, "ies"):
+		return word[:n-3] + "y"
+	case n > 4 && strings.HasSuffix(word, "sses"):
+		return word[:n-2]
+	case n > 3 && (strings.HasSuffix(word, "xes") || strings.HasSuffix(word, "ches") || strings.HasSuffix(word, "shes")):
+		return word[:n-2]
+	case n > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") &&
+		!strings.HasSuffix(word, "us") && !strings.HasSuffix(word, "is"):
+		return word[:n-1]
+	case n > 5 && strings.HasSuffix(word, "ing"):
+		return undouble(word[:n-3])
+	case n > 4 && strings.HasSuffix(word, "ed"):
+		return undouble(word[:n-2])
+	}
+	return word
+}
+
+// undouble removes a doubled final consonant left by stripping a suffix, as in "running"
+func undouble(stem string) string {
+	n := len(stem)
+	if n > 2 && stem[n-1] == stem[n-2] && !strings.ContainsRune("aeiouls", rune(stem[n-1])) {
+		return stem[:n-1]
+	}
+	return stem
+}
+
+// maxEdits is the number of typos tolerated in a query term of the given length
+func maxEdits(term string) int {
+	switch n := len([]rune(term)); {
+	case n >= 8:
+		return 2
+	case n >= 4:
+		return 1
+	}
+	return 0
+}
+
+// editDistance returns the Levenshtein distance between two terms, or max+1 once it exceeds max
+func editDistance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > max {
+		return max + 1
+	}
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		rowMin := current[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			rowMin = min(rowMin, current[j])
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
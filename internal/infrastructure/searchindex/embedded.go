@@ -0,0 +1,448 @@
+package searchindex
+
+import (
+	"context"
+	"e-commerce/internal/application/product/search"
+	"e-commerce/internal/domain/product"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"html"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Field weights of the terms of a document
+const (
+	nameWeight        = 3.0
+	keywordWeight     = 2.0
+	descriptionWeight = 1.0
+)
+
+// fuzzyFactor scales the score of terms matched with typos
+const fuzzyFactor = 0.5
+
+// snippetWords is the length of description snippets in words
+const snippetWords = 35
+
+// EmbeddedIndex implements the search.SearchIndex interface with an in-memory inverted index
+// saved to a file, so search runs in the API process without touching the database.
+// Writes only change the index in memory; Save writes the file in the background.
+type EmbeddedIndex struct {
+	mu        sync.RWMutex
+	saveMu    sync.Mutex
+	path      string
+	documents map[string]search.Document
+	postings  map[string]map[string]float64 // term -> document ID -> weighted term frequency
+	words     map[string]map[string]bool    // lowercased name word -> document IDs
+	changes   uint64                        // number of writes that changed the index
+	saved     uint64                        // number of changes written to the file
+}
+
+// NewEmbeddedIndex opens the index saved at path, starting empty when there is none
+func NewEmbeddedIndex(path string) (*EmbeddedIndex, error) {
+	i := &EmbeddedIndex{
+		path:      path,
+		documents: make(map[string]search.Document),
+		postings:  make(map[string]map[string]float64),
+		words:     make(map[string]map[string]bool),
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return i, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open search index: %w", err)
+	}
+	defer file.Close()
+
+	var documents []search.Document
+	if err := gob.NewDecoder(file).Decode(&documents); err != nil {
+		return nil, fmt.Errorf("failed to read search index: %w", err)
+	}
+
+	for _, document := range documents {
+		i.add(document)
+	}
+
+	return i, nil
+}
+
+// Len returns the number of indexed documents
+func (i *EmbeddedIndex) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return len(i.documents)
+}
+
+// Index adds or replaces documents in the index; documents with unchanged content are skipped
+func (i *EmbeddedIndex) Index(ctx context.Context, documents ...search.Document) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, document := range documents {
+		if current, ok := i.documents[document.ID]; ok && current.Equal(document) {
+			continue
+		}
+		i.remove(document.ID)
+		i.add(document)
+		i.changes++
+	}
+
+	return nil
+}
+
+// Remove removes the documents of products from the index
+func (i *EmbeddedIndex) Remove(ctx context.Context, productIDs ...string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, id := range productIDs {
+		if _, ok := i.documents[id]; !ok {
+			continue
+		}
+		i.remove(id)
+		i.changes++
+	}
+
+	return nil
+}
+
+// Clear removes every document from the index
+func (i *EmbeddedIndex) Clear(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.documents = make(map[string]search.Document)
+	i.postings = make(map[string]map[string]float64)
+	i.words = make(map[string]map[string]bool)
+	i.changes++
+
+	return nil
+}
+
+// Save writes the index to its file when it changed since the last save. It reports whether it wrote the file.
+func (i *EmbeddedIndex) Save() (bool, error) {
+	i.saveMu.Lock()
+	defer i.saveMu.Unlock()
+
+	// Copy the documents, so searches and writes are not blocked while the file is written
+	i.mu.RLock()
+	changes := i.changes
+	if changes == i.saved {
+		i.mu.RUnlock()
+		return false, nil
+	}
+	documents := make([]search.Document, 0, len(i.documents))
+	for _, document := range i.documents {
+		documents = append(documents, document)
+	}
+	i.mu.RUnlock()
+
+	if err := i.write(documents); err != nil {
+		return false, fmt.Errorf("failed to save search index: %w", err)
+	}
+
+	i.mu.Lock()
+	i.saved = changes
+	i.mu.Unlock()
+
+	return true, nil
+}
+
+// Search searches the documents published by now. Every query word must match a term of the document, either
+// exactly or, when no document has it, with a few typos. Exact matches rank first.
+func (i *EmbeddedIndex) Search(ctx context.Context, query product.SearchQuery, limit, offset int) ([]search.Hit, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	queryTerms := analyze(query.Text, query.Language.String())
+	if len(queryTerms) == 0 {
+		return []search.Hit{}, nil
+	}
+
+	type match struct {
+		score float64
+		fuzzy bool
+	}
+
+	scores := make(map[string]float64)
+	fuzzy := make(map[string]bool)
+	matchedQueryTerms := make(map[string]int)
+	matchedTerms := make(map[string]bool)
+
+	for _, queryTerm := range queryTerms {
+		best := make(map[string]match)
+		for term, factor := range i.expand(queryTerm) {
+			matchedTerms[term] = true
+
+			postings := i.postings[term]
+			idf := math.Log(1 + float64(len(i.documents))/float64(len(postings)))
+			for id, frequency := range postings {
+				score := idf * frequency / (frequency + 1.2) * factor
+				if current, ok := best[id]; !ok || score > current.score {
+					best[id] = match{score: score, fuzzy: factor < 1}
+				}
+			}
+		}
+
+		for id, m := range best {
+			scores[id] += m.score
+			matchedQueryTerms[id]++
+			if m.fuzzy {
+				fuzzy[id] = true
+			}
+		}
+	}
+
+	now := time.Now()
+
+	var hits []search.Hit
+	for id, count := range matchedQueryTerms {
+		document := i.documents[id]
+		if count < len(queryTerms) || document.PublishedAt.After(now) {
+			continue
+		}
+
+		hits = append(hits, search.Hit{
+			ProductID:     id,
+			Rank:          scores[id] / (scores[id] + 1),
+			Fuzzy:         fuzzy[id],
+			NameHighlight: highlight(strings.Fields(document.Name), document.Language, matchedTerms),
+			Snippet:       snippet(strings.Fields(document.Description), document.Language, matchedTerms),
+		})
+	}
+
+	sort.Slice(hits, func(a, b int) bool {
+		if hits[a].Fuzzy != hits[b].Fuzzy {
+			return !hits[a].Fuzzy
+		}
+		if hits[a].Rank != hits[b].Rank {
+			return hits[a].Rank > hits[b].Rank
+		}
+		return i.documents[hits[a].ProductID].Name < i.documents[hits[b].ProductID].Name
+	})
+
+	if offset >= len(hits) {
+		return []search.Hit{}, nil
+	}
+	hits = hits[offset:]
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	return hits, nil
+}
+
+// Suggest returns the names of products published by now with a word starting with the last word of the prefix
+// and containing its other words, names starting with the prefix first
+func (i *EmbeddedIndex) Suggest(ctx context.Context, prefix string, limit int) ([]search.Suggestion, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	words := tokenize(prefix)
+	if len(words) == 0 {
+		return []search.Suggestion{}, nil
+	}
+	last, complete := words[len(words)-1], words[:len(words)-1]
+
+	candidates := make(map[string]bool)
+	for word, ids := range i.words {
+		if strings.HasPrefix(word, last) {
+			for id := range ids {
+				candidates[id] = true
+			}
+		}
+	}
+
+	now := time.Now()
+
+	var suggestions []search.Suggestion
+	for id := range candidates {
+		document := i.documents[id]
+		if !document.PublishedAt.After(now) && containsWords(tokenize(document.Name), complete) {
+			suggestions = append(suggestions, search.Suggestion{ProductID: id, Text: document.Name})
+		}
+	}
+
+	lowerPrefix := strings.ToLower(strings.TrimSpace(prefix))
+	sort.Slice(suggestions, func(a, b int) bool {
+		startsA := strings.HasPrefix(strings.ToLower(suggestions[a].Text), lowerPrefix)
+		startsB := strings.HasPrefix(strings.ToLower(suggestions[b].Text), lowerPrefix)
+		if startsA != startsB {
+			return startsA
+		}
+		if len(suggestions[a].Text) != len(suggestions[b].Text) {
+			return len(suggestions[a].Text) < len(suggestions[b].Text)
+		}
+		return suggestions[a].Text < suggestions[b].Text
+	})
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions, nil
+}
+
+// expand returns the index terms a query term matches with their score factor: the term
+// itself when indexed, otherwise the indexed terms within its typo tolerance
+func (i *EmbeddedIndex) expand(queryTerm string) map[string]float64 {
+	if _, ok := i.postings[queryTerm]; ok {
+		return map[string]float64{queryTerm: 1}
+	}
+
+	terms := make(map[string]float64)
+	if edits := maxEdits(queryTerm); edits > 0 {
+		for term := range i.postings {
+			if editDistance(queryTerm, term, edits) <= edits {
+				terms[term] = fuzzyFactor
+			}
+		}
+	}
+	return terms
+}
+
+// add indexes a document; the caller holds the write lock
+func (i *EmbeddedIndex) add(document search.Document) {
+	i.documents[document.ID] = document
+
+	frequencies := make(map[string]float64)
+	for _, term := range analyze(document.Name, document.Language) {
+		frequencies[term] += nameWeight
+	}
+	for _, keyword := range document.Keywords {
+		for _, term := range analyze(keyword, document.Language) {
+			frequencies[term] += keywordWeight
+		}
+	}
+	for _, term := range analyze(document.Description, document.Language) {
+		frequencies[term] += descriptionWeight
+	}
+
+	for term, frequency := range frequencies {
+		if i.postings[term] == nil {
+			i.postings[term] = make(map[string]float64)
+		}
+		i.postings[term][document.ID] = frequency
+	}
+
+	for _, word := range tokenize(document.Name) {
+		if i.words[word] == nil {
+			i.words[word] = make(map[string]bool)
+		}
+		i.words[word][document.ID] = true
+	}
+}
+
+// remove unindexes a document; the caller holds the write lock
+func (i *EmbeddedIndex) remove(id string) {
+	document, ok := i.documents[id]
+	if !ok {
+		return
+	}
+	delete(i.documents, id)
+
+	text := document.Name + " " + document.Description + " " + strings.Join(document.Keywords, " ")
+	for _, term := range analyze(text, document.Language) {
+		delete(i.postings[term], id)
+		if len(i.postings[term]) == 0 {
+			delete(i.postings, term)
+		}
+	}
+
+	for _, word := range tokenize(document.Name) {
+		delete(i.words[word], id)
+		if len(i.words[word]) == 0 {
+			delete(i.words, word)
+		}
+	}
+}
+
+// write writes the documents to the index file, replacing it atomically
+func (i *EmbeddedIndex) write(documents []search.Document) error {
+	if err := os.MkdirAll(filepath.Dir(i.path), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(i.path), filepath.Base(i.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if err := gob.NewEncoder(file).Encode(documents); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), i.path)
+}
+
+// highlight joins HTML-escaped words, wrapping those matching one of the terms in <mark> tags
+func highlight(words []string, language string, terms map[string]bool) string {
+	marked := make([]string, len(words))
+	for j, word := range words {
+		if matchesTerms(word, language, terms) {
+			core := strings.TrimFunc(word, isSeparator)
+			start := strings.Index(word, core)
+			marked[j] = html.EscapeString(word[:start]) + "<mark>" + html.EscapeString(core) + "</mark>" +
+				html.EscapeString(word[start+len(core):])
+		} else {
+			marked[j] = html.EscapeString(word)
+		}
+	}
+	return strings.Join(marked, " ")
+}
+
+// snippet returns an excerpt of the words around the first one matching the terms, highlighted
+func snippet(words []string, language string, terms map[string]bool) string {
+	start := 0
+	for j, word := range words {
+		if matchesTerms(word, language, terms) {
+			start = max(0, j-snippetWords/3)
+			break
+		}
+	}
+
+	end := min(len(words), start+snippetWords)
+	return highlight(words[start:end], language, terms)
+}
+
+// matchesTerms reports whether a word of a document analyzes to one of the terms
+func matchesTerms(word, language string, terms map[string]bool) bool {
+	for _, token := range tokenize(word) {
+		if term, ok := analyzeWord(token, language); ok && terms[term] {
+			return true
+		}
+	}
+	return false
+}
+
+// containsWords reports whether every wanted word is one of the words
+func containsWords(words, wanted []string) bool {
+	for _, w := range wanted {
+		found := false
+		for _, word := range words {
+			if word == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,17 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_shipping_rates_zone_id;
+
+-- Drop columns
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS shipping_cost,
+    DROP COLUMN IF EXISTS shipping_method;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS width,
+    DROP COLUMN IF EXISTS length,
+    DROP COLUMN IF EXISTS weight;
+
+-- Drop tables
+DROP TABLE IF EXISTS shipping_rates;
+DROP TABLE IF EXISTS shipping_zones;
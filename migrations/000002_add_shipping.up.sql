@@ -0,0 +1,36 @@
+-- Add shipping profile to products
+ALTER TABLE products
+    ADD COLUMN weight DECIMAL(10, 3) NOT NULL DEFAULT 0,
+    ADD COLUMN length DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN width DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN height DECIMAL(10, 2) NOT NULL DEFAULT 0;
+
+-- Create shipping_zones table
+CREATE TABLE IF NOT EXISTS shipping_zones (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    countries TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- Create shipping_rates table
+CREATE TABLE IF NOT EXISTS shipping_rates (
+    id VARCHAR(36) PRIMARY KEY,
+    zone_id VARCHAR(36) NOT NULL,
+    method VARCHAR(50) NOT NULL,
+    rate_type VARCHAR(50) NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    free_threshold DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    tiers JSONB NOT NULL DEFAULT '[]',
+    FOREIGN KEY (zone_id) REFERENCES shipping_zones(id) ON DELETE CASCADE,
+    UNIQUE (zone_id, method)
+);
+
+-- Add chosen shipping to orders
+ALTER TABLE orders
+    ADD COLUMN shipping_method VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN shipping_cost DECIMAL(10, 2) NOT NULL DEFAULT 0;
+
+-- Create indexes
+CREATE INDEX idx_shipping_rates_zone_id ON shipping_rates(zone_id);
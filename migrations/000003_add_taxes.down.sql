@@ -0,0 +1,11 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_order_item_taxes_order_item_id;
+DROP INDEX IF EXISTS idx_tax_rules_country;
+
+-- Drop tables
+DROP TABLE IF EXISTS order_item_taxes;
+DROP TABLE IF EXISTS tax_rules;
+
+-- Drop columns
+ALTER TABLE products
+    DROP COLUMN IF EXISTS tax_category;
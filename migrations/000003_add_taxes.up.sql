@@ -0,0 +1,31 @@
+-- Add tax category to products
+ALTER TABLE products
+    ADD COLUMN tax_category VARCHAR(50) NOT NULL DEFAULT 'standard';
+
+-- Create tax_rules table
+CREATE TABLE IF NOT EXISTS tax_rules (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    country CHAR(2) NOT NULL,
+    region VARCHAR(10) NOT NULL DEFAULT '',
+    category VARCHAR(50) NOT NULL,
+    rate DECIMAL(6, 4) NOT NULL,
+    inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- Create order_item_taxes table
+CREATE TABLE IF NOT EXISTS order_item_taxes (
+    id SERIAL PRIMARY KEY,
+    order_item_id VARCHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate DECIMAL(6, 4) NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_tax_rules_country ON tax_rules(country);
+CREATE INDEX idx_order_item_taxes_order_item_id ON order_item_taxes(order_item_id);
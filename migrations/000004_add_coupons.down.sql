@@ -0,0 +1,15 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_order_discounts_order_id;
+DROP INDEX IF EXISTS idx_coupon_redemptions_coupon_user;
+
+-- Drop tables
+DROP TABLE IF EXISTS order_discounts;
+DROP TABLE IF EXISTS coupon_redemptions;
+DROP TABLE IF EXISTS coupons;
+
+-- Drop columns
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS discount;
+
+ALTER TABLE carts
+    DROP COLUMN IF EXISTS coupon_code;
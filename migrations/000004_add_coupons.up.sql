@@ -0,0 +1,54 @@
+-- Create coupons table
+CREATE TABLE IF NOT EXISTS coupons (
+    id VARCHAR(36) PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    type VARCHAR(20) NOT NULL,
+    value DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    buy_quantity INT NOT NULL DEFAULT 0,
+    get_quantity INT NOT NULL DEFAULT 0,
+    min_order_value DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    valid_from TIMESTAMP,
+    valid_until TIMESTAMP,
+    usage_limit INT NOT NULL DEFAULT 0,
+    per_user_limit INT NOT NULL DEFAULT 0,
+    times_used INT NOT NULL DEFAULT 0,
+    product_ids TEXT[] NOT NULL DEFAULT '{}',
+    category_ids TEXT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- Create coupon_redemptions table
+CREATE TABLE IF NOT EXISTS coupon_redemptions (
+    id SERIAL PRIMARY KEY,
+    coupon_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    order_id VARCHAR(36) NOT NULL,
+    redeemed_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (coupon_id) REFERENCES coupons(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+-- Add applied coupon to carts
+ALTER TABLE carts
+    ADD COLUMN coupon_code VARCHAR(50);
+
+-- Add line discount to order items
+ALTER TABLE order_items
+    ADD COLUMN discount DECIMAL(10, 2) NOT NULL DEFAULT 0;
+
+-- Create order_discounts table
+CREATE TABLE IF NOT EXISTS order_discounts (
+    id SERIAL PRIMARY KEY,
+    order_id VARCHAR(36) NOT NULL,
+    code VARCHAR(50) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL,
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_coupon_redemptions_coupon_user ON coupon_redemptions(coupon_id, user_id);
+CREATE INDEX idx_order_discounts_order_id ON order_discounts(order_id);
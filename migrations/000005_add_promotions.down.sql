@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_promotions_active;
+
+-- Drop tables
+DROP TABLE IF EXISTS promotions;
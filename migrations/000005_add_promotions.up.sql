@@ -0,0 +1,22 @@
+-- Create promotions table
+CREATE TABLE IF NOT EXISTS promotions (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    type VARCHAR(20) NOT NULL,
+    value DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    tiers JSONB NOT NULL DEFAULT '[]',
+    priority INT NOT NULL DEFAULT 0,
+    stackable BOOLEAN NOT NULL DEFAULT TRUE,
+    min_order_value DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    valid_from TIMESTAMP,
+    valid_until TIMESTAMP,
+    product_ids TEXT[] NOT NULL DEFAULT '{}',
+    category_ids TEXT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- Create indexes
+CREATE INDEX idx_promotions_active ON promotions(active);
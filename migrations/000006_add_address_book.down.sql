@@ -0,0 +1,16 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_user_addresses_user_id;
+
+-- Restore free-text order addresses
+ALTER TABLE orders
+    ALTER COLUMN shipping_address TYPE TEXT USING concat_ws(', ',
+        NULLIF(shipping_address->>'name', ''), shipping_address->>'line1', NULLIF(shipping_address->>'line2', ''),
+        NULLIF(shipping_address->>'postal_code', ''), NULLIF(shipping_address->>'city', ''),
+        NULLIF(shipping_address->>'region', ''), NULLIF(shipping_address->>'country', '')),
+    ALTER COLUMN billing_address TYPE TEXT USING concat_ws(', ',
+        NULLIF(billing_address->>'name', ''), billing_address->>'line1', NULLIF(billing_address->>'line2', ''),
+        NULLIF(billing_address->>'postal_code', ''), NULLIF(billing_address->>'city', ''),
+        NULLIF(billing_address->>'region', ''), NULLIF(billing_address->>'country', ''));
+
+-- Drop tables
+DROP TABLE IF EXISTS user_addresses;
@@ -0,0 +1,26 @@
+-- Create user_addresses table
+CREATE TABLE IF NOT EXISTS user_addresses (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    label VARCHAR(100) NOT NULL DEFAULT '',
+    name VARCHAR(255) NOT NULL,
+    line1 VARCHAR(255) NOT NULL,
+    line2 VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL DEFAULT '',
+    region VARCHAR(10) NOT NULL DEFAULT '',
+    country CHAR(2) NOT NULL,
+    is_default_shipping BOOLEAN NOT NULL DEFAULT FALSE,
+    is_default_billing BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Store order addresses as structured snapshots; legacy free-text addresses keep their text as the first line
+ALTER TABLE orders
+    ALTER COLUMN shipping_address TYPE JSONB USING jsonb_build_object('line1', shipping_address),
+    ALTER COLUMN billing_address TYPE JSONB USING jsonb_build_object('line1', billing_address);
+
+-- Create indexes
+CREATE INDEX idx_user_addresses_user_id ON user_addresses(user_id);
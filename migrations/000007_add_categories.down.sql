@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_product_categories_category_id;
+DROP INDEX IF EXISTS idx_categories_parent_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS product_categories;
+DROP TABLE IF EXISTS categories;
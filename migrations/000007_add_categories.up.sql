@@ -0,0 +1,24 @@
+-- Create categories table
+CREATE TABLE IF NOT EXISTS categories (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    parent_id VARCHAR(36),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (parent_id) REFERENCES categories(id) ON DELETE RESTRICT
+);
+
+-- Create product_categories table
+CREATE TABLE IF NOT EXISTS product_categories (
+    product_id VARCHAR(36) NOT NULL,
+    category_id VARCHAR(36) NOT NULL,
+    PRIMARY KEY (product_id, category_id),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_categories_parent_id ON categories(parent_id);
+CREATE INDEX idx_product_categories_category_id ON product_categories(category_id);
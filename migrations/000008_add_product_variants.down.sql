@@ -0,0 +1,21 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_product_variants_product_id;
+
+-- Remove variant references from cart and order items
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS sku,
+    DROP COLUMN IF EXISTS variant_id;
+
+DELETE FROM cart_items WHERE variant_id <> '';
+
+ALTER TABLE cart_items
+    DROP CONSTRAINT IF EXISTS cart_items_cart_id_product_id_variant_id_key,
+    DROP COLUMN IF EXISTS variant_id,
+    ADD CONSTRAINT cart_items_cart_id_product_id_key UNIQUE (cart_id, product_id);
+
+-- Drop tables
+DROP TABLE IF EXISTS product_variants;
+
+-- Remove option types from products
+ALTER TABLE products
+    DROP COLUMN IF EXISTS options;
@@ -0,0 +1,29 @@
+-- Add option types to products
+ALTER TABLE products
+    ADD COLUMN options JSONB NOT NULL DEFAULT '[]';
+
+-- Create product_variants table
+CREATE TABLE IF NOT EXISTS product_variants (
+    id VARCHAR(36) PRIMARY KEY,
+    product_id VARCHAR(36) NOT NULL,
+    sku VARCHAR(64) NOT NULL UNIQUE,
+    option_values JSONB NOT NULL DEFAULT '{}',
+    price DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    stock INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Reference variants from cart and order items; an empty variant ID means the product itself
+ALTER TABLE cart_items
+    ADD COLUMN variant_id VARCHAR(36) NOT NULL DEFAULT '',
+    DROP CONSTRAINT IF EXISTS cart_items_cart_id_product_id_key,
+    ADD CONSTRAINT cart_items_cart_id_product_id_variant_id_key UNIQUE (cart_id, product_id, variant_id);
+
+ALTER TABLE order_items
+    ADD COLUMN variant_id VARCHAR(36) NOT NULL DEFAULT '',
+    ADD COLUMN sku VARCHAR(64) NOT NULL DEFAULT '';
+
+-- Create indexes
+CREATE INDEX idx_product_variants_product_id ON product_variants(product_id);
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_products_price;
+DROP INDEX IF EXISTS idx_product_attributes_name_value;
+
+-- Drop tables
+DROP TABLE IF EXISTS product_attributes;
@@ -0,0 +1,13 @@
+-- Create product_attributes table
+CREATE TABLE IF NOT EXISTS product_attributes (
+    product_id VARCHAR(36) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (product_id, name),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_product_attributes_name_value ON product_attributes(name, value);
+CREATE INDEX idx_products_price ON products(price);
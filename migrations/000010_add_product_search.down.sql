@@ -0,0 +1,12 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_products_name_trgm;
+DROP INDEX IF EXISTS idx_products_search_vector;
+
+-- Drop the search vector trigger
+DROP TRIGGER IF EXISTS products_search_vector_trigger ON products;
+DROP FUNCTION IF EXISTS products_search_vector_update();
+
+-- Drop columns
+ALTER TABLE products
+    DROP COLUMN IF EXISTS search_vector,
+    DROP COLUMN IF EXISTS language;
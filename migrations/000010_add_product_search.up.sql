@@ -0,0 +1,30 @@
+-- Enable trigram matching for typo-tolerant search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Add the product language and its weighted search vector
+ALTER TABLE products
+    ADD COLUMN language VARCHAR(20) NOT NULL DEFAULT 'english',
+    ADD COLUMN search_vector TSVECTOR;
+
+-- Keep the search vector in sync, stemming the name (weight A) and description (weight B) by the product language
+CREATE OR REPLACE FUNCTION products_search_vector_update() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector(NEW.language::regconfig, COALESCE(NEW.name, '')), 'A') ||
+        setweight(to_tsvector(NEW.language::regconfig, COALESCE(NEW.description, '')), 'B');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER products_search_vector_trigger
+    BEFORE INSERT OR UPDATE OF name, description, language ON products
+    FOR EACH ROW EXECUTE FUNCTION products_search_vector_update();
+
+-- Index existing products
+UPDATE products SET search_vector =
+    setweight(to_tsvector(language::regconfig, COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector(language::regconfig, COALESCE(description, '')), 'B');
+
+-- Create indexes
+CREATE INDEX idx_products_search_vector ON products USING GIN (search_vector);
+CREATE INDEX idx_products_name_trgm ON products USING GIN (name gin_trgm_ops);
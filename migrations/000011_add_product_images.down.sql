@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_product_images_product_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS product_images;
@@ -0,0 +1,13 @@
+-- Create product_images table; renditions holds the stored sizes of each image
+CREATE TABLE IF NOT EXISTS product_images (
+    id VARCHAR(36) PRIMARY KEY,
+    product_id VARCHAR(36) NOT NULL,
+    alt_text VARCHAR(250) NOT NULL DEFAULT '',
+    position INT NOT NULL DEFAULT 0,
+    renditions JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_product_images_product_id ON product_images(product_id, position);
@@ -0,0 +1,12 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_products_status_published_at;
+
+-- Restore the cascading order item reference
+ALTER TABLE order_items
+    DROP CONSTRAINT IF EXISTS order_items_product_id_fkey,
+    ADD CONSTRAINT order_items_product_id_fkey FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+
+-- Remove the product lifecycle
+ALTER TABLE products
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS status;
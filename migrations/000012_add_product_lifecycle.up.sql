@@ -0,0 +1,16 @@
+-- Add the product lifecycle; new products start as drafts
+ALTER TABLE products
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'published', 'archived')),
+    ADD COLUMN published_at TIMESTAMP;
+
+-- Keep the existing catalog live
+UPDATE products SET status = 'published', published_at = created_at;
+
+-- Products are archived instead of deleted; protect the order history from deletes that slip through
+ALTER TABLE order_items
+    DROP CONSTRAINT IF EXISTS order_items_product_id_fkey,
+    ADD CONSTRAINT order_items_product_id_fkey FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE RESTRICT;
+
+-- Create indexes
+CREATE INDEX idx_products_status_published_at ON products(status, published_at);
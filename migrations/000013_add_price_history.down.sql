@@ -0,0 +1,13 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_product_price_schedules_ends_at;
+DROP INDEX IF EXISTS idx_product_price_schedules_starts_at;
+DROP INDEX IF EXISTS idx_product_price_schedules_product_id;
+DROP INDEX IF EXISTS idx_product_price_history_product_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS product_price_schedules;
+DROP TABLE IF EXISTS product_price_history;
+
+-- Remove the compare-at price
+ALTER TABLE products
+    DROP COLUMN IF EXISTS compare_at_price;
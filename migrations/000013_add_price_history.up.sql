@@ -0,0 +1,35 @@
+-- Add the compare-at price shown during sales
+ALTER TABLE products
+    ADD COLUMN compare_at_price DECIMAL(10, 2) NOT NULL DEFAULT 0;
+
+-- Create product_price_history table; previous_price is 0 for the initial price
+CREATE TABLE IF NOT EXISTS product_price_history (
+    id BIGSERIAL PRIMARY KEY,
+    product_id VARCHAR(36) NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    previous_price DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    changed_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Open the history of existing products with their current price
+INSERT INTO product_price_history (product_id, price, previous_price, changed_at)
+SELECT id, price, 0, created_at FROM products;
+
+-- Create product_price_schedules table; original_price is the price restored when a sale ends
+CREATE TABLE IF NOT EXISTS product_price_schedules (
+    id VARCHAR(36) PRIMARY KEY,
+    product_id VARCHAR(36) NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP,
+    active BOOLEAN NOT NULL DEFAULT FALSE,
+    original_price DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_product_price_history_product_id ON product_price_history(product_id, changed_at DESC);
+CREATE INDEX idx_product_price_schedules_product_id ON product_price_schedules(product_id);
+CREATE INDEX idx_product_price_schedules_starts_at ON product_price_schedules(starts_at) WHERE NOT active;
+CREATE INDEX idx_product_price_schedules_ends_at ON product_price_schedules(ends_at) WHERE active;
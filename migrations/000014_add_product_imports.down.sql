@@ -0,0 +1,10 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_import_jobs_status;
+DROP INDEX IF EXISTS idx_products_sku;
+
+-- Drop tables
+DROP TABLE IF EXISTS import_jobs;
+
+-- Remove the product SKU
+ALTER TABLE products
+    DROP COLUMN IF EXISTS sku;
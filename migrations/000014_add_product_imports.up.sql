@@ -0,0 +1,25 @@
+-- Add the SKU products are imported and exported by; variants have their own SKUs
+ALTER TABLE products
+    ADD COLUMN sku VARCHAR(64);
+
+-- Create import_jobs table; row_errors holds the errors of up to 1000 failed rows
+CREATE TABLE IF NOT EXISTS import_jobs (
+    id VARCHAR(36) PRIMARY KEY,
+    format VARCHAR(10) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    created INTEGER NOT NULL DEFAULT 0,
+    updated INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    row_errors JSONB NOT NULL DEFAULT '[]',
+    failure TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- Create indexes
+CREATE UNIQUE INDEX idx_products_sku ON products(sku) WHERE sku IS NOT NULL;
+CREATE INDEX idx_import_jobs_status ON import_jobs(status, created_at) WHERE status IN ('pending', 'running');
@@ -0,0 +1,10 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_product_stock_levels_warehouse_id;
+
+-- Remove order item allocations
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS allocations;
+
+-- Drop tables
+DROP TABLE IF EXISTS product_stock_levels;
+DROP TABLE IF EXISTS warehouses;
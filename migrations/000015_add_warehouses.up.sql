@@ -0,0 +1,44 @@
+-- Create warehouses table; the warehouse with the lowest priority is the default one
+CREATE TABLE IF NOT EXISTS warehouses (
+    id VARCHAR(36) PRIMARY KEY,
+    code VARCHAR(20) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    line1 VARCHAR(255) NOT NULL,
+    line2 VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL DEFAULT '',
+    region VARCHAR(10) NOT NULL DEFAULT '',
+    country CHAR(2) NOT NULL,
+    priority INT NOT NULL DEFAULT 0 CHECK (priority >= 0),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- Create the default warehouse existing stock is moved into; its address should be updated after migrating
+INSERT INTO warehouses (id, code, name, line1, city, country, priority, created_at, updated_at)
+VALUES ('00000000-0000-0000-0000-000000000001', 'MAIN', 'Main warehouse', 'Unknown', 'Unknown', 'US', 0, NOW(), NOW());
+
+-- Create product_stock_levels table; an empty variant ID means the product itself.
+-- products.stock and product_variants.stock keep the total across warehouses.
+CREATE TABLE IF NOT EXISTS product_stock_levels (
+    product_id VARCHAR(36) NOT NULL,
+    variant_id VARCHAR(36) NOT NULL DEFAULT '',
+    warehouse_id VARCHAR(36) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity >= 0),
+    PRIMARY KEY (product_id, variant_id, warehouse_id),
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (warehouse_id) REFERENCES warehouses(id) ON DELETE RESTRICT
+);
+
+INSERT INTO product_stock_levels (product_id, variant_id, warehouse_id, quantity)
+SELECT id, '', '00000000-0000-0000-0000-000000000001', stock FROM products WHERE stock > 0;
+
+INSERT INTO product_stock_levels (product_id, variant_id, warehouse_id, quantity)
+SELECT product_id, id, '00000000-0000-0000-0000-000000000001', stock FROM product_variants WHERE stock > 0;
+
+-- Record the warehouses each order item is shipped from
+ALTER TABLE order_items
+    ADD COLUMN allocations JSONB NOT NULL DEFAULT '[]';
+
+-- Create indexes
+CREATE INDEX idx_product_stock_levels_warehouse_id ON product_stock_levels(warehouse_id);
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_stock_movements_reference;
+DROP INDEX IF EXISTS idx_stock_movements_product_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS stock_movements;
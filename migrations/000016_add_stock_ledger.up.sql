@@ -0,0 +1,27 @@
+-- Create stock_movements table recording every change of the stock held in a warehouse;
+-- an empty variant ID means the product itself and reference_type is 'order', 'user' or empty
+CREATE TABLE IF NOT EXISTS stock_movements (
+    seq BIGSERIAL,
+    id VARCHAR(36) PRIMARY KEY,
+    product_id VARCHAR(36) NOT NULL,
+    variant_id VARCHAR(36) NOT NULL DEFAULT '',
+    warehouse_id VARCHAR(36) NOT NULL,
+    reason VARCHAR(20) NOT NULL
+        CHECK (reason IN ('sale', 'return', 'adjustment', 'transfer', 'damage')),
+    reference_type VARCHAR(10) NOT NULL DEFAULT '',
+    reference_id VARCHAR(36) NOT NULL DEFAULT '',
+    quantity_before INT NOT NULL,
+    quantity_after INT NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Open the ledger with the stock currently held
+INSERT INTO stock_movements (id, product_id, variant_id, warehouse_id, reason, quantity_before, quantity_after, occurred_at)
+SELECT gen_random_uuid()::text, product_id, variant_id, warehouse_id, 'adjustment', 0, quantity, NOW()
+FROM product_stock_levels
+WHERE quantity > 0;
+
+-- Create indexes
+CREATE INDEX idx_stock_movements_product_id ON stock_movements(product_id, occurred_at DESC);
+CREATE INDEX idx_stock_movements_reference ON stock_movements(reference_type, reference_id) WHERE reference_type <> '';
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_orders_created_at;
+
+-- Remove the reorder threshold
+ALTER TABLE products
+    DROP COLUMN IF EXISTS reorder_threshold;
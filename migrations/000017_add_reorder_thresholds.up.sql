@@ -0,0 +1,6 @@
+-- Add the stock at or below which a product, or one of its variants, is low on stock; 0 disables alerts
+ALTER TABLE products
+    ADD COLUMN reorder_threshold INT NOT NULL DEFAULT 0;
+
+-- Create indexes for the sales velocity of the reorder report
+CREATE INDEX idx_orders_created_at ON orders(created_at);
@@ -0,0 +1,15 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_product_backorders_product_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS product_backorders;
+
+-- Remove the backordered quantity of order items
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS backordered;
+
+-- Remove the backorder policy
+ALTER TABLE products
+    DROP COLUMN IF EXISTS available_at,
+    DROP COLUMN IF EXISTS backorder_limit,
+    DROP COLUMN IF EXISTS backorder_policy;
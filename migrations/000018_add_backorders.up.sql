@@ -0,0 +1,25 @@
+-- Add whether products can be ordered beyond their stock: none, backorder or preorder,
+-- up to a limit when positive, and when the missing stock is expected
+ALTER TABLE products
+    ADD COLUMN backorder_policy VARCHAR(20) NOT NULL DEFAULT 'none',
+    ADD COLUMN backorder_limit INT NOT NULL DEFAULT 0,
+    ADD COLUMN available_at TIMESTAMP;
+
+-- Add the quantity of order items waiting for stock
+ALTER TABLE order_items
+    ADD COLUMN backordered INT NOT NULL DEFAULT 0;
+
+-- Create product_backorders table; the order items waiting for stock are filled oldest first
+CREATE TABLE IF NOT EXISTS product_backorders (
+    item_id VARCHAR(36) PRIMARY KEY,
+    product_id VARCHAR(36) NOT NULL,
+    variant_id VARCHAR(36) NOT NULL DEFAULT '',
+    order_id VARCHAR(36) NOT NULL,
+    quantity INT NOT NULL,
+    placed_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_product_backorders_product_id ON product_backorders(product_id, placed_at);
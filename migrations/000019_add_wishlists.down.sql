@@ -0,0 +1,8 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_wishlist_items_product_id;
+DROP INDEX IF EXISTS idx_wishlist_lists_wishlist_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS wishlist_items;
+DROP TABLE IF EXISTS wishlist_lists;
+DROP TABLE IF EXISTS wishlists;
@@ -0,0 +1,35 @@
+-- Create wishlists table; each user has a single wishlist holding their named lists
+CREATE TABLE IF NOT EXISTS wishlists (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create wishlist_lists table; a list with a share token can be viewed through a public link
+CREATE TABLE IF NOT EXISTS wishlist_lists (
+    id VARCHAR(36) PRIMARY KEY,
+    wishlist_id VARCHAR(36) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    share_token VARCHAR(64) UNIQUE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (wishlist_id) REFERENCES wishlists(id) ON DELETE CASCADE
+);
+
+-- Create wishlist_items table; the price a product was saved at is kept to spot price drops
+CREATE TABLE IF NOT EXISTS wishlist_items (
+    list_id VARCHAR(36) NOT NULL,
+    product_id VARCHAR(36) NOT NULL,
+    variant_id VARCHAR(36) NOT NULL DEFAULT '',
+    added_price DECIMAL(10, 2) NOT NULL,
+    added_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (list_id, product_id, variant_id),
+    FOREIGN KEY (list_id) REFERENCES wishlist_lists(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_wishlist_lists_wishlist_id ON wishlist_lists(wishlist_id);
+CREATE INDEX idx_wishlist_items_product_id ON wishlist_items(product_id);
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_reviews_status;
+DROP INDEX IF EXISTS idx_reviews_product_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS review_votes;
+DROP TABLE IF EXISTS reviews;
@@ -0,0 +1,32 @@
+-- Create reviews table; each user reviews a product at most once, and only approved reviews are shown
+CREATE TABLE IF NOT EXISTS reviews (
+    id VARCHAR(36) PRIMARY KEY,
+    product_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    title VARCHAR(150) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    verified BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    moderation_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE (product_id, user_id)
+);
+
+-- Create review_votes table; each user votes on a review at most once
+CREATE TABLE IF NOT EXISTS review_votes (
+    review_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    helpful BOOLEAN NOT NULL,
+    voted_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (review_id, user_id),
+    FOREIGN KEY (review_id) REFERENCES reviews(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create indexes
+CREATE INDEX idx_reviews_product_id ON reviews(product_id, status);
+CREATE INDEX idx_reviews_status ON reviews(status, created_at);
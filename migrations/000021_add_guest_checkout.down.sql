@@ -0,0 +1,20 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_orders_guest_email;
+
+-- Remove guest coupon redemptions, orders and carts
+DELETE FROM coupon_redemptions WHERE user_id IS NULL;
+DELETE FROM orders WHERE user_id IS NULL;
+DELETE FROM carts WHERE user_id IS NULL;
+
+ALTER TABLE coupon_redemptions
+    ALTER COLUMN user_id SET NOT NULL;
+
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS chk_orders_customer,
+    DROP COLUMN IF EXISTS guest_email,
+    ALTER COLUMN user_id SET NOT NULL;
+
+ALTER TABLE carts
+    DROP CONSTRAINT IF EXISTS chk_carts_owner,
+    DROP COLUMN IF EXISTS session_token,
+    ALTER COLUMN user_id SET NOT NULL;
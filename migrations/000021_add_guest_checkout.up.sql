@@ -0,0 +1,18 @@
+-- Allow anonymous carts, identified by the session token of the visitor instead of a user
+ALTER TABLE carts
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN session_token VARCHAR(64) UNIQUE,
+    ADD CONSTRAINT chk_carts_owner CHECK (user_id IS NOT NULL OR session_token IS NOT NULL);
+
+-- Allow guest orders, placed with just an email address
+ALTER TABLE orders
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN guest_email VARCHAR(255),
+    ADD CONSTRAINT chk_orders_customer CHECK (user_id IS NOT NULL OR guest_email IS NOT NULL);
+
+-- Coupons redeemed by guests are counted without a user
+ALTER TABLE coupon_redemptions
+    ALTER COLUMN user_id DROP NOT NULL;
+
+-- Create indexes
+CREATE INDEX idx_orders_guest_email ON orders(guest_email);
@@ -0,0 +1,3 @@
+-- Remove the price cart items were shown at
+ALTER TABLE cart_items
+    DROP COLUMN IF EXISTS price;
@@ -0,0 +1,3 @@
+-- Add the unit price each cart item was last shown at, to warn about price changes; zero when unknown
+ALTER TABLE cart_items
+    ADD COLUMN price DECIMAL(10, 2) NOT NULL DEFAULT 0;
@@ -0,0 +1,13 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_cart_reminders_sent_at;
+DROP INDEX IF EXISTS idx_cart_reminders_cart_id;
+DROP INDEX IF EXISTS idx_carts_updated_at;
+
+-- Drop tables
+DROP TABLE IF EXISTS cart_reminders;
+
+-- Remove the reminder tracking of carts
+ALTER TABLE carts
+    DROP COLUMN IF EXISTS recovery_token,
+    DROP COLUMN IF EXISTS last_reminded_at,
+    DROP COLUMN IF EXISTS reminders_sent;
@@ -0,0 +1,21 @@
+-- Track the reminders sent about abandoned carts, and the token of the link restoring them
+ALTER TABLE carts
+    ADD COLUMN reminders_sent INT NOT NULL DEFAULT 0,
+    ADD COLUMN last_reminded_at TIMESTAMP,
+    ADD COLUMN recovery_token VARCHAR(64) UNIQUE;
+
+-- Create cart_reminders table; reminders are kept when their cart expires, for recovery reporting
+CREATE TABLE IF NOT EXISTS cart_reminders (
+    id SERIAL PRIMARY KEY,
+    cart_id VARCHAR(36) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    sent_at TIMESTAMP NOT NULL,
+    restored_at TIMESTAMP,
+    recovered_at TIMESTAMP,
+    order_id VARCHAR(36)
+);
+
+-- Create indexes
+CREATE INDEX idx_carts_updated_at ON carts(updated_at);
+CREATE INDEX idx_cart_reminders_cart_id ON cart_reminders(cart_id);
+CREATE INDEX idx_cart_reminders_sent_at ON cart_reminders(sent_at);
@@ -0,0 +1,12 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_notification_deliveries_next_attempt_at;
+DROP INDEX IF EXISTS idx_notification_deliveries_status;
+
+-- Drop tables
+DROP TABLE IF EXISTS notification_deliveries;
+
+-- Remove the locale and password resets of users
+ALTER TABLE users
+    DROP COLUMN IF EXISTS password_reset_expires_at,
+    DROP COLUMN IF EXISTS password_reset_token,
+    DROP COLUMN IF EXISTS locale;
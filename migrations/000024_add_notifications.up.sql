@@ -0,0 +1,28 @@
+-- Let users choose the locale they are written to in, and request a password reset
+ALTER TABLE users
+    ADD COLUMN locale VARCHAR(35) NOT NULL DEFAULT '',
+    ADD COLUMN password_reset_token VARCHAR(64) UNIQUE,
+    ADD COLUMN password_reset_expires_at TIMESTAMP;
+
+-- Create notification_deliveries table, the log of every message sent and its delivery attempts
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id VARCHAR(36) PRIMARY KEY,
+    template VARCHAR(100) NOT NULL DEFAULT '',
+    locale VARCHAR(35) NOT NULL DEFAULT '',
+    recipients TEXT[] NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    html TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP
+);
+
+-- Create indexes
+CREATE INDEX idx_notification_deliveries_status ON notification_deliveries(status, created_at);
+CREATE INDEX idx_notification_deliveries_next_attempt_at ON notification_deliveries(next_attempt_at)
+    WHERE status = 'pending';
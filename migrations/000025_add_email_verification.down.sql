@@ -0,0 +1,5 @@
+-- Remove the email verification of users
+ALTER TABLE users
+    DROP COLUMN IF EXISTS verification_sent_at,
+    DROP COLUMN IF EXISTS verification_nonce,
+    DROP COLUMN IF EXISTS email_verified_at;
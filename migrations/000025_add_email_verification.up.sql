@@ -0,0 +1,8 @@
+-- Track whether users verified their email address, and the verification link last sent to them
+ALTER TABLE users
+    ADD COLUMN email_verified_at TIMESTAMP,
+    ADD COLUMN verification_nonce VARCHAR(64),
+    ADD COLUMN verification_sent_at TIMESTAMP;
+
+-- Users registered before verification existed are considered verified
+UPDATE users SET email_verified_at = created_at;
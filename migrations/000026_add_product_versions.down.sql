@@ -0,0 +1,3 @@
+-- Remove the versions of products
+ALTER TABLE products
+    DROP COLUMN IF EXISTS version;
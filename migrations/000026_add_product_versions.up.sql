@@ -0,0 +1,3 @@
+-- Count the updates of each product, so that an update made from a stale copy of it is rejected
+ALTER TABLE products
+    ADD COLUMN version INT NOT NULL DEFAULT 0;
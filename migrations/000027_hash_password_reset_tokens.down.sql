@@ -0,0 +1,6 @@
+-- Go back to plain text password reset tokens; hashed tokens cannot be recovered, so pending resets are dropped
+UPDATE users SET password_reset_token_hash = NULL, password_reset_expires_at = NULL;
+
+ALTER TABLE users DROP COLUMN IF EXISTS password_reset_requested_at;
+
+ALTER TABLE users RENAME COLUMN password_reset_token_hash TO password_reset_token;
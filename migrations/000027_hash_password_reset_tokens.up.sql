@@ -0,0 +1,9 @@
+-- Store only the hash of password reset tokens, and when a reset was last requested to rate-limit requests
+ALTER TABLE users RENAME COLUMN password_reset_token TO password_reset_token_hash;
+
+ALTER TABLE users ADD COLUMN password_reset_requested_at TIMESTAMP;
+
+-- Hash the tokens of pending resets, so that their links keep working
+UPDATE users
+SET password_reset_token_hash = encode(sha256(password_reset_token_hash::bytea), 'hex')
+WHERE password_reset_token_hash IS NOT NULL;
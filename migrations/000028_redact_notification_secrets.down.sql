@@ -0,0 +1,2 @@
+-- Stop marking redacted deliveries; redacted messages cannot be restored
+ALTER TABLE notification_deliveries DROP COLUMN IF EXISTS redacted;
@@ -0,0 +1,13 @@
+-- Mark the deliveries whose logged message had its secrets, such as reset links, redacted
+ALTER TABLE notification_deliveries ADD COLUMN redacted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Drop the links of the password reset and verification emails logged so far; those not sent yet
+-- cannot be sent any more and have to be requested again
+UPDATE notification_deliveries
+SET body = '[redacted]', html = '', redacted = TRUE
+WHERE template IN ('password_reset', 'email_verification');
+
+UPDATE notification_deliveries
+SET status = 'failed', next_attempt_at = NULL,
+    last_error = 'the message holding secrets is no longer kept, it cannot be sent'
+WHERE redacted AND status = 'pending';
@@ -4,19 +4,35 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// defaultVerificationSecret signs email verification tokens when VERIFICATION_SECRET is unset,
+// which is only allowed in development
+const defaultVerificationSecret = "change-me-in-production"
+
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	RabbitMQ RabbitMQConfig
+	Server       ServerConfig
+	Database     DatabaseConfig
+	Redis        RedisConfig
+	RabbitMQ     RabbitMQConfig
+	Search       SearchConfig
+	Storage      StorageConfig
+	Jobs         JobsConfig
+	Cart         CartConfig
+	Users        UsersConfig
+	Notification NotificationConfig
+	Inventory    InventoryConfig
 }
 
 // ServerConfig holds all server related configuration
 type ServerConfig struct {
 	Port string
+
+	// Environment names where the application runs, such as development or production
+	Environment string
 }
 
 // DatabaseConfig holds all database related configuration
@@ -45,11 +61,119 @@ type RabbitMQConfig struct {
 	Password string
 }
 
+// SearchConfig holds all product search related configuration
+type SearchConfig struct {
+	Backend   string
+	IndexPath string
+
+	// SaveInterval is how often the changes of the embedded index are written to its file
+	SaveInterval time.Duration
+}
+
+// StorageConfig holds all media storage related configuration
+type StorageConfig struct {
+	Backend     string
+	LocalDir    string
+	MediaURL    string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+}
+
+// JobsConfig holds all background job related configuration
+type JobsConfig struct {
+	PriceScheduleInterval time.Duration
+	ImportPollInterval    time.Duration
+}
+
+// CartConfig holds all abandoned cart related configuration
+type CartConfig struct {
+	// ReminderInterval is how often abandoned carts are looked for
+	ReminderInterval time.Duration
+
+	// AbandonedAfter is how long a cart is left untouched before it is reminded, and between reminders
+	AbandonedAfter time.Duration
+
+	// MaxReminders caps the reminders sent about an abandoned cart
+	MaxReminders int
+
+	// ExpireAfter is how long a cart is left untouched before it is removed
+	ExpireAfter time.Duration
+
+	// RecoveryURL is the link in reminders, completed with the recovery token of the cart
+	RecoveryURL string
+}
+
+// UsersConfig holds all user account related configuration
+type UsersConfig struct {
+	// PasswordResetURL is the link in password reset emails, completed with the reset token
+	PasswordResetURL string
+
+	// PasswordResetTTL is how long a password reset link can be used
+	PasswordResetTTL time.Duration
+
+	// PasswordResetCooldown is the least time between two password reset emails sent to a user
+	PasswordResetCooldown time.Duration
+
+	// VerificationURL is the link in email verification emails, completed with the signed token
+	VerificationURL string
+
+	// VerificationSecret signs the email verification tokens
+	VerificationSecret string
+
+	// VerificationTTL is how long an email verification link can be used
+	VerificationTTL time.Duration
+
+	// VerificationResendCooldown is the least time between two verification emails sent to a user
+	VerificationResendCooldown time.Duration
+
+	// RequireVerifiedEmail prevents users from placing orders before verifying their email address
+	RequireVerifiedEmail bool
+}
+
+// NotificationConfig holds all customer notification related configuration
+type NotificationConfig struct {
+	// Backend delivers the notifications: log or smtp
+	Backend string
+
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+
+	// From is the sender of emails, such as "Shop <no-reply@example.com>"
+	From string
+
+	// DefaultLocale is the locale of the templates used for recipients without one
+	DefaultLocale string
+
+	// MaxAttempts caps the attempts at delivering a notification failing transiently
+	MaxAttempts int
+
+	// RetryDelay is the wait before the first retry, doubling before each next one
+	RetryDelay time.Duration
+
+	// SendInterval is how often the notifications due for an attempt are looked for
+	SendInterval time.Duration
+}
+
+// InventoryConfig holds all stock keeping related configuration
+type InventoryConfig struct {
+	// AllocationStrategy picks the warehouses orders are shipped from: nearest or fill_first
+	AllocationStrategy string
+
+	// LowStockRecipients are the staff addresses notified when stock falls to a reorder threshold
+	LowStockRecipients []string
+}
+
 // Load returns a new Config struct populated with values from environment variables
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
-			Port: getEnv("PORT", "3000"),
+			Port:        getEnv("PORT", "3000"),
+			Environment: getEnv("APP_ENV", "development"),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -71,9 +195,72 @@ func Load() *Config {
 			User:     getEnv("RABBITMQ_USER", "guest"),
 			Password: getEnv("RABBITMQ_PASSWORD", "guest"),
 		},
+		Search: SearchConfig{
+			Backend:      getEnv("SEARCH_BACKEND", "postgres"),
+			IndexPath:    getEnv("SEARCH_INDEX_PATH", "data/search.idx"),
+			SaveInterval: getEnvAsDuration("SEARCH_INDEX_SAVE_INTERVAL", 5*time.Second),
+		},
+		Storage: StorageConfig{
+			Backend:     getEnv("STORAGE_BACKEND", "local"),
+			LocalDir:    getEnv("STORAGE_LOCAL_DIR", "data/media"),
+			MediaURL:    getEnv("MEDIA_URL", "/media"),
+			S3Endpoint:  getEnv("S3_ENDPOINT", "http://localhost:9000"),
+			S3Region:    getEnv("S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("S3_BUCKET", "media"),
+			S3AccessKey: getEnv("S3_ACCESS_KEY", "minioadmin"),
+			S3SecretKey: getEnv("S3_SECRET_KEY", "minioadmin"),
+		},
+		Jobs: JobsConfig{
+			PriceScheduleInterval: getEnvAsDuration("PRICE_SCHEDULE_INTERVAL", time.Minute),
+			ImportPollInterval:    getEnvAsDuration("IMPORT_POLL_INTERVAL", 5*time.Second),
+		},
+		Cart: CartConfig{
+			ReminderInterval: getEnvAsDuration("ABANDONED_CART_INTERVAL", 15*time.Minute),
+			AbandonedAfter:   getEnvAsDuration("ABANDONED_CART_AFTER", 24*time.Hour),
+			MaxReminders:     getEnvAsInt("ABANDONED_CART_MAX_REMINDERS", 2),
+			ExpireAfter:      getEnvAsDuration("CART_EXPIRE_AFTER", 90*24*time.Hour),
+			RecoveryURL:      getEnv("CART_RECOVERY_URL", "http://localhost:3000/api/carts/recover/"),
+		},
+		Users: UsersConfig{
+			PasswordResetURL:           getEnv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password?token="),
+			PasswordResetTTL:           getEnvAsDuration("PASSWORD_RESET_TTL", time.Hour),
+			PasswordResetCooldown:      getEnvAsDuration("PASSWORD_RESET_COOLDOWN", time.Minute),
+			VerificationURL:            getEnv("VERIFY_EMAIL_URL", "http://localhost:3000/api/users/verify-email/"),
+			VerificationSecret:         getEnv("VERIFICATION_SECRET", defaultVerificationSecret),
+			VerificationTTL:            getEnvAsDuration("VERIFICATION_TTL", 48*time.Hour),
+			VerificationResendCooldown: getEnvAsDuration("VERIFICATION_RESEND_COOLDOWN", time.Minute),
+			RequireVerifiedEmail:       getEnvAsBool("REQUIRE_VERIFIED_EMAIL_TO_ORDER", false),
+		},
+		Notification: NotificationConfig{
+			Backend:       getEnv("NOTIFIER_BACKEND", "log"),
+			SMTPHost:      getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:      getEnv("SMTP_PORT", "1025"),
+			SMTPUsername:  getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:  getEnv("SMTP_PASSWORD", ""),
+			From:          getEnv("MAIL_FROM", "Shop <no-reply@example.com>"),
+			DefaultLocale: getEnv("NOTIFICATION_LOCALE", "en"),
+			MaxAttempts:   getEnvAsInt("NOTIFICATION_MAX_ATTEMPTS", 5),
+			RetryDelay:    getEnvAsDuration("NOTIFICATION_RETRY_DELAY", time.Minute),
+			SendInterval:  getEnvAsDuration("NOTIFICATION_SEND_INTERVAL", 5*time.Second),
+		},
+		Inventory: InventoryConfig{
+			AllocationStrategy: getEnv("ALLOCATION_STRATEGY", "nearest"),
+			LowStockRecipients: getEnvAsList("LOW_STOCK_RECIPIENTS"),
+		},
 	}
 }
 
+// IsDevelopment reports whether the application runs in development
+func (c *ServerConfig) IsDevelopment() bool {
+	return c.Environment == "development"
+}
+
+// UsesDefaultVerificationSecret reports whether VERIFICATION_SECRET was left unset, which makes
+// email verification tokens forgeable by anyone who knows the default
+func (c *UsersConfig) UsesDefaultVerificationSecret() bool {
+	return c.VerificationSecret == defaultVerificationSecret
+}
+
 // PostgresConnectionString returns a connection string for PostgreSQL
 func (c *DatabaseConfig) PostgresConnectionString() string {
 	return fmt.Sprintf(
@@ -112,3 +299,34 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// Helper function to get an environment variable as a duration, such as "30s", with a default value
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if valueStr, exists := os.LookupEnv(key); exists {
+		if value, err := time.ParseDuration(valueStr); err == nil && value > 0 {
+			return value
+		}
+	}
+	return defaultValue
+}
+
+// Helper function to get an environment variable as a boolean, such as "true" or "1", with a default value
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if valueStr, exists := os.LookupEnv(key); exists {
+		if value, err := strconv.ParseBool(valueStr); err == nil {
+			return value
+		}
+	}
+	return defaultValue
+}
+
+// Helper function to get a comma-separated environment variable as a list, empty when unset
+func getEnvAsList(key string) []string {
+	values := []string{}
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,139 @@
+package imaging
+
+import (
+	"image"
+	"image/draw"
+	"math"
+)
+
+// Fit scales an image down to fit within maxWidth x maxHeight, keeping its aspect ratio.
+// Images that already fit are returned unchanged; images are never scaled up.
+func Fit(src image.Image, maxWidth, maxHeight int) image.Image {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxWidth && height <= maxHeight {
+		return src
+	}
+
+	scale := math.Min(float64(maxWidth)/float64(width), float64(maxHeight)/float64(height))
+	return Resize(
+		src,
+		max(1, int(math.Round(float64(width)*scale))),
+		max(1, int(math.Round(float64(height)*scale))),
+	)
+}
+
+// Resize scales an image to width x height by averaging the source pixels each
+// destination pixel covers, which keeps downscaled images smooth. Colors are averaged
+// weighted by their alpha so transparent pixels do not darken edges.
+func Resize(src image.Image, width, height int) *image.NRGBA {
+	bounds := src.Bounds()
+	source := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(source, source.Bounds(), src, bounds.Min, draw.Src)
+
+	// Scale rows first, then columns, accumulating premultiplied channels
+	rows := resampleRows(premultiply(source), bounds.Dx(), bounds.Dy(), width)
+	columns := resampleColumns(rows, width, bounds.Dy(), height)
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for i := 0; i < width*height; i++ {
+		r, g, b, a := columns[i*4], columns[i*4+1], columns[i*4+2], columns[i*4+3]
+		if a > 0 {
+			r, g, b = r/a*255, g/a*255, b/a*255
+		}
+		dst.Pix[i*4] = clamp(r)
+		dst.Pix[i*4+1] = clamp(g)
+		dst.Pix[i*4+2] = clamp(b)
+		dst.Pix[i*4+3] = clamp(a)
+	}
+
+	return dst
+}
+
+// premultiply returns the pixels of an image as premultiplied RGBA floats
+func premultiply(img *image.NRGBA) []float64 {
+	pixels := make([]float64, len(img.Pix))
+	for i := 0; i < len(img.Pix); i += 4 {
+		alpha := float64(img.Pix[i+3])
+		pixels[i] = float64(img.Pix[i]) * alpha / 255
+		pixels[i+1] = float64(img.Pix[i+1]) * alpha / 255
+		pixels[i+2] = float64(img.Pix[i+2]) * alpha / 255
+		pixels[i+3] = alpha
+	}
+	return pixels
+}
+
+// contribution is the share of a source pixel in a destination pixel
+type contribution struct {
+	index  int
+	weight float64
+}
+
+// weights returns, for each destination index, the source pixels it covers
+func weights(sourceSize, targetSize int) [][]contribution {
+	scale := float64(sourceSize) / float64(targetSize)
+	result := make([][]contribution, targetSize)
+
+	for t := 0; t < targetSize; t++ {
+		start, end := float64(t)*scale, float64(t+1)*scale
+		for s := int(start); s < sourceSize && float64(s) < end; s++ {
+			coverage := math.Min(end, float64(s+1)) - math.Max(start, float64(s))
+			if coverage > 0 {
+				result[t] = append(result[t], contribution{index: s, weight: coverage / scale})
+			}
+		}
+	}
+
+	return result
+}
+
+// resampleRows scales each row of a width x height pixel buffer to targetWidth
+func resampleRows(pixels []float64, width, height, targetWidth int) []float64 {
+	result := make([]float64, targetWidth*height*4)
+	columnWeights := weights(width, targetWidth)
+
+	for y := 0; y < height; y++ {
+		for x, covered := range columnWeights {
+			out := (y*targetWidth + x) * 4
+			for _, w := range covered {
+				in := (y*width + w.index) * 4
+				for c := 0; c < 4; c++ {
+					result[out+c] += pixels[in+c] * w.weight
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// resampleColumns scales each column of a width x height pixel buffer to targetHeight
+func resampleColumns(pixels []float64, width, height, targetHeight int) []float64 {
+	result := make([]float64, width*targetHeight*4)
+	rowWeights := weights(height, targetHeight)
+
+	for y, covered := range rowWeights {
+		for _, w := range covered {
+			for x := 0; x < width; x++ {
+				out := (y*width + x) * 4
+				in := (w.index*width + x) * 4
+				for c := 0; c < 4; c++ {
+					result[out+c] += pixels[in+c] * w.weight
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// clamp rounds a channel value into a byte
+func clamp(value float64) uint8 {
+	switch {
+	case value <= 0:
+		return 0
+	case value >= 255:
+		return 255
+	}
+	return uint8(value + 0.5)
+}
@@ -0,0 +1,8 @@
+package money
+
+import "math"
+
+// Round rounds an amount to cents
+func Round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}